@@ -0,0 +1,250 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// adminconsent.go verifies the id_token returned to the redirect url
+// of an external admin consent workflow.
+// https://developers.docusign.com/esign-rest-api/guides/authentication/obtaining-consent#admin-consent-for-external-applications
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/ctxclient"
+	"github.com/jfcote87/oauth2/jws"
+)
+
+// JWKSFetcher retrieves and caches the RSA public keys published at
+// an account server's JWKS endpoint.
+type JWKSFetcher struct {
+	// URL of the JWKS endpoint (e.g. https://account-d.docusign.com/oauth/jwks)
+	URL string
+	// CacheDuration determines how long keys are cached. If 0, 24 hours
+	// is assumed.  An unknown key id causes a refetch if the keys are
+	// more than a minute old.
+	CacheDuration time.Duration
+	// HTTPClientFunc determines client used for the jwks call.  If
+	// nil, ctxclient.DefaultClient will be used.
+	HTTPClientFunc ctxclient.Func
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+}
+
+// jwk describes a single RSA JSON Web Key.  See https://tools.ietf.org/html/rfc7517
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (k jwk) publicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(k.N, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %v", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(k.E, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %v", err)
+	}
+	exp := new(big.Int).SetBytes(e)
+	if !exp.IsInt64() || exp.Int64() > 1<<31-1 || exp.Int64() < 3 {
+		return nil, errors.New("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+}
+
+// Key returns the public key identified by kid.
+func (f *JWKSFetcher) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	if f == nil {
+		return nil, errors.New("nil jwks fetcher")
+	}
+	cacheDuration := f.CacheDuration
+	if cacheDuration == 0 {
+		cacheDuration = 24 * time.Hour
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	age := time.Since(f.fetched)
+	key, ok := f.keys[kid]
+	if ok && age < cacheDuration {
+		return key, nil
+	}
+	if !ok && f.keys != nil && age < time.Minute {
+		return nil, fmt.Errorf("jwks: unknown key id %s", kid)
+	}
+	if err := f.fetch(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok = f.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("jwks: unknown key id %s", kid)
+}
+
+// fetch reloads the key set.  Must be called with f.mu locked.
+func (f *JWKSFetcher) fetch(ctx context.Context) error {
+	req, err := http.NewRequest("GET", f.URL, nil)
+	if err != nil {
+		return err
+	}
+	res, err := f.HTTPClientFunc.Do(ctx, req)
+	if err != nil {
+		return toResponseError(err)
+	}
+	defer res.Body.Close()
+	var keySet struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&keySet); err != nil {
+		return fmt.Errorf("jwks: %v", err)
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range keySet.Keys {
+		if key, err := k.publicKey(); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+	f.keys, f.fetched = keys, time.Now()
+	return nil
+}
+
+// AdminConsentVerifier validates the id_token returned from an external
+// admin consent.  Reuse the verifier to take advantage of key caching.
+type AdminConsentVerifier struct {
+	// IntegratorKey must match the token's aud claim.
+	IntegratorKey string
+	// Issuer must match the token's iss claim (e.g. https://account-d.docusign.com/).
+	Issuer string
+	// Keys provides the public keys for verifying signatures.
+	Keys *JWKSFetcher
+	// ClockSkew is the allowed difference between the local clock and
+	// the token's exp and iat claims.  If 0, 5 minutes is assumed.
+	ClockSkew time.Duration
+	// Now returns the current time.  If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// AdminConsentVerifier returns a verifier for the config's IntegratorKey
+// using the environment's JWKS endpoint and issuer.
+func (c *JWTConfig) AdminConsentVerifier() *AdminConsentVerifier {
+	env := c.environment()
+	return &AdminConsentVerifier{
+		IntegratorKey: c.IntegratorKey,
+		Issuer:        env.issuer(),
+		Keys: &JWKSFetcher{
+			URL:            env.jwksURL(),
+			HTTPClientFunc: c.HTTPClientFunc,
+		},
+	}
+}
+
+// idTokenClaims handles claims whose types vary between servers.
+type idTokenClaims struct {
+	AdminConsentResponse
+	Audience json.RawMessage `json:"aud"`
+	AuthTime json.RawMessage `json:"auth_time"`
+}
+
+// Verify checks the id_token's RS256 signature, audience, issuer and
+// expiration.  On success, the token's claims are returned.
+func (v *AdminConsentVerifier) Verify(ctx context.Context, idToken string) (*AdminConsentResponse, error) {
+	var hdr struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := jws.DecodeHeader(idToken, &hdr); err != nil {
+		return nil, fmt.Errorf("id_token header: %v", err)
+	}
+	if hdr.Alg != "RS256" {
+		return nil, fmt.Errorf("id_token: unsupported alg %s", hdr.Alg)
+	}
+	key, err := v.Keys.Key(ctx, hdr.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err = jws.Verify(idToken, jws.RS256Verifier(key)); err != nil {
+		return nil, fmt.Errorf("id_token signature: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(idToken, ".")[1])
+	if err != nil {
+		return nil, fmt.Errorf("id_token payload: %v", err)
+	}
+	var claims idTokenClaims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("id_token payload: %v", err)
+	}
+	acr := claims.AdminConsentResponse
+	if acr.Audience, err = v.checkAudience(claims.Audience); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(acr.Issuer, "/") != strings.TrimSuffix(v.Issuer, "/") {
+		return nil, fmt.Errorf("id_token: invalid issuer %s", acr.Issuer)
+	}
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+	skew := v.ClockSkew
+	if skew == 0 {
+		skew = 5 * time.Minute
+	}
+	tm := now()
+	if tm.Add(-skew).After(time.Unix(acr.ExpiresAt, 0)) {
+		return nil, errors.New("id_token: expired")
+	}
+	if tm.Add(skew).Before(time.Unix(acr.IssuedAt, 0)) {
+		return nil, errors.New("id_token: issued in the future")
+	}
+	var authTime interface{}
+	if len(claims.AuthTime) > 0 && json.Unmarshal(claims.AuthTime, &authTime) == nil {
+		switch t := authTime.(type) {
+		case string:
+			acr.AuthTime = t
+		case float64:
+			acr.AuthTime = strconv.FormatInt(int64(t), 10)
+		}
+	}
+	return &acr, nil
+}
+
+// checkAudience ensures the IntegratorKey is the aud claim, which may be
+// a string or an array of strings.
+func (v *AdminConsentVerifier) checkAudience(raw json.RawMessage) (string, error) {
+	var aud string
+	if err := json.Unmarshal(raw, &aud); err == nil {
+		if aud == v.IntegratorKey {
+			return aud, nil
+		}
+		return "", fmt.Errorf("id_token: invalid audience %s", aud)
+	}
+	var auds []string
+	if err := json.Unmarshal(raw, &auds); err != nil {
+		return "", fmt.Errorf("id_token: invalid audience %s", string(raw))
+	}
+	for _, a := range auds {
+		if a == v.IntegratorKey {
+			return a, nil
+		}
+	}
+	return "", fmt.Errorf("id_token: invalid audience %s", strings.Join(auds, ","))
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/oauth2/jws"
+	"github.com/jfcote87/testutils"
+)
+
+func TestAdminConsentVerifier(t *testing.T) {
+	ctx := context.Background()
+	key, err := jws.ParseRSAKey([]byte(testPK))
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"IDKEY","use":"sig","alg":"RS256","n":"%s","e":"%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+
+	testTransport := &testutils.Transport{}
+	clx := &http.Client{Transport: testTransport}
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		PrivateKey:    testPK,
+		IsDemo:        true,
+		HTTPClientFunc: func(ctx context.Context) (*http.Client, error) {
+			return clx, nil
+		},
+	}
+	v := cfg.AdminConsentVerifier()
+	if v.Issuer != "https://account-d.docusign.com/" || v.Keys.URL != "https://account-d.docusign.com/oauth/jwks" {
+		t.Fatalf("expected demo issuer and jwks url; got %s %s", v.Issuer, v.Keys.URL)
+	}
+
+	now := time.Now()
+	makeToken := func(kid, aud, iss string, iat time.Time) string {
+		cs := &jws.ClaimSet{
+			Issuer:    iss,
+			Audience:  aud,
+			Subject:   "ADMINUSER",
+			IssuedAt:  iat.Unix(),
+			ExpiresAt: iat.Add(time.Hour).Unix(),
+			PrivateClaims: map[string]interface{}{
+				"coid":      []string{"ORG1", "ORG2"},
+				"amr":       []string{"pwd"},
+				"siteid":    1,
+				"auth_time": iat.Unix(),
+			},
+		}
+		tk, err := cs.JWT(jws.RS256(key, kid))
+		if err != nil {
+			t.Fatalf("sign id_token: %v", err)
+		}
+		return tk
+	}
+
+	testTransport.Add(&testutils.RequestTester{
+		Host:     "account-d.docusign.com",
+		Path:     "/oauth/jwks",
+		Response: testutils.MakeResponse(200, []byte(jwks), nil),
+	})
+	acr, err := v.Verify(ctx, makeToken("IDKEY", "KEY", "https://account-d.docusign.com/", now))
+	if err != nil {
+		t.Fatalf("expected valid id_token; got %v", err)
+	}
+	if acr.Subject != "ADMINUSER" || len(acr.COID) != 2 || acr.COID[1] != "ORG2" || acr.AuthTime != fmt.Sprintf("%d", now.Unix()) {
+		t.Errorf("unexpected claims %#v", acr)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "audience", token: makeToken("IDKEY", "OTHERKEY", "https://account-d.docusign.com/", now)},
+		{name: "issuer", token: makeToken("IDKEY", "KEY", "https://account.docusign.com/", now)},
+		{name: "expired", token: makeToken("IDKEY", "KEY", "https://account-d.docusign.com", now.Add(-2*time.Hour))},
+		{name: "future", token: makeToken("IDKEY", "KEY", "https://account-d.docusign.com", now.Add(time.Hour))},
+		{name: "unknown kid", token: makeToken("OTHERKID", "KEY", "https://account-d.docusign.com/", now)},
+		{name: "signature", token: makeToken("IDKEY", "KEY", "https://account-d.docusign.com/", now) + "A"},
+	}
+	// keys are cached, so no further jwks calls expected
+	for _, tt := range tests {
+		if _, err := v.Verify(ctx, tt.token); err == nil {
+			t.Errorf("%s: expected error; got success", tt.name)
+		}
+	}
+}
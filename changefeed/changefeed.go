@@ -0,0 +1,221 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package changefeed polls an account for envelope status changes for
+// applications unable to receive Connect messages.  Changes are passed to
+// OnEvent as esign.ConnectEvents so that the same code may process
+// pushed and polled changes.
+//
+//	f := &changefeed.Feed{
+//		Credential: cred,
+//		ID:         accountID,
+//		Store:      cursorStore,
+//		Include:    "recipients",
+//		OnEvent:    handleEvent, // also used by esign.ConnectHandler.OnEvent
+//	}
+//	err := f.Run(ctx, 5*time.Minute)
+package changefeed // import "github.com/jfcote87/esign/changefeed"
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Defaults for Feed values.
+const (
+	DefaultPageSize   = 100
+	DefaultMaxResults = 1000
+)
+
+// Cursor is the position of a feed.
+type Cursor struct {
+	// FromDate is the start of the next query.
+	FromDate time.Time `json:"from_date"`
+}
+
+// CursorStore persists a feed's cursor.
+type CursorStore interface {
+	// LoadCursor returns the feed's cursor or nil if none is saved.
+	LoadCursor(ctx context.Context, feedID string) (*Cursor, error)
+	SaveCursor(ctx context.Context, feedID string, c *Cursor) error
+}
+
+// Feed polls for envelope status changes using the list status changes
+// call.  Date ranges returning more than MaxResults changes are split so
+// that each query stays within DocuSign's result window.  The cursor is
+// saved after the changes of each range are processed.
+//
+// A range includes changes made at its end time, and the next range begins
+// at that time, so a change at the boundary is read twice.  Set Events to
+// drop the repeat.
+type Feed struct {
+	Credential esign.Credential
+	// ID identifies the feed's cursor in Store.
+	ID    string
+	Store CursorStore
+	// Start is the first FromDate when no cursor is saved.  If zero,
+	// the feed starts at the time of the first poll.
+	Start time.Time
+	// FromToStatus limits changes to an envelope status.  If empty,
+	// all changes are returned.
+	FromToStatus string
+	// Include lists additional information (e.g. recipients) to return
+	// with each envelope.
+	Include string
+	// PageSize is the number of envelopes requested per call.  If 0,
+	// DefaultPageSize is used.
+	PageSize int
+	// MaxResults is the largest result set allowed before a date range is
+	// split.  If 0, DefaultMaxResults is used.
+	MaxResults int
+	// Events, if not nil, drops changes already passed to OnEvent.  Use the
+	// same store as an esign.ConnectHandler to combine push and pull modes.
+	// A change and the Connect message of the same change are matched by
+	// the time the envelope entered its status.
+	Events esign.ConnectEventStore
+	// OnEvent is called for each change.  An error stops the poll without
+	// advancing the cursor past the event's date range.
+	OnEvent func(context.Context, *esign.ConnectEvent) error
+}
+
+// Run polls every interval until ctx is done or a poll fails.
+func (f *Feed) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("changefeed: interval must be positive")
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := f.Poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Poll processes changes from the saved cursor to the current time.
+func (f *Feed) Poll(ctx context.Context) error {
+	if f.Store == nil || f.OnEvent == nil {
+		return errors.New("changefeed: Store and OnEvent must be set")
+	}
+	c, err := f.Store.LoadCursor(ctx, f.ID)
+	if err != nil {
+		return err
+	}
+	to := time.Now().UTC().Truncate(time.Second)
+	from := f.Start
+	if c != nil {
+		from = c.FromDate
+	}
+	if from.IsZero() {
+		return f.Store.SaveCursor(ctx, f.ID, &Cursor{FromDate: to})
+	}
+	if !from.Before(to) {
+		return nil
+	}
+	return f.pollRange(ctx, from, to)
+}
+
+// pollRange processes the changes between from and to splitting the range
+// if the result set is too large.
+func (f *Feed) pollRange(ctx context.Context, from, to time.Time) error {
+	res, err := f.list(ctx, from, to, 0, "")
+	if err != nil {
+		return err
+	}
+	total, _ := strconv.Atoi(res.TotalSetSize)
+	if mid := from.Add(to.Sub(from) / 2).Truncate(time.Second); total > f.maxResults() && mid.After(from) && mid.Before(to) {
+		if err = f.pollRange(ctx, from, mid); err != nil {
+			return err
+		}
+		return f.pollRange(ctx, mid, to)
+	}
+	for pos := 0; ; {
+		for i := range res.Envelopes {
+			if err = f.emit(ctx, &res.Envelopes[i]); err != nil {
+				return err
+			}
+		}
+		pos += len(res.Envelopes)
+		if len(res.Envelopes) == 0 || (res.NextURI == "" && pos >= total) {
+			break
+		}
+		if res, err = f.list(ctx, from, to, pos, res.ContinuationToken); err != nil {
+			return err
+		}
+	}
+	return f.Store.SaveCursor(ctx, f.ID, &Cursor{FromDate: to})
+}
+
+func (f *Feed) list(ctx context.Context, from, to time.Time, pos int, token string) (*model.EnvelopesInformation, error) {
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	op := envelopes.New(f.Credential).ListStatusChanges().
+		FromDate(from).
+		ToDate(to).
+		Count(pageSize).
+		Order("asc").
+		OrderBy("last_modified")
+	if f.FromToStatus != "" {
+		op.FromToStatus(f.FromToStatus)
+	}
+	if f.Include != "" {
+		op.Include(f.Include)
+	}
+	if pos > 0 {
+		op.StartPosition(pos)
+	}
+	if token != "" {
+		op.ContinuationToken(token)
+	}
+	return op.Do(ctx)
+}
+
+func (f *Feed) maxResults() int {
+	if f.MaxResults > 0 {
+		return f.MaxResults
+	}
+	return DefaultMaxResults
+}
+
+// emit passes the envelope's event to OnEvent unless Events shows the
+// change was already processed.
+func (f *Feed) emit(ctx context.Context, env *model.Envelope) error {
+	ev, err := esign.EnvelopeConnectEvent(env)
+	if err != nil {
+		return err
+	}
+	// polled changes have no generated time
+	mark := ev.EnvelopeMark()
+	mark.Generated = time.Time{}
+	if f.Events != nil && ev.EnvelopeID != "" {
+		prev, err := f.Events.LoadMark(ctx, ev.EnvelopeID)
+		if err != nil {
+			return err
+		}
+		if !prev.Allows(mark) {
+			return nil
+		}
+	}
+	if err = f.OnEvent(ctx, ev); err != nil {
+		return err
+	}
+	if f.Events != nil && ev.EnvelopeID != "" {
+		return f.Events.SaveMark(ctx, ev.EnvelopeID, mark)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package changefeed_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/changefeed"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+type cursorStore map[string]changefeed.Cursor
+
+func (s cursorStore) LoadCursor(ctx context.Context, feedID string) (*changefeed.Cursor, error) {
+	if c, ok := s[feedID]; ok {
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (s cursorStore) SaveCursor(ctx context.Context, feedID string, c *changefeed.Cursor) error {
+	s[feedID] = *c
+	return nil
+}
+
+const userInfo = `{"sub": "USER", "accounts": [
+	{"account_id": "ACCOUNT1", "is_default": true, "base_uri": "https://gotest.docusign.net"}]}`
+
+func TestFeed(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	// one change every 5 minutes
+	var changes []model.Envelope
+	for i := 0; i < 12; i++ {
+		tm := start.Add(time.Duration(i*5) * time.Minute)
+		changes = append(changes, model.Envelope{EnvelopeID: fmt.Sprintf("ENV%02d", i), Status: "sent", SentDateTime: &tm, StatusChangedDateTime: &tm})
+	}
+	var calls int
+	rt := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if r.URL.Path == "/oauth/userinfo" {
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewBufferString(userInfo))}, nil
+		}
+		calls++
+		q := r.URL.Query()
+		from, _ := time.Parse(time.RFC3339, q.Get("from_date"))
+		to, _ := time.Parse(time.RFC3339, q.Get("to_date"))
+		pos, _ := strconv.Atoi(q.Get("start_position"))
+		count, _ := strconv.Atoi(q.Get("count"))
+		var matches []model.Envelope
+		for _, env := range changes {
+			if !env.StatusChangedDateTime.Before(from) && env.StatusChangedDateTime.Before(to) {
+				matches = append(matches, env)
+			}
+		}
+		res := &model.EnvelopesInformation{TotalSetSize: strconv.Itoa(len(matches))}
+		if pos < len(matches) {
+			matches = matches[pos:]
+			if len(matches) > count {
+				matches = matches[:count]
+			}
+			res.Envelopes = matches
+		}
+		b, _ := json.Marshal(res)
+		return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewBuffer(b))}, nil
+	})
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		})
+
+	// ENV11's change was pushed by Connect, which generates the message
+	// after the status change.
+	pushed := &esign.ConnectEvent{
+		Event:       "envelope-sent",
+		EnvelopeID:  "ENV11",
+		Sent:        *changes[11].SentDateTime,
+		GeneratedAt: changes[11].SentDateTime.Add(3 * time.Second),
+	}
+	eventStore := &esign.MemoryEventStore{}
+	if err := eventStore.SaveMark(ctx, pushed.EnvelopeID, pushed.EnvelopeMark()); err != nil {
+		t.Fatalf("SaveMark: %v", err)
+	}
+
+	var events []*esign.ConnectEvent
+	var fail bool
+	store := cursorStore{}
+	f := &changefeed.Feed{
+		Credential: cred,
+		ID:         "ACCOUNT1",
+		Store:      store,
+		Start:      start,
+		PageSize:   2,
+		MaxResults: 4,
+		Events:     eventStore,
+		OnEvent: func(ctx context.Context, ev *esign.ConnectEvent) error {
+			if fail && ev.EnvelopeID == "ENV07" {
+				return errors.New("handler failed")
+			}
+			events = append(events, ev)
+			return nil
+		},
+	}
+	fail = true
+	if err := f.Poll(ctx); err == nil {
+		t.Fatalf("expected handler error; got success")
+	}
+	saved := store["ACCOUNT1"].FromDate
+	if !saved.After(start) || !saved.Before(*changes[7].StatusChangedDateTime) {
+		t.Errorf("expected cursor saved before failed change; got %v", saved)
+	}
+	fail = false
+	if err := f.Poll(ctx); err != nil {
+		t.Fatalf("Poll: %v", err)
+	}
+	if len(events) != 11 {
+		t.Fatalf("expected 11 events with pushed change dropped; got %d", len(events))
+	}
+	for i, ev := range events {
+		if ev.EnvelopeID != changes[i].EnvelopeID || ev.Event != "envelope-sent" || !ev.GeneratedAt.Equal(*changes[i].StatusChangedDateTime) {
+			t.Errorf("event %d: expected %s; got %#v", i, changes[i].EnvelopeID, ev)
+		}
+	}
+	if calls < 8 {
+		t.Errorf("expected ranges split and paged; got %d calls", calls)
+	}
+
+	// replayed ranges are dropped by the event store
+	store["ACCOUNT1"] = changefeed.Cursor{FromDate: start}
+	if err := f.Poll(ctx); err != nil || len(events) != 11 {
+		t.Errorf("expected duplicates dropped; got %d %v", len(events), err)
+	}
+
+	if err := f.Run(ctx, 0); err == nil {
+		t.Errorf("expected interval error; got success")
+	}
+}
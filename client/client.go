@@ -0,0 +1,161 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package client provides a single entry point to the v2.1 eSignature
+// and click services.  A Client holds a credential wrapped with shared
+// middleware (retry, logging, rate limiting and timeouts).
+//
+//	c := client.New(cred,
+//		client.WithTimeout(30*time.Second),
+//		client.WithMiddleware(client.Retry(3, time.Second), client.Logging(log.Printf)),
+//	)
+//	env, err := c.Envelopes().Get(envelopeID).Do(ctx)
+//	acct2, err := c.WithAccountID(otherAccountID)
+package client // import "github.com/jfcote87/esign/client"
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/legacy"
+)
+
+// Client exposes the DocuSign services using a shared credential
+// and middleware.
+type Client struct {
+	base       esign.Credential
+	cred       esign.Credential // base wrapped with middleware
+	middleware []Middleware
+	timeout    time.Duration
+	version    *esign.APIVersion
+}
+
+// Option sets a Client default.
+type Option func(*Client)
+
+// WithMiddleware adds middleware to the client.  The first middleware
+// listed is the first to receive a request.
+func WithMiddleware(m ...Middleware) Option {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, m...)
+	}
+}
+
+// WithTimeout limits the time of each request, including reading of
+// the response body.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// WithOpVersion sets the version used by ops created with Client.Op.
+// esign.VersionV21 is the default.  The service accessors always use
+// v2.1; create v2 services with the client's Credential.
+func WithOpVersion(v *esign.APIVersion) Option {
+	return func(c *Client) {
+		c.version = v
+	}
+}
+
+// New creates a client for cred.
+func New(cred esign.Credential, opts ...Option) *Client {
+	c := &Client{base: cred, version: esign.VersionV21}
+	for _, o := range opts {
+		o(c)
+	}
+	c.cred = c.wrap(cred)
+	return c
+}
+
+// wrap applies the timeout and middleware to cred.
+func (c *Client) wrap(cred esign.Credential) esign.Credential {
+	if c.timeout > 0 {
+		cred = timeout(c.timeout)(cred)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		cred = c.middleware[i](cred)
+	}
+	return cred
+}
+
+// Credential returns the client's credential including middleware.
+func (c *Client) Credential() esign.Credential {
+	return c.cred
+}
+
+// Op creates an op using the client's credential and API version for calls
+// not covered by the service packages.
+func (c *Client) Op(method, path string, payload interface{}) *esign.Op {
+	return &esign.Op{
+		Credential: c.cred,
+		Method:     method,
+		Path:       path,
+		Payload:    payload,
+		QueryOpts:  make(url.Values),
+		Version:    c.version,
+	}
+}
+
+// WithCredential returns a client using cred with the same middleware
+// and defaults (e.g. for a user's credential from an esign.CredentialPool).
+func (c *Client) WithCredential(cred esign.Credential) *Client {
+	c2 := *c
+	c2.base = cred
+	c2.cred = c2.wrap(cred)
+	return &c2
+}
+
+// WithAccountID returns a client for another of the user's accounts.  The
+// client's credential must be an *esign.OAuth2Credential, legacy.Config or
+// legacy.OauthCredential.
+func (c *Client) WithAccountID(accountID string) (*Client, error) {
+	switch cred := c.base.(type) {
+	case *esign.OAuth2Credential:
+		return c.WithCredential(cred.WithAccountID(accountID)), nil
+	case legacy.Config:
+		cred.AccountID = accountID
+		return c.WithCredential(cred), nil
+	case *legacy.Config:
+		cred2 := *cred
+		cred2.AccountID = accountID
+		return c.WithCredential(&cred2), nil
+	case legacy.OauthCredential:
+		cred.AccountID = accountID
+		return c.WithCredential(cred), nil
+	case *legacy.OauthCredential:
+		cred2 := *cred
+		cred2.AccountID = accountID
+		return c.WithCredential(&cred2), nil
+	}
+	return nil, fmt.Errorf("credential type %T does not support account selection", c.base)
+}
+
+// WithActAsUser returns a client that sends requests on behalf of the
+// user identified by userIDOrEmail.  The client's credential must be an
+// *esign.OAuth2Credential, legacy.Config or legacy.OauthCredential.
+func (c *Client) WithActAsUser(userIDOrEmail string) (*Client, error) {
+	switch cred := c.base.(type) {
+	case *esign.OAuth2Credential:
+		return c.WithCredential(cred.WithActAsUser(userIDOrEmail)), nil
+	case legacy.Config:
+		cred.OnBehalfOf = userIDOrEmail
+		return c.WithCredential(cred), nil
+	case *legacy.Config:
+		cred2 := *cred
+		cred2.OnBehalfOf = userIDOrEmail
+		return c.WithCredential(&cred2), nil
+	case legacy.OauthCredential:
+		cred.OnBehalfOf = userIDOrEmail
+		return c.WithCredential(cred), nil
+	case *legacy.OauthCredential:
+		cred2 := *cred
+		cred2.OnBehalfOf = userIDOrEmail
+		return c.WithCredential(&cred2), nil
+	}
+	return nil, fmt.Errorf("credential type %T does not support send on behalf of", c.base)
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/client"
+	"github.com/jfcote87/esign/legacy"
+	"github.com/jfcote87/testutils"
+)
+
+const userInfo = `{"sub": "USER", "accounts": [
+	{"account_id": "ACCOUNT1", "is_default": true, "base_uri": "https://gotest.docusign.net"},
+	{"account_id": "ACCOUNT2", "base_uri": "https://gotest2.docusign.net"}]}`
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	var logs []string
+	c := client.New(cred,
+		client.WithTimeout(time.Minute),
+		client.WithMiddleware(
+			client.Logging(func(format string, args ...interface{}) {
+				logs = append(logs, fmt.Sprintf(format, args...))
+			}),
+			client.Retry(2, time.Millisecond),
+		),
+	)
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfo), nil),
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/envelopes/ENVID",
+		Response: testutils.MakeResponse(503, []byte(`{"errorCode":"UNAVAILABLE"}`), nil),
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/envelopes/ENVID",
+		Response: testutils.MakeResponse(200, []byte(`{"envelopeId":"ENVID"}`), nil),
+	})
+	env, err := c.Envelopes().Get("ENVID").Do(ctx)
+	if err != nil {
+		t.Fatalf("expected envelope after retry; got %v", err)
+	}
+	if env.EnvelopeID != "ENVID" {
+		t.Errorf("expected ENVID; got %s", env.EnvelopeID)
+	}
+	if len(logs) != 1 || !strings.HasPrefix(logs[0], "esign: GET envelopes/ENVID 200") {
+		t.Errorf("expected single log entry; got %v", logs)
+	}
+
+	// retries exhausted on posts with recreatable bodies
+	for i := 0; i < 3; i++ {
+		testTransport.Add(&testutils.RequestTester{
+			Path:     "/restapi/v2.1/accounts/ACCOUNT1/custom",
+			Method:   "POST",
+			Payload:  []byte("{\"a\":\"b\"}\n"),
+			Response: testutils.MakeResponse(429, []byte(`{"errorCode":"HOURLY_APIINVOCATION_LIMIT_EXCEEDED"}`), nil),
+		})
+	}
+	if err = c.Op("POST", "custom", map[string]string{"a": "b"}).Do(ctx, nil); err == nil || err.(*esign.ResponseError).Status != 429 {
+		t.Errorf("expected 429 after retries; got %v", err)
+	}
+	if len(testTransport.Queue) != 0 {
+		t.Errorf("expected 3 attempts; %d requests remain", len(testTransport.Queue))
+	}
+
+	// posts are not retried on 5xx unless allowed
+	unavailable := func() *testutils.RequestTester {
+		return &testutils.RequestTester{
+			Path:     "/restapi/v2.1/accounts/ACCOUNT1/custom",
+			Method:   "POST",
+			Response: testutils.MakeResponse(503, []byte(`{"errorCode":"UNAVAILABLE"}`), nil),
+		}
+	}
+	testTransport.Add(unavailable())
+	if err = c.Op("POST", "custom", map[string]string{"a": "b"}).Do(ctx, nil); err == nil || err.(*esign.ResponseError).Status != 503 {
+		t.Errorf("expected 503 without retry; got %v", err)
+	}
+	testTransport.Add(unavailable(), &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/custom",
+		Method:   "POST",
+		Response: testutils.MakeResponse(200, []byte(`{}`), nil),
+	})
+	if err = c.Op("POST", "custom", map[string]string{"a": "b"}).Do(client.AllowRetry(ctx), nil); err != nil {
+		t.Errorf("expected allowed post retry; got %v", err)
+	}
+	if len(testTransport.Queue) != 0 {
+		t.Errorf("expected post attempts; %d requests remain", len(testTransport.Queue))
+	}
+
+	// derived client for second account keeps middleware
+	c2, err := c.WithAccountID("ACCOUNT2")
+	if err != nil {
+		t.Fatalf("WithAccountID: %v", err)
+	}
+	testTransport.Add(&testutils.RequestTester{
+		Host:     "gotest2.docusign.net",
+		Path:     "/restapi/v2.1/accounts/ACCOUNT2/folders",
+		Response: testutils.MakeResponse(200, []byte(`{}`), nil),
+	})
+	if _, err = c2.Folders().List().Do(ctx); err != nil {
+		t.Errorf("expected account 2 folders; got %v", err)
+	}
+	if len(logs) != 5 {
+		t.Errorf("expected derived client logging; got %v", logs)
+	}
+
+	lc := client.New(legacy.Config{IntegratorKey: "KEY"})
+	if _, err = lc.WithActAsUser("user@example.com"); err != nil {
+		t.Errorf("expected legacy send on behalf of; got %v", err)
+	}
+	oc, err := client.New(legacy.OauthCredential{AccessToken: "TOKEN"}).WithAccountID("ACCOUNT2")
+	if err != nil {
+		t.Errorf("expected legacy oauth credential value account selection; got %v", err)
+	} else if _, err = oc.WithActAsUser("user@example.com"); err != nil {
+		t.Errorf("expected legacy oauth credential value send on behalf of; got %v", err)
+	}
+	if _, err = client.New(client.CredentialFunc(nil)).WithAccountID("X"); err == nil {
+		t.Errorf("expected unsupported credential error; got success")
+	}
+}
+
+func TestRetry_retryAfter(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	// Retry-After overrides the hour long backoff
+	c := client.New(cred, client.WithMiddleware(client.Retry(2, time.Hour)))
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfo), nil),
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/envelopes/ENVID",
+		Response: testutils.MakeResponse(429, []byte(`{"errorCode":"HOURLY_APIINVOCATION_LIMIT_EXCEEDED"}`), http.Header{"Retry-After": {"0"}}),
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/envelopes/ENVID",
+		Response: testutils.MakeResponse(503, []byte(`{"errorCode":"UNAVAILABLE"}`), http.Header{"Retry-After": {time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)}}),
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/envelopes/ENVID",
+		Response: testutils.MakeResponse(200, []byte(`{"envelopeId":"ENVID"}`), nil),
+	})
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	if _, err := c.Envelopes().Get("ENVID").Do(ctx); err != nil {
+		t.Fatalf("expected envelope after Retry-After; got %v", err)
+	}
+	if len(testTransport.Queue) != 0 {
+		t.Errorf("expected all requests sent; %d remain", len(testTransport.Queue))
+	}
+}
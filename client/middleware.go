@@ -0,0 +1,184 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jfcote87/esign"
+)
+
+// Middleware wraps a credential to add behavior to each request.
+type Middleware func(next esign.Credential) esign.Credential
+
+// CredentialFunc allows a func to be used as an esign.Credential.
+type CredentialFunc func(context.Context, *http.Request, *esign.APIVersion) (*http.Response, error)
+
+// AuthDo calls f.
+func (f CredentialFunc) AuthDo(ctx context.Context, req *http.Request, v *esign.APIVersion) (*http.Response, error) {
+	return f(ctx, req, v)
+}
+
+// Retry resends requests receiving a 429 or 5xx (other than 501) status
+// or a network timeout up to maxRetries times. The wait between attempts
+// starts at backoff and doubles after each attempt unless a 429 or 503
+// response includes a Retry-After header. Requests whose body cannot be
+// recreated (e.g. file uploads) are not retried.
+//
+// Only idempotent requests (GET, HEAD, PUT and DELETE) are retried after a
+// 5xx status or network error, as DocuSign may have already processed the
+// request (e.g. a POST creating an envelope).  Other methods are retried
+// only after a 429 status unless the request's context was created with
+// AllowRetry.
+func Retry(maxRetries int, backoff time.Duration) Middleware {
+	return func(next esign.Credential) esign.Credential {
+		return CredentialFunc(func(ctx context.Context, req *http.Request, v *esign.APIVersion) (*http.Response, error) {
+			wait := backoff
+			idempotent := isIdempotent(req.Method) || ctx.Value(allowRetryKey{}) != nil
+			for attempt := 0; ; attempt++ {
+				r := req
+				if attempt > 0 && req.Body != nil {
+					body, err := req.GetBody()
+					if err != nil {
+						return nil, err
+					}
+					r2 := *req
+					r2.Body = body
+					r = &r2
+				}
+				res, err := next.AuthDo(ctx, r, v)
+				if err == nil || attempt >= maxRetries || !isRetryable(err, idempotent) || (req.Body != nil && req.GetBody == nil) {
+					return res, err
+				}
+				delay := wait
+				if d, ok := retryAfter(err); ok {
+					delay = d
+				}
+				t := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					t.Stop()
+					return nil, ctx.Err()
+				case <-t.C:
+				}
+				wait *= 2
+			}
+		})
+	}
+}
+
+type allowRetryKey struct{}
+
+// AllowRetry returns a context that permits Retry to resend a
+// non-idempotent request after a 5xx status or network error.  Use only
+// when a duplicate request is harmless or detected by the caller.
+func AllowRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allowRetryKey{}, true)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isRetryable(err error, idempotent bool) bool {
+	var respErr *esign.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.Status == http.StatusTooManyRequests ||
+			(idempotent && respErr.Status >= 500 && respErr.Status != http.StatusNotImplemented)
+	}
+	var netErr net.Error
+	return idempotent && errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// retryAfter returns the wait specified by the Retry-After header of a 429
+// or 503 response.  The header may be in seconds or an http date.
+func retryAfter(err error) (time.Duration, bool) {
+	var respErr *esign.ResponseError
+	if !errors.As(err, &respErr) || (respErr.Status != http.StatusTooManyRequests && respErr.Status != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+	v := respErr.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	tm, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := time.Until(tm); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// Logging calls logf after each request with the method, path,
+// status and duration of the call.
+func Logging(logf func(format string, args ...interface{})) Middleware {
+	return func(next esign.Credential) esign.Credential {
+		return CredentialFunc(func(ctx context.Context, req *http.Request, v *esign.APIVersion) (*http.Response, error) {
+			method, path := req.Method, req.URL.Path
+			tm := time.Now()
+			res, err := next.AuthDo(ctx, req, v)
+			if err != nil {
+				logf("esign: %s %s error %v (%v)", method, path, err, time.Since(tm))
+				return res, err
+			}
+			logf("esign: %s %s %d (%v)", method, path, res.StatusCode, time.Since(tm))
+			return res, err
+		})
+	}
+}
+
+// RateLimit limits requests to requestsPerSecond allowing bursts of up
+// to burst requests.  Clients derived using WithAccountID, WithActAsUser
+// or WithCredential share the limit.
+func RateLimit(requestsPerSecond float64, burst int) Middleware {
+	l := esign.NewRateLimiter(requestsPerSecond, burst)
+	return func(next esign.Credential) esign.Credential {
+		return esign.RateLimited(next, l)
+	}
+}
+
+// timeout cancels the request's context after d.  The context remains
+// active until the response body is closed.
+func timeout(d time.Duration) Middleware {
+	return func(next esign.Credential) esign.Credential {
+		return CredentialFunc(func(ctx context.Context, req *http.Request, v *esign.APIVersion) (*http.Response, error) {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			res, err := next.AuthDo(ctx, req, v)
+			if err != nil {
+				cancel()
+				return res, err
+			}
+			res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+			return res, nil
+		})
+	}
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
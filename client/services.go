@@ -0,0 +1,111 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"github.com/jfcote87/esign/click"
+	"github.com/jfcote87/esign/v2.1/accounts"
+	"github.com/jfcote87/esign/v2.1/billing"
+	"github.com/jfcote87/esign/v2.1/bulkenvelopes"
+	"github.com/jfcote87/esign/v2.1/cloudstorage"
+	"github.com/jfcote87/esign/v2.1/connect"
+	"github.com/jfcote87/esign/v2.1/customtabs"
+	"github.com/jfcote87/esign/v2.1/diagnostics"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/folders"
+	"github.com/jfcote87/esign/v2.1/powerforms"
+	"github.com/jfcote87/esign/v2.1/signinggroups"
+	"github.com/jfcote87/esign/v2.1/templates"
+	"github.com/jfcote87/esign/v2.1/uncategorized"
+	"github.com/jfcote87/esign/v2.1/usergroups"
+	"github.com/jfcote87/esign/v2.1/users"
+	"github.com/jfcote87/esign/v2.1/workspaces"
+)
+
+// Accounts returns the v2.1 accounts service.
+func (c *Client) Accounts() *accounts.Service {
+	return accounts.New(c.cred)
+}
+
+// Billing returns the v2.1 billing service.
+func (c *Client) Billing() *billing.Service {
+	return billing.New(c.cred)
+}
+
+// BulkEnvelopes returns the v2.1 bulkenvelopes service.
+func (c *Client) BulkEnvelopes() *bulkenvelopes.Service {
+	return bulkenvelopes.New(c.cred)
+}
+
+// CloudStorage returns the v2.1 cloudstorage service.
+func (c *Client) CloudStorage() *cloudstorage.Service {
+	return cloudstorage.New(c.cred)
+}
+
+// Connect returns the v2.1 connect service.
+func (c *Client) Connect() *connect.Service {
+	return connect.New(c.cred)
+}
+
+// CustomTabs returns the v2.1 customtabs service.
+func (c *Client) CustomTabs() *customtabs.Service {
+	return customtabs.New(c.cred)
+}
+
+// Diagnostics returns the v2.1 diagnostics service.
+func (c *Client) Diagnostics() *diagnostics.Service {
+	return diagnostics.New(c.cred)
+}
+
+// Envelopes returns the v2.1 envelopes service.
+func (c *Client) Envelopes() *envelopes.Service {
+	return envelopes.New(c.cred)
+}
+
+// Folders returns the v2.1 folders service.
+func (c *Client) Folders() *folders.Service {
+	return folders.New(c.cred)
+}
+
+// PowerForms returns the v2.1 powerforms service.
+func (c *Client) PowerForms() *powerforms.Service {
+	return powerforms.New(c.cred)
+}
+
+// SigningGroups returns the v2.1 signinggroups service.
+func (c *Client) SigningGroups() *signinggroups.Service {
+	return signinggroups.New(c.cred)
+}
+
+// Templates returns the v2.1 templates service.
+func (c *Client) Templates() *templates.Service {
+	return templates.New(c.cred)
+}
+
+// Uncategorized returns the v2.1 uncategorized service.
+func (c *Client) Uncategorized() *uncategorized.Service {
+	return uncategorized.New(c.cred)
+}
+
+// UserGroups returns the v2.1 usergroups service.
+func (c *Client) UserGroups() *usergroups.Service {
+	return usergroups.New(c.cred)
+}
+
+// Users returns the v2.1 users service.
+func (c *Client) Users() *users.Service {
+	return users.New(c.cred)
+}
+
+// Workspaces returns the v2.1 workspaces service.
+func (c *Client) Workspaces() *workspaces.Service {
+	return workspaces.New(c.cred)
+}
+
+// Click returns the click api service.
+func (c *Client) Click() *click.Service {
+	return click.New(c.cred)
+}
@@ -0,0 +1,2542 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package accounts defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 accounts services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/accounts"
+//	)
+//	...
+//	accountsService := accounts.New(esignCredential, cfg.UseV2)
+package accounts // import "github.com/jfcote87/esign/compat/accounts"
+
+import (
+	"context"
+	"io"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/accounts"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2accounts "github.com/jfcote87/esign/v2/accounts"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 accounts services.
+type Service interface {
+	// BrandsCreate creates one or more brand profiles for an account.
+	BrandsCreate(brand *model.Brand) BrandsCreateOp
+	// BrandsDelete deletes a brand.
+	BrandsDelete(brandID string) BrandsDeleteOp
+	// BrandsDeleteList deletes one or more brand profiles.
+	BrandsDeleteList(brandsRequest *model.BrandsRequest) BrandsDeleteListOp
+	// BrandsDeleteLogo deletes a brand logo.
+	BrandsDeleteLogo(brandID string, logoType string) BrandsDeleteLogoOp
+	// BrandsGet gets information about a brand.
+	BrandsGet(brandID string) BrandsGetOp
+	// BrandsGetExportFile export a brand.
+	BrandsGetExportFile(brandID string) BrandsGetExportFileOp
+	// BrandsGetLogo gets a brand logo.
+	BrandsGetLogo(brandID string, logoType string) BrandsGetLogoOp
+	// BrandsGetResource returns a branding resource file.
+	BrandsGetResource(brandID string, resourceContentType string) BrandsGetResourceOp
+	// BrandsList gets a list of brands.
+	BrandsList() BrandsListOp
+	// BrandsListResources returns metadata about the branding resources for an account.
+	BrandsListResources(brandID string) BrandsListResourcesOp
+	// BrandsUpdate updates an existing brand.
+	BrandsUpdate(brandID string, brand *model.Brand) BrandsUpdateOp
+	// BrandsUpdateLogo updates a brand logo.
+	BrandsUpdateLogo(brandID string, logoType string, logoFileBytes []byte) BrandsUpdateLogoOp
+	// BrandsUpdateResource updates a branding resource file.
+	BrandsUpdateResource(brandID string, resourceContentType string, media io.Reader, mimeType string) BrandsUpdateResourceOp
+	// ConsumerDisclosuresGet gets the Electronic Record and Signature Disclosure.
+	ConsumerDisclosuresGet(langCode string) ConsumerDisclosuresGetOp
+	// ConsumerDisclosuresGetDefault gets the Electronic Record and Signature Disclosure for the account.
+	ConsumerDisclosuresGetDefault() ConsumerDisclosuresGetDefaultOp
+	// ConsumerDisclosuresUpdate update Consumer Disclosure.
+	ConsumerDisclosuresUpdate(langCode string, envelopeConsumerDisclosures *model.ConsumerDisclosure) ConsumerDisclosuresUpdateOp
+	// CustomFieldsCreate creates an acount custom field.
+	CustomFieldsCreate(customField *model.CustomField) CustomFieldsCreateOp
+	// CustomFieldsDelete delete an existing account custom field.
+	CustomFieldsDelete(customFieldID string) CustomFieldsDeleteOp
+	// CustomFieldsList gets a list of custom fields associated with the account.
+	CustomFieldsList() CustomFieldsListOp
+	// CustomFieldsUpdate updates an existing account custom field.
+	CustomFieldsUpdate(customFieldID string, customField *model.CustomField) CustomFieldsUpdateOp
+	// PasswordRulesGet get the password rules
+	PasswordRulesGet() PasswordRulesGetOp
+	// PasswordRulesGetForUser get membership account password rules
+	PasswordRulesGetForUser() PasswordRulesGetForUserOp
+	// PasswordRulesUpdate update the password rules
+	PasswordRulesUpdate(accountPasswordRules *model.AccountPasswordRules) PasswordRulesUpdateOp
+	// PermissionProfilesCreate creates a new permission profile for an account.
+	PermissionProfilesCreate(accountPermissionProfiles *model.PermissionProfile) PermissionProfilesCreateOp
+	// PermissionProfilesDelete deletes a permission profile from an account.
+	PermissionProfilesDelete(permissionProfileID string) PermissionProfilesDeleteOp
+	// PermissionProfilesGet returns a permission profile for an account.
+	PermissionProfilesGet(permissionProfileID string) PermissionProfilesGetOp
+	// PermissionProfilesList gets a list of permission profiles.
+	PermissionProfilesList() PermissionProfilesListOp
+	// PermissionProfilesUpdate updates a permission profile.
+	PermissionProfilesUpdate(permissionProfileID string, accountPermissionProfiles *model.PermissionProfile) PermissionProfilesUpdateOp
+	// SignatureProvidersList returns Account available signature providers for specified account.
+	SignatureProvidersList() SignatureProvidersListOp
+	// TabSettingsGet returns tab settings list for specified account
+	TabSettingsGet() TabSettingsGetOp
+	// TabSettingsUpdate modifies tab settings for specified account
+	TabSettingsUpdate(accountTabSettings *model.TabAccountSettings) TabSettingsUpdateOp
+	// WatermarksGet get watermark information.
+	WatermarksGet() WatermarksGetOp
+	// WatermarksPreview get watermark preview.
+	WatermarksPreview(accountWatermarks *model.Watermark) WatermarksPreviewOp
+	// WatermarksUpdate update watermark information.
+	WatermarksUpdate(accountWatermarks *model.Watermark) WatermarksUpdateOp
+	// Create creates new accounts.
+	Create(newAccountDefinition *model.NewAccountDefinition) CreateOp
+	// Delete deletes the specified account.
+	Delete() DeleteOp
+	// DeleteCaptiveRecipient deletes the signature for one or more captive recipient records.
+	DeleteCaptiveRecipient(recipientPart string, captiveRecipientInformation *model.CaptiveRecipientInformation) DeleteCaptiveRecipientOp
+	// Get retrieves the account information for the specified account.
+	Get() GetOp
+	// GetBillingCharges gets list of recurring and usage charges for the account.
+	GetBillingCharges() GetBillingChargesOp
+	// GetProvisioning retrieves the account provisioning information for the account.
+	GetProvisioning() GetProvisioningOp
+	// ListRecipientNamesByEmail gets recipient names associated with an email address.
+	ListRecipientNamesByEmail() ListRecipientNamesByEmailOp
+	// ListSettings gets account settings information.
+	ListSettings() ListSettingsOp
+	// ListSharedAccess reserved: Gets the shared item status for one or more users.
+	ListSharedAccess() ListSharedAccessOp
+	// ListSupportedLanguages list supported languages for the recipient language setting
+	ListSupportedLanguages() ListSupportedLanguagesOp
+	// ListUnsupportedFileTypes gets a list of unsupported file types.
+	ListUnsupportedFileTypes() ListUnsupportedFileTypesOp
+	// UpdateSettings updates the account settings for an account.
+	UpdateSettings(accountSettingsInformation *model.AccountSettingsInformation) UpdateSettingsOp
+	// UpdateSharedAccess reserved: Sets the shared access information for users.
+	UpdateSharedAccess(accountSharedAccess *model.AccountSharedAccess) UpdateSharedAccessOp
+	// ENoteConfigurationsDelete deletes configuration information for the eNote eOriginal integration.
+	ENoteConfigurationsDelete() ENoteConfigurationsDeleteOp
+	// ENoteConfigurationsGet returns the configuration information for the eNote eOriginal integration.
+	ENoteConfigurationsGet() ENoteConfigurationsGetOp
+	// ENoteConfigurationsUpdate updates configuration information for the eNote eOriginal integration.
+	ENoteConfigurationsUpdate(eNoteConfigurations *model.ENoteConfiguration) ENoteConfigurationsUpdateOp
+	// PaymentGatewayAccountsList list payment gateway account information
+	PaymentGatewayAccountsList() PaymentGatewayAccountsListOp
+	// SealProvidersList is SDK Method Accounts::getSealProviders
+	SealProvidersList() SealProvidersListOp
+	// IdentityVerificationsList retrieves the list of identity verification workflows available to an account
+	IdentityVerificationsList() IdentityVerificationsListOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2accounts.New(cred)}
+	}
+	return v21Service{s: accounts.New(cred)}
+}
+
+// BrandsCreateOp is the version-neutral BrandsCreate op.
+type BrandsCreateOp interface {
+	Do(ctx context.Context) (*model.BrandsResponse, error)
+}
+
+// BrandsDeleteOp is the version-neutral BrandsDelete op.
+type BrandsDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// BrandsDeleteListOp is the version-neutral BrandsDeleteList op.
+type BrandsDeleteListOp interface {
+	Do(ctx context.Context) (*model.BrandsResponse, error)
+}
+
+// BrandsDeleteLogoOp is the version-neutral BrandsDeleteLogo op.
+type BrandsDeleteLogoOp interface {
+	Do(ctx context.Context) error
+}
+
+// BrandsGetOp is the version-neutral BrandsGet op.
+type BrandsGetOp interface {
+	IncludeExternalReferences() BrandsGetOp
+	IncludeLogos() BrandsGetOp
+	Do(ctx context.Context) (*model.Brand, error)
+}
+
+// BrandsGetExportFileOp is the version-neutral BrandsGetExportFile op.
+type BrandsGetExportFileOp interface {
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// BrandsGetLogoOp is the version-neutral BrandsGetLogo op.
+type BrandsGetLogoOp interface {
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// BrandsGetResourceOp is the version-neutral BrandsGetResource op.
+type BrandsGetResourceOp interface {
+	Langcode(val string) BrandsGetResourceOp
+	ReturnMaster() BrandsGetResourceOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// BrandsListOp is the version-neutral BrandsList op.
+type BrandsListOp interface {
+	ExcludeDistributorBrand() BrandsListOp
+	IncludeLogos() BrandsListOp
+	Do(ctx context.Context) (*model.BrandsResponse, error)
+}
+
+// BrandsListResourcesOp is the version-neutral BrandsListResources op.
+type BrandsListResourcesOp interface {
+	Do(ctx context.Context) (*model.BrandResourcesList, error)
+}
+
+// BrandsUpdateOp is the version-neutral BrandsUpdate op.
+type BrandsUpdateOp interface {
+	Do(ctx context.Context) (*model.Brand, error)
+}
+
+// BrandsUpdateLogoOp is the version-neutral BrandsUpdateLogo op.
+type BrandsUpdateLogoOp interface {
+	Do(ctx context.Context) error
+}
+
+// BrandsUpdateResourceOp is the version-neutral BrandsUpdateResource op.
+type BrandsUpdateResourceOp interface {
+	Do(ctx context.Context) (*model.BrandResources, error)
+}
+
+// ConsumerDisclosuresGetOp is the version-neutral ConsumerDisclosuresGet op.
+type ConsumerDisclosuresGetOp interface {
+	Do(ctx context.Context) (*model.ConsumerDisclosure, error)
+}
+
+// ConsumerDisclosuresGetDefaultOp is the version-neutral ConsumerDisclosuresGetDefault op.
+type ConsumerDisclosuresGetDefaultOp interface {
+	LangCode(val string) ConsumerDisclosuresGetDefaultOp
+	Do(ctx context.Context) (*model.ConsumerDisclosure, error)
+}
+
+// ConsumerDisclosuresUpdateOp is the version-neutral ConsumerDisclosuresUpdate op.
+type ConsumerDisclosuresUpdateOp interface {
+	IncludeMetadata(val string) ConsumerDisclosuresUpdateOp
+	Do(ctx context.Context) (*model.ConsumerDisclosure, error)
+}
+
+// CustomFieldsCreateOp is the version-neutral CustomFieldsCreate op.
+type CustomFieldsCreateOp interface {
+	ApplyToTemplates() CustomFieldsCreateOp
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsDeleteOp is the version-neutral CustomFieldsDelete op.
+type CustomFieldsDeleteOp interface {
+	ApplyToTemplates() CustomFieldsDeleteOp
+	Do(ctx context.Context) error
+}
+
+// CustomFieldsListOp is the version-neutral CustomFieldsList op.
+type CustomFieldsListOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsUpdateOp is the version-neutral CustomFieldsUpdate op.
+type CustomFieldsUpdateOp interface {
+	ApplyToTemplates() CustomFieldsUpdateOp
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// PasswordRulesGetOp is the version-neutral PasswordRulesGet op.
+type PasswordRulesGetOp interface {
+	Do(ctx context.Context) (*model.AccountPasswordRules, error)
+}
+
+// PasswordRulesGetForUserOp is the version-neutral PasswordRulesGetForUser op.
+type PasswordRulesGetForUserOp interface {
+	Do(ctx context.Context) (*model.UserPasswordRules, error)
+}
+
+// PasswordRulesUpdateOp is the version-neutral PasswordRulesUpdate op.
+type PasswordRulesUpdateOp interface {
+	Do(ctx context.Context) (*model.AccountPasswordRules, error)
+}
+
+// PermissionProfilesCreateOp is the version-neutral PermissionProfilesCreate op.
+type PermissionProfilesCreateOp interface {
+	Include(val ...string) PermissionProfilesCreateOp
+	Do(ctx context.Context) (*model.PermissionProfile, error)
+}
+
+// PermissionProfilesDeleteOp is the version-neutral PermissionProfilesDelete op.
+type PermissionProfilesDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// PermissionProfilesGetOp is the version-neutral PermissionProfilesGet op.
+type PermissionProfilesGetOp interface {
+	Include(val ...string) PermissionProfilesGetOp
+	Do(ctx context.Context) (*model.PermissionProfile, error)
+}
+
+// PermissionProfilesListOp is the version-neutral PermissionProfilesList op.
+type PermissionProfilesListOp interface {
+	Include(val string) PermissionProfilesListOp
+	Do(ctx context.Context) (*model.PermissionProfileInformation, error)
+}
+
+// PermissionProfilesUpdateOp is the version-neutral PermissionProfilesUpdate op.
+type PermissionProfilesUpdateOp interface {
+	Include(val ...string) PermissionProfilesUpdateOp
+	Do(ctx context.Context) (*model.PermissionProfile, error)
+}
+
+// SignatureProvidersListOp is the version-neutral SignatureProvidersList op.
+type SignatureProvidersListOp interface {
+	Do(ctx context.Context) (*model.AccountSignatureProviders, error)
+}
+
+// TabSettingsGetOp is the version-neutral TabSettingsGet op.
+type TabSettingsGetOp interface {
+	Do(ctx context.Context) (*model.TabAccountSettings, error)
+}
+
+// TabSettingsUpdateOp is the version-neutral TabSettingsUpdate op.
+type TabSettingsUpdateOp interface {
+	Do(ctx context.Context) (*model.TabAccountSettings, error)
+}
+
+// WatermarksGetOp is the version-neutral WatermarksGet op.
+type WatermarksGetOp interface {
+	Do(ctx context.Context) (*model.Watermark, error)
+}
+
+// WatermarksPreviewOp is the version-neutral WatermarksPreview op.
+type WatermarksPreviewOp interface {
+	Do(ctx context.Context) (*model.Watermark, error)
+}
+
+// WatermarksUpdateOp is the version-neutral WatermarksUpdate op.
+type WatermarksUpdateOp interface {
+	Do(ctx context.Context) (*model.Watermark, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	PreviewBillingPlan() CreateOp
+	Do(ctx context.Context) (*model.NewAccountSummary, error)
+}
+
+// DeleteOp is the version-neutral Delete op.
+type DeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// DeleteCaptiveRecipientOp is the version-neutral DeleteCaptiveRecipient op.
+type DeleteCaptiveRecipientOp interface {
+	Do(ctx context.Context) (*model.CaptiveRecipientInformation, error)
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	IncludeAccountSettings() GetOp
+	Do(ctx context.Context) (*model.AccountInformation, error)
+}
+
+// GetBillingChargesOp is the version-neutral GetBillingCharges op.
+type GetBillingChargesOp interface {
+	IncludeCharges(val string) GetBillingChargesOp
+	Do(ctx context.Context) (*model.BillingChargeResponse, error)
+}
+
+// GetProvisioningOp is the version-neutral GetProvisioning op.
+type GetProvisioningOp interface {
+	Do(ctx context.Context) (*model.ProvisioningInformation, error)
+}
+
+// ListRecipientNamesByEmailOp is the version-neutral ListRecipientNamesByEmail op.
+type ListRecipientNamesByEmailOp interface {
+	Email(val string) ListRecipientNamesByEmailOp
+	Do(ctx context.Context) (*model.RecipientNamesResponse, error)
+}
+
+// ListSettingsOp is the version-neutral ListSettings op.
+type ListSettingsOp interface {
+	Do(ctx context.Context) (*model.AccountSettingsInformation, error)
+}
+
+// ListSharedAccessOp is the version-neutral ListSharedAccess op.
+type ListSharedAccessOp interface {
+	Count(val int) ListSharedAccessOp
+	EnvelopesNotSharedUserStatus(val string) ListSharedAccessOp
+	FolderIds(val ...string) ListSharedAccessOp
+	ItemType(val string) ListSharedAccessOp
+	SearchText(val string) ListSharedAccessOp
+	Shared(val string) ListSharedAccessOp
+	StartPosition(val int) ListSharedAccessOp
+	UserIds(val ...string) ListSharedAccessOp
+	Do(ctx context.Context) (*model.AccountSharedAccess, error)
+}
+
+// ListSupportedLanguagesOp is the version-neutral ListSupportedLanguages op.
+type ListSupportedLanguagesOp interface {
+	Do(ctx context.Context) (*model.SupportedLanguages, error)
+}
+
+// ListUnsupportedFileTypesOp is the version-neutral ListUnsupportedFileTypes op.
+type ListUnsupportedFileTypesOp interface {
+	Do(ctx context.Context) (*model.FileTypeList, error)
+}
+
+// UpdateSettingsOp is the version-neutral UpdateSettings op.
+type UpdateSettingsOp interface {
+	Do(ctx context.Context) error
+}
+
+// UpdateSharedAccessOp is the version-neutral UpdateSharedAccess op.
+type UpdateSharedAccessOp interface {
+	ItemType(val string) UpdateSharedAccessOp
+	UserIds(val ...string) UpdateSharedAccessOp
+	Do(ctx context.Context) (*model.AccountSharedAccess, error)
+}
+
+// ENoteConfigurationsDeleteOp is the version-neutral ENoteConfigurationsDelete op.
+type ENoteConfigurationsDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// ENoteConfigurationsGetOp is the version-neutral ENoteConfigurationsGet op.
+type ENoteConfigurationsGetOp interface {
+	Do(ctx context.Context) (*model.ENoteConfiguration, error)
+}
+
+// ENoteConfigurationsUpdateOp is the version-neutral ENoteConfigurationsUpdate op.
+type ENoteConfigurationsUpdateOp interface {
+	Do(ctx context.Context) (*model.ENoteConfiguration, error)
+}
+
+// PaymentGatewayAccountsListOp is the version-neutral PaymentGatewayAccountsList op.
+type PaymentGatewayAccountsListOp interface {
+	Do(ctx context.Context) (*model.PaymentGatewayAccountsInfo, error)
+}
+
+// SealProvidersListOp is the version-neutral SealProvidersList op.
+type SealProvidersListOp interface {
+	Do(ctx context.Context) (*model.AccountSeals, error)
+}
+
+// IdentityVerificationsListOp is the version-neutral IdentityVerificationsList op.
+type IdentityVerificationsListOp interface {
+	Do(ctx context.Context) (*model.AccountIdentityVerificationResponse, error)
+}
+
+type v21Service struct {
+	s *accounts.Service
+}
+
+func (s v21Service) BrandsCreate(brand *model.Brand) BrandsCreateOp {
+	return &v21BrandsCreateOp{op: s.s.BrandsCreate(brand)}
+}
+
+type v21BrandsCreateOp struct {
+	op *accounts.BrandsCreateOp
+}
+
+func (o *v21BrandsCreateOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsDelete(brandID string) BrandsDeleteOp {
+	return &v21BrandsDeleteOp{op: s.s.BrandsDelete(brandID)}
+}
+
+type v21BrandsDeleteOp struct {
+	op *accounts.BrandsDeleteOp
+}
+
+func (o *v21BrandsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsDeleteList(brandsRequest *model.BrandsRequest) BrandsDeleteListOp {
+	return &v21BrandsDeleteListOp{op: s.s.BrandsDeleteList(brandsRequest)}
+}
+
+type v21BrandsDeleteListOp struct {
+	op *accounts.BrandsDeleteListOp
+}
+
+func (o *v21BrandsDeleteListOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsDeleteLogo(brandID string, logoType string) BrandsDeleteLogoOp {
+	return &v21BrandsDeleteLogoOp{op: s.s.BrandsDeleteLogo(brandID, logoType)}
+}
+
+type v21BrandsDeleteLogoOp struct {
+	op *accounts.BrandsDeleteLogoOp
+}
+
+func (o *v21BrandsDeleteLogoOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsGet(brandID string) BrandsGetOp {
+	return &v21BrandsGetOp{op: s.s.BrandsGet(brandID)}
+}
+
+type v21BrandsGetOp struct {
+	op *accounts.BrandsGetOp
+}
+
+func (o *v21BrandsGetOp) IncludeExternalReferences() BrandsGetOp {
+	o.op.IncludeExternalReferences()
+	return o
+}
+
+func (o *v21BrandsGetOp) IncludeLogos() BrandsGetOp {
+	o.op.IncludeLogos()
+	return o
+}
+
+func (o *v21BrandsGetOp) Do(ctx context.Context) (*model.Brand, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsGetExportFile(brandID string) BrandsGetExportFileOp {
+	return &v21BrandsGetExportFileOp{op: s.s.BrandsGetExportFile(brandID)}
+}
+
+type v21BrandsGetExportFileOp struct {
+	op *accounts.BrandsGetExportFileOp
+}
+
+func (o *v21BrandsGetExportFileOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsGetLogo(brandID string, logoType string) BrandsGetLogoOp {
+	return &v21BrandsGetLogoOp{op: s.s.BrandsGetLogo(brandID, logoType)}
+}
+
+type v21BrandsGetLogoOp struct {
+	op *accounts.BrandsGetLogoOp
+}
+
+func (o *v21BrandsGetLogoOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsGetResource(brandID string, resourceContentType string) BrandsGetResourceOp {
+	return &v21BrandsGetResourceOp{op: s.s.BrandsGetResource(brandID, resourceContentType)}
+}
+
+type v21BrandsGetResourceOp struct {
+	op *accounts.BrandsGetResourceOp
+}
+
+func (o *v21BrandsGetResourceOp) Langcode(val string) BrandsGetResourceOp {
+	o.op.Langcode(val)
+	return o
+}
+
+func (o *v21BrandsGetResourceOp) ReturnMaster() BrandsGetResourceOp {
+	o.op.ReturnMaster()
+	return o
+}
+
+func (o *v21BrandsGetResourceOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsList() BrandsListOp {
+	return &v21BrandsListOp{op: s.s.BrandsList()}
+}
+
+type v21BrandsListOp struct {
+	op *accounts.BrandsListOp
+}
+
+func (o *v21BrandsListOp) ExcludeDistributorBrand() BrandsListOp {
+	o.op.ExcludeDistributorBrand()
+	return o
+}
+
+func (o *v21BrandsListOp) IncludeLogos() BrandsListOp {
+	o.op.IncludeLogos()
+	return o
+}
+
+func (o *v21BrandsListOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsListResources(brandID string) BrandsListResourcesOp {
+	return &v21BrandsListResourcesOp{op: s.s.BrandsListResources(brandID)}
+}
+
+type v21BrandsListResourcesOp struct {
+	op *accounts.BrandsListResourcesOp
+}
+
+func (o *v21BrandsListResourcesOp) Do(ctx context.Context) (*model.BrandResourcesList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsUpdate(brandID string, brand *model.Brand) BrandsUpdateOp {
+	return &v21BrandsUpdateOp{op: s.s.BrandsUpdate(brandID, brand)}
+}
+
+type v21BrandsUpdateOp struct {
+	op *accounts.BrandsUpdateOp
+}
+
+func (o *v21BrandsUpdateOp) Do(ctx context.Context) (*model.Brand, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsUpdateLogo(brandID string, logoType string, logoFileBytes []byte) BrandsUpdateLogoOp {
+	return &v21BrandsUpdateLogoOp{op: s.s.BrandsUpdateLogo(brandID, logoType, logoFileBytes)}
+}
+
+type v21BrandsUpdateLogoOp struct {
+	op *accounts.BrandsUpdateLogoOp
+}
+
+func (o *v21BrandsUpdateLogoOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BrandsUpdateResource(brandID string, resourceContentType string, media io.Reader, mimeType string) BrandsUpdateResourceOp {
+	return &v21BrandsUpdateResourceOp{op: s.s.BrandsUpdateResource(brandID, resourceContentType, media, mimeType)}
+}
+
+type v21BrandsUpdateResourceOp struct {
+	op *accounts.BrandsUpdateResourceOp
+}
+
+func (o *v21BrandsUpdateResourceOp) Do(ctx context.Context) (*model.BrandResources, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConsumerDisclosuresGet(langCode string) ConsumerDisclosuresGetOp {
+	return &v21ConsumerDisclosuresGetOp{op: s.s.ConsumerDisclosuresGet(langCode)}
+}
+
+type v21ConsumerDisclosuresGetOp struct {
+	op *accounts.ConsumerDisclosuresGetOp
+}
+
+func (o *v21ConsumerDisclosuresGetOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConsumerDisclosuresGetDefault() ConsumerDisclosuresGetDefaultOp {
+	return &v21ConsumerDisclosuresGetDefaultOp{op: s.s.ConsumerDisclosuresGetDefault()}
+}
+
+type v21ConsumerDisclosuresGetDefaultOp struct {
+	op *accounts.ConsumerDisclosuresGetDefaultOp
+}
+
+func (o *v21ConsumerDisclosuresGetDefaultOp) LangCode(val string) ConsumerDisclosuresGetDefaultOp {
+	o.op.LangCode(val)
+	return o
+}
+
+func (o *v21ConsumerDisclosuresGetDefaultOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConsumerDisclosuresUpdate(langCode string, envelopeConsumerDisclosures *model.ConsumerDisclosure) ConsumerDisclosuresUpdateOp {
+	return &v21ConsumerDisclosuresUpdateOp{op: s.s.ConsumerDisclosuresUpdate(langCode, envelopeConsumerDisclosures)}
+}
+
+type v21ConsumerDisclosuresUpdateOp struct {
+	op *accounts.ConsumerDisclosuresUpdateOp
+}
+
+func (o *v21ConsumerDisclosuresUpdateOp) IncludeMetadata(val string) ConsumerDisclosuresUpdateOp {
+	o.op.IncludeMetadata(val)
+	return o
+}
+
+func (o *v21ConsumerDisclosuresUpdateOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsCreate(customField *model.CustomField) CustomFieldsCreateOp {
+	return &v21CustomFieldsCreateOp{op: s.s.CustomFieldsCreate(customField)}
+}
+
+type v21CustomFieldsCreateOp struct {
+	op *accounts.CustomFieldsCreateOp
+}
+
+func (o *v21CustomFieldsCreateOp) ApplyToTemplates() CustomFieldsCreateOp {
+	o.op.ApplyToTemplates()
+	return o
+}
+
+func (o *v21CustomFieldsCreateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsDelete(customFieldID string) CustomFieldsDeleteOp {
+	return &v21CustomFieldsDeleteOp{op: s.s.CustomFieldsDelete(customFieldID)}
+}
+
+type v21CustomFieldsDeleteOp struct {
+	op *accounts.CustomFieldsDeleteOp
+}
+
+func (o *v21CustomFieldsDeleteOp) ApplyToTemplates() CustomFieldsDeleteOp {
+	o.op.ApplyToTemplates()
+	return o
+}
+
+func (o *v21CustomFieldsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsList() CustomFieldsListOp {
+	return &v21CustomFieldsListOp{op: s.s.CustomFieldsList()}
+}
+
+type v21CustomFieldsListOp struct {
+	op *accounts.CustomFieldsListOp
+}
+
+func (o *v21CustomFieldsListOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsUpdate(customFieldID string, customField *model.CustomField) CustomFieldsUpdateOp {
+	return &v21CustomFieldsUpdateOp{op: s.s.CustomFieldsUpdate(customFieldID, customField)}
+}
+
+type v21CustomFieldsUpdateOp struct {
+	op *accounts.CustomFieldsUpdateOp
+}
+
+func (o *v21CustomFieldsUpdateOp) ApplyToTemplates() CustomFieldsUpdateOp {
+	o.op.ApplyToTemplates()
+	return o
+}
+
+func (o *v21CustomFieldsUpdateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PasswordRulesGet() PasswordRulesGetOp {
+	return &v21PasswordRulesGetOp{op: s.s.PasswordRulesGet()}
+}
+
+type v21PasswordRulesGetOp struct {
+	op *accounts.PasswordRulesGetOp
+}
+
+func (o *v21PasswordRulesGetOp) Do(ctx context.Context) (*model.AccountPasswordRules, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PasswordRulesGetForUser() PasswordRulesGetForUserOp {
+	return &v21PasswordRulesGetForUserOp{op: s.s.PasswordRulesGetForUser()}
+}
+
+type v21PasswordRulesGetForUserOp struct {
+	op *accounts.PasswordRulesGetForUserOp
+}
+
+func (o *v21PasswordRulesGetForUserOp) Do(ctx context.Context) (*model.UserPasswordRules, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PasswordRulesUpdate(accountPasswordRules *model.AccountPasswordRules) PasswordRulesUpdateOp {
+	return &v21PasswordRulesUpdateOp{op: s.s.PasswordRulesUpdate(accountPasswordRules)}
+}
+
+type v21PasswordRulesUpdateOp struct {
+	op *accounts.PasswordRulesUpdateOp
+}
+
+func (o *v21PasswordRulesUpdateOp) Do(ctx context.Context) (*model.AccountPasswordRules, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PermissionProfilesCreate(accountPermissionProfiles *model.PermissionProfile) PermissionProfilesCreateOp {
+	return &v21PermissionProfilesCreateOp{op: s.s.PermissionProfilesCreate(accountPermissionProfiles)}
+}
+
+type v21PermissionProfilesCreateOp struct {
+	op *accounts.PermissionProfilesCreateOp
+}
+
+func (o *v21PermissionProfilesCreateOp) Include(val ...string) PermissionProfilesCreateOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21PermissionProfilesCreateOp) Do(ctx context.Context) (*model.PermissionProfile, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PermissionProfilesDelete(permissionProfileID string) PermissionProfilesDeleteOp {
+	return &v21PermissionProfilesDeleteOp{op: s.s.PermissionProfilesDelete(permissionProfileID)}
+}
+
+type v21PermissionProfilesDeleteOp struct {
+	op *accounts.PermissionProfilesDeleteOp
+}
+
+func (o *v21PermissionProfilesDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PermissionProfilesGet(permissionProfileID string) PermissionProfilesGetOp {
+	return &v21PermissionProfilesGetOp{op: s.s.PermissionProfilesGet(permissionProfileID)}
+}
+
+type v21PermissionProfilesGetOp struct {
+	op *accounts.PermissionProfilesGetOp
+}
+
+func (o *v21PermissionProfilesGetOp) Include(val ...string) PermissionProfilesGetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21PermissionProfilesGetOp) Do(ctx context.Context) (*model.PermissionProfile, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PermissionProfilesList() PermissionProfilesListOp {
+	return &v21PermissionProfilesListOp{op: s.s.PermissionProfilesList()}
+}
+
+type v21PermissionProfilesListOp struct {
+	op *accounts.PermissionProfilesListOp
+}
+
+func (o *v21PermissionProfilesListOp) Include(val string) PermissionProfilesListOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v21PermissionProfilesListOp) Do(ctx context.Context) (*model.PermissionProfileInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PermissionProfilesUpdate(permissionProfileID string, accountPermissionProfiles *model.PermissionProfile) PermissionProfilesUpdateOp {
+	return &v21PermissionProfilesUpdateOp{op: s.s.PermissionProfilesUpdate(permissionProfileID, accountPermissionProfiles)}
+}
+
+type v21PermissionProfilesUpdateOp struct {
+	op *accounts.PermissionProfilesUpdateOp
+}
+
+func (o *v21PermissionProfilesUpdateOp) Include(val ...string) PermissionProfilesUpdateOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21PermissionProfilesUpdateOp) Do(ctx context.Context) (*model.PermissionProfile, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignatureProvidersList() SignatureProvidersListOp {
+	return &v21SignatureProvidersListOp{op: s.s.SignatureProvidersList()}
+}
+
+type v21SignatureProvidersListOp struct {
+	op *accounts.SignatureProvidersListOp
+}
+
+func (o *v21SignatureProvidersListOp) Do(ctx context.Context) (*model.AccountSignatureProviders, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TabSettingsGet() TabSettingsGetOp {
+	return &v21TabSettingsGetOp{op: s.s.TabSettingsGet()}
+}
+
+type v21TabSettingsGetOp struct {
+	op *accounts.TabSettingsGetOp
+}
+
+func (o *v21TabSettingsGetOp) Do(ctx context.Context) (*model.TabAccountSettings, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TabSettingsUpdate(accountTabSettings *model.TabAccountSettings) TabSettingsUpdateOp {
+	return &v21TabSettingsUpdateOp{op: s.s.TabSettingsUpdate(accountTabSettings)}
+}
+
+type v21TabSettingsUpdateOp struct {
+	op *accounts.TabSettingsUpdateOp
+}
+
+func (o *v21TabSettingsUpdateOp) Do(ctx context.Context) (*model.TabAccountSettings, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) WatermarksGet() WatermarksGetOp {
+	return &v21WatermarksGetOp{op: s.s.WatermarksGet()}
+}
+
+type v21WatermarksGetOp struct {
+	op *accounts.WatermarksGetOp
+}
+
+func (o *v21WatermarksGetOp) Do(ctx context.Context) (*model.Watermark, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) WatermarksPreview(accountWatermarks *model.Watermark) WatermarksPreviewOp {
+	return &v21WatermarksPreviewOp{op: s.s.WatermarksPreview(accountWatermarks)}
+}
+
+type v21WatermarksPreviewOp struct {
+	op *accounts.WatermarksPreviewOp
+}
+
+func (o *v21WatermarksPreviewOp) Do(ctx context.Context) (*model.Watermark, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) WatermarksUpdate(accountWatermarks *model.Watermark) WatermarksUpdateOp {
+	return &v21WatermarksUpdateOp{op: s.s.WatermarksUpdate(accountWatermarks)}
+}
+
+type v21WatermarksUpdateOp struct {
+	op *accounts.WatermarksUpdateOp
+}
+
+func (o *v21WatermarksUpdateOp) Do(ctx context.Context) (*model.Watermark, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(newAccountDefinition *model.NewAccountDefinition) CreateOp {
+	return &v21CreateOp{op: s.s.Create(newAccountDefinition)}
+}
+
+type v21CreateOp struct {
+	op *accounts.CreateOp
+}
+
+func (o *v21CreateOp) PreviewBillingPlan() CreateOp {
+	o.op.PreviewBillingPlan()
+	return o
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.NewAccountSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Delete() DeleteOp {
+	return &v21DeleteOp{op: s.s.Delete()}
+}
+
+type v21DeleteOp struct {
+	op *accounts.DeleteOp
+}
+
+func (o *v21DeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DeleteCaptiveRecipient(recipientPart string, captiveRecipientInformation *model.CaptiveRecipientInformation) DeleteCaptiveRecipientOp {
+	return &v21DeleteCaptiveRecipientOp{op: s.s.DeleteCaptiveRecipient(recipientPart, captiveRecipientInformation)}
+}
+
+type v21DeleteCaptiveRecipientOp struct {
+	op *accounts.DeleteCaptiveRecipientOp
+}
+
+func (o *v21DeleteCaptiveRecipientOp) Do(ctx context.Context) (*model.CaptiveRecipientInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get() GetOp {
+	return &v21GetOp{op: s.s.Get()}
+}
+
+type v21GetOp struct {
+	op *accounts.GetOp
+}
+
+func (o *v21GetOp) IncludeAccountSettings() GetOp {
+	o.op.IncludeAccountSettings()
+	return o
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.AccountInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetBillingCharges() GetBillingChargesOp {
+	return &v21GetBillingChargesOp{op: s.s.GetBillingCharges()}
+}
+
+type v21GetBillingChargesOp struct {
+	op *accounts.GetBillingChargesOp
+}
+
+func (o *v21GetBillingChargesOp) IncludeCharges(val string) GetBillingChargesOp {
+	o.op.IncludeCharges(val)
+	return o
+}
+
+func (o *v21GetBillingChargesOp) Do(ctx context.Context) (*model.BillingChargeResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetProvisioning() GetProvisioningOp {
+	return &v21GetProvisioningOp{op: s.s.GetProvisioning()}
+}
+
+type v21GetProvisioningOp struct {
+	op *accounts.GetProvisioningOp
+}
+
+func (o *v21GetProvisioningOp) Do(ctx context.Context) (*model.ProvisioningInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListRecipientNamesByEmail() ListRecipientNamesByEmailOp {
+	return &v21ListRecipientNamesByEmailOp{op: s.s.ListRecipientNamesByEmail()}
+}
+
+type v21ListRecipientNamesByEmailOp struct {
+	op *accounts.ListRecipientNamesByEmailOp
+}
+
+func (o *v21ListRecipientNamesByEmailOp) Email(val string) ListRecipientNamesByEmailOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v21ListRecipientNamesByEmailOp) Do(ctx context.Context) (*model.RecipientNamesResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListSettings() ListSettingsOp {
+	return &v21ListSettingsOp{op: s.s.ListSettings()}
+}
+
+type v21ListSettingsOp struct {
+	op *accounts.ListSettingsOp
+}
+
+func (o *v21ListSettingsOp) Do(ctx context.Context) (*model.AccountSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListSharedAccess() ListSharedAccessOp {
+	return &v21ListSharedAccessOp{op: s.s.ListSharedAccess()}
+}
+
+type v21ListSharedAccessOp struct {
+	op *accounts.ListSharedAccessOp
+}
+
+func (o *v21ListSharedAccessOp) Count(val int) ListSharedAccessOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) EnvelopesNotSharedUserStatus(val string) ListSharedAccessOp {
+	o.op.EnvelopesNotSharedUserStatus(val)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) FolderIds(val ...string) ListSharedAccessOp {
+	o.op.FolderIds(val...)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) ItemType(val string) ListSharedAccessOp {
+	o.op.ItemType(val)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) SearchText(val string) ListSharedAccessOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) Shared(val string) ListSharedAccessOp {
+	o.op.Shared(val)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) StartPosition(val int) ListSharedAccessOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) UserIds(val ...string) ListSharedAccessOp {
+	o.op.UserIds(val...)
+	return o
+}
+
+func (o *v21ListSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAccess, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListSupportedLanguages() ListSupportedLanguagesOp {
+	return &v21ListSupportedLanguagesOp{op: s.s.ListSupportedLanguages()}
+}
+
+type v21ListSupportedLanguagesOp struct {
+	op *accounts.ListSupportedLanguagesOp
+}
+
+func (o *v21ListSupportedLanguagesOp) Do(ctx context.Context) (*model.SupportedLanguages, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListUnsupportedFileTypes() ListUnsupportedFileTypesOp {
+	return &v21ListUnsupportedFileTypesOp{op: s.s.ListUnsupportedFileTypes()}
+}
+
+type v21ListUnsupportedFileTypesOp struct {
+	op *accounts.ListUnsupportedFileTypesOp
+}
+
+func (o *v21ListUnsupportedFileTypesOp) Do(ctx context.Context) (*model.FileTypeList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateSettings(accountSettingsInformation *model.AccountSettingsInformation) UpdateSettingsOp {
+	return &v21UpdateSettingsOp{op: s.s.UpdateSettings(accountSettingsInformation)}
+}
+
+type v21UpdateSettingsOp struct {
+	op *accounts.UpdateSettingsOp
+}
+
+func (o *v21UpdateSettingsOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateSharedAccess(accountSharedAccess *model.AccountSharedAccess) UpdateSharedAccessOp {
+	return &v21UpdateSharedAccessOp{op: s.s.UpdateSharedAccess(accountSharedAccess)}
+}
+
+type v21UpdateSharedAccessOp struct {
+	op *accounts.UpdateSharedAccessOp
+}
+
+func (o *v21UpdateSharedAccessOp) ItemType(val string) UpdateSharedAccessOp {
+	o.op.ItemType(val)
+	return o
+}
+
+func (o *v21UpdateSharedAccessOp) UserIds(val ...string) UpdateSharedAccessOp {
+	o.op.UserIds(val...)
+	return o
+}
+
+func (o *v21UpdateSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAccess, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ENoteConfigurationsDelete() ENoteConfigurationsDeleteOp {
+	return &v21ENoteConfigurationsDeleteOp{op: s.s.ENoteConfigurationsDelete()}
+}
+
+type v21ENoteConfigurationsDeleteOp struct {
+	op *accounts.ENoteConfigurationsDeleteOp
+}
+
+func (o *v21ENoteConfigurationsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ENoteConfigurationsGet() ENoteConfigurationsGetOp {
+	return &v21ENoteConfigurationsGetOp{op: s.s.ENoteConfigurationsGet()}
+}
+
+type v21ENoteConfigurationsGetOp struct {
+	op *accounts.ENoteConfigurationsGetOp
+}
+
+func (o *v21ENoteConfigurationsGetOp) Do(ctx context.Context) (*model.ENoteConfiguration, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ENoteConfigurationsUpdate(eNoteConfigurations *model.ENoteConfiguration) ENoteConfigurationsUpdateOp {
+	return &v21ENoteConfigurationsUpdateOp{op: s.s.ENoteConfigurationsUpdate(eNoteConfigurations)}
+}
+
+type v21ENoteConfigurationsUpdateOp struct {
+	op *accounts.ENoteConfigurationsUpdateOp
+}
+
+func (o *v21ENoteConfigurationsUpdateOp) Do(ctx context.Context) (*model.ENoteConfiguration, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PaymentGatewayAccountsList() PaymentGatewayAccountsListOp {
+	return &v21PaymentGatewayAccountsListOp{op: s.s.PaymentGatewayAccountsList()}
+}
+
+type v21PaymentGatewayAccountsListOp struct {
+	op *accounts.PaymentGatewayAccountsListOp
+}
+
+func (o *v21PaymentGatewayAccountsListOp) Do(ctx context.Context) (*model.PaymentGatewayAccountsInfo, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SealProvidersList() SealProvidersListOp {
+	return &v21SealProvidersListOp{op: s.s.SealProvidersList()}
+}
+
+type v21SealProvidersListOp struct {
+	op *accounts.SealProvidersListOp
+}
+
+func (o *v21SealProvidersListOp) Do(ctx context.Context) (*model.AccountSeals, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) IdentityVerificationsList() IdentityVerificationsListOp {
+	return &v21IdentityVerificationsListOp{op: s.s.IdentityVerificationsList()}
+}
+
+type v21IdentityVerificationsListOp struct {
+	op *accounts.IdentityVerificationsListOp
+}
+
+func (o *v21IdentityVerificationsListOp) Do(ctx context.Context) (*model.AccountIdentityVerificationResponse, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2accounts.Service
+}
+
+func (s v2Service) BrandsCreate(brand *model.Brand) BrandsCreateOp {
+	o := &v2BrandsCreateOp{}
+	var v2brand *v2model.Brand
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2brand, brand)
+	}
+	o.op = s.s.BrandsCreate(v2brand)
+	return o
+}
+
+type v2BrandsCreateOp struct {
+	op  *v2accounts.BrandsCreateOp
+	err error
+}
+
+func (o *v2BrandsCreateOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BrandsDelete(brandID string) BrandsDeleteOp {
+	return &v2BrandsDeleteOp{op: s.s.BrandsDelete(brandID)}
+}
+
+type v2BrandsDeleteOp struct {
+	op *v2accounts.BrandsDeleteOp
+}
+
+func (o *v2BrandsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) BrandsDeleteList(brandsRequest *model.BrandsRequest) BrandsDeleteListOp {
+	o := &v2BrandsDeleteListOp{}
+	var v2brandsRequest *v2model.BrandsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2brandsRequest, brandsRequest)
+	}
+	o.op = s.s.BrandsDeleteList(v2brandsRequest)
+	return o
+}
+
+type v2BrandsDeleteListOp struct {
+	op  *v2accounts.BrandsDeleteListOp
+	err error
+}
+
+func (o *v2BrandsDeleteListOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BrandsDeleteLogo(brandID string, logoType string) BrandsDeleteLogoOp {
+	return &v2BrandsDeleteLogoOp{op: s.s.BrandsDeleteLogo(brandID, logoType)}
+}
+
+type v2BrandsDeleteLogoOp struct {
+	op *v2accounts.BrandsDeleteLogoOp
+}
+
+func (o *v2BrandsDeleteLogoOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) BrandsGet(brandID string) BrandsGetOp {
+	return &v2BrandsGetOp{op: s.s.BrandsGet(brandID)}
+}
+
+type v2BrandsGetOp struct {
+	op  *v2accounts.BrandsGetOp
+	err error
+}
+
+func (o *v2BrandsGetOp) IncludeExternalReferences() BrandsGetOp {
+	o.op.IncludeExternalReferences()
+	return o
+}
+
+func (o *v2BrandsGetOp) IncludeLogos() BrandsGetOp {
+	o.op.IncludeLogos()
+	return o
+}
+
+func (o *v2BrandsGetOp) Do(ctx context.Context) (*model.Brand, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Brand
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BrandsGetExportFile(brandID string) BrandsGetExportFileOp {
+	return &v2BrandsGetExportFileOp{op: s.s.BrandsGetExportFile(brandID)}
+}
+
+type v2BrandsGetExportFileOp struct {
+	op *v2accounts.BrandsGetExportFileOp
+}
+
+func (o *v2BrandsGetExportFileOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) BrandsGetLogo(brandID string, logoType string) BrandsGetLogoOp {
+	return &v2BrandsGetLogoOp{op: s.s.BrandsGetLogo(brandID, logoType)}
+}
+
+type v2BrandsGetLogoOp struct {
+	op *v2accounts.BrandsGetLogoOp
+}
+
+func (o *v2BrandsGetLogoOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) BrandsGetResource(brandID string, resourceContentType string) BrandsGetResourceOp {
+	return &v2BrandsGetResourceOp{op: s.s.BrandsGetResource(brandID, resourceContentType)}
+}
+
+type v2BrandsGetResourceOp struct {
+	op *v2accounts.BrandsGetResourceOp
+}
+
+func (o *v2BrandsGetResourceOp) Langcode(val string) BrandsGetResourceOp {
+	o.op.Langcode(val)
+	return o
+}
+
+func (o *v2BrandsGetResourceOp) ReturnMaster() BrandsGetResourceOp {
+	o.op.ReturnMaster()
+	return o
+}
+
+func (o *v2BrandsGetResourceOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) BrandsList() BrandsListOp {
+	return &v2BrandsListOp{op: s.s.BrandsList()}
+}
+
+type v2BrandsListOp struct {
+	op  *v2accounts.BrandsListOp
+	err error
+}
+
+func (o *v2BrandsListOp) ExcludeDistributorBrand() BrandsListOp {
+	o.op.ExcludeDistributorBrand()
+	return o
+}
+
+func (o *v2BrandsListOp) IncludeLogos() BrandsListOp {
+	o.op.IncludeLogos()
+	return o
+}
+
+func (o *v2BrandsListOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BrandsListResources(brandID string) BrandsListResourcesOp {
+	return &v2BrandsListResourcesOp{op: s.s.BrandsListResources(brandID)}
+}
+
+type v2BrandsListResourcesOp struct {
+	op  *v2accounts.BrandsListResourcesOp
+	err error
+}
+
+func (o *v2BrandsListResourcesOp) Do(ctx context.Context) (*model.BrandResourcesList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandResourcesList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BrandsUpdate(brandID string, brand *model.Brand) BrandsUpdateOp {
+	o := &v2BrandsUpdateOp{}
+	var v2brand *v2model.Brand
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2brand, brand)
+	}
+	o.op = s.s.BrandsUpdate(brandID, v2brand)
+	return o
+}
+
+type v2BrandsUpdateOp struct {
+	op  *v2accounts.BrandsUpdateOp
+	err error
+}
+
+func (o *v2BrandsUpdateOp) Do(ctx context.Context) (*model.Brand, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Brand
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BrandsUpdateLogo(brandID string, logoType string, logoFileBytes []byte) BrandsUpdateLogoOp {
+	return &v2BrandsUpdateLogoOp{op: s.s.BrandsUpdateLogo(brandID, logoType, logoFileBytes)}
+}
+
+type v2BrandsUpdateLogoOp struct {
+	op *v2accounts.BrandsUpdateLogoOp
+}
+
+func (o *v2BrandsUpdateLogoOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) BrandsUpdateResource(brandID string, resourceContentType string, media io.Reader, mimeType string) BrandsUpdateResourceOp {
+	return &v2BrandsUpdateResourceOp{op: s.s.BrandsUpdateResource(brandID, resourceContentType, media, mimeType)}
+}
+
+type v2BrandsUpdateResourceOp struct {
+	op  *v2accounts.BrandsUpdateResourceOp
+	err error
+}
+
+func (o *v2BrandsUpdateResourceOp) Do(ctx context.Context) (*model.BrandResources, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandResources
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConsumerDisclosuresGet(langCode string) ConsumerDisclosuresGetOp {
+	return &v2ConsumerDisclosuresGetOp{op: s.s.ConsumerDisclosuresGet(langCode)}
+}
+
+type v2ConsumerDisclosuresGetOp struct {
+	op  *v2accounts.ConsumerDisclosuresGetOp
+	err error
+}
+
+func (o *v2ConsumerDisclosuresGetOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConsumerDisclosure
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConsumerDisclosuresGetDefault() ConsumerDisclosuresGetDefaultOp {
+	return &v2ConsumerDisclosuresGetDefaultOp{op: s.s.ConsumerDisclosuresGetDefault()}
+}
+
+type v2ConsumerDisclosuresGetDefaultOp struct {
+	op  *v2accounts.ConsumerDisclosuresGetDefaultOp
+	err error
+}
+
+func (o *v2ConsumerDisclosuresGetDefaultOp) LangCode(val string) ConsumerDisclosuresGetDefaultOp {
+	o.op.LangCode(val)
+	return o
+}
+
+func (o *v2ConsumerDisclosuresGetDefaultOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConsumerDisclosure
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConsumerDisclosuresUpdate(langCode string, envelopeConsumerDisclosures *model.ConsumerDisclosure) ConsumerDisclosuresUpdateOp {
+	o := &v2ConsumerDisclosuresUpdateOp{}
+	var v2envelopeConsumerDisclosures *v2model.ConsumerDisclosure
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeConsumerDisclosures, envelopeConsumerDisclosures)
+	}
+	o.op = s.s.ConsumerDisclosuresUpdate(langCode, v2envelopeConsumerDisclosures)
+	return o
+}
+
+type v2ConsumerDisclosuresUpdateOp struct {
+	op  *v2accounts.ConsumerDisclosuresUpdateOp
+	err error
+}
+
+func (o *v2ConsumerDisclosuresUpdateOp) IncludeMetadata(val string) ConsumerDisclosuresUpdateOp {
+	o.op.IncludeMetadata(val)
+	return o
+}
+
+func (o *v2ConsumerDisclosuresUpdateOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConsumerDisclosure
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsCreate(customField *model.CustomField) CustomFieldsCreateOp {
+	o := &v2CustomFieldsCreateOp{}
+	var v2customField *v2model.CustomField
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2customField, customField)
+	}
+	o.op = s.s.CustomFieldsCreate(v2customField)
+	return o
+}
+
+type v2CustomFieldsCreateOp struct {
+	op  *v2accounts.CustomFieldsCreateOp
+	err error
+}
+
+func (o *v2CustomFieldsCreateOp) ApplyToTemplates() CustomFieldsCreateOp {
+	o.op.ApplyToTemplates()
+	return o
+}
+
+func (o *v2CustomFieldsCreateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsDelete(customFieldID string) CustomFieldsDeleteOp {
+	return &v2CustomFieldsDeleteOp{op: s.s.CustomFieldsDelete(customFieldID)}
+}
+
+type v2CustomFieldsDeleteOp struct {
+	op *v2accounts.CustomFieldsDeleteOp
+}
+
+func (o *v2CustomFieldsDeleteOp) ApplyToTemplates() CustomFieldsDeleteOp {
+	o.op.ApplyToTemplates()
+	return o
+}
+
+func (o *v2CustomFieldsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) CustomFieldsList() CustomFieldsListOp {
+	return &v2CustomFieldsListOp{op: s.s.CustomFieldsList()}
+}
+
+type v2CustomFieldsListOp struct {
+	op  *v2accounts.CustomFieldsListOp
+	err error
+}
+
+func (o *v2CustomFieldsListOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsUpdate(customFieldID string, customField *model.CustomField) CustomFieldsUpdateOp {
+	o := &v2CustomFieldsUpdateOp{}
+	var v2customField *v2model.CustomField
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2customField, customField)
+	}
+	o.op = s.s.CustomFieldsUpdate(customFieldID, v2customField)
+	return o
+}
+
+type v2CustomFieldsUpdateOp struct {
+	op  *v2accounts.CustomFieldsUpdateOp
+	err error
+}
+
+func (o *v2CustomFieldsUpdateOp) ApplyToTemplates() CustomFieldsUpdateOp {
+	o.op.ApplyToTemplates()
+	return o
+}
+
+func (o *v2CustomFieldsUpdateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PasswordRulesGet() PasswordRulesGetOp {
+	return &v2PasswordRulesGetOp{op: s.s.PasswordRulesGet()}
+}
+
+type v2PasswordRulesGetOp struct {
+	op  *v2accounts.PasswordRulesGetOp
+	err error
+}
+
+func (o *v2PasswordRulesGetOp) Do(ctx context.Context) (*model.AccountPasswordRules, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountPasswordRules
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PasswordRulesGetForUser() PasswordRulesGetForUserOp {
+	return &v2PasswordRulesGetForUserOp{op: s.s.PasswordRulesGetForUser()}
+}
+
+type v2PasswordRulesGetForUserOp struct {
+	op  *v2accounts.PasswordRulesGetForUserOp
+	err error
+}
+
+func (o *v2PasswordRulesGetForUserOp) Do(ctx context.Context) (*model.UserPasswordRules, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserPasswordRules
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PasswordRulesUpdate(accountPasswordRules *model.AccountPasswordRules) PasswordRulesUpdateOp {
+	o := &v2PasswordRulesUpdateOp{}
+	var v2accountPasswordRules *v2model.AccountPasswordRules
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountPasswordRules, accountPasswordRules)
+	}
+	o.op = s.s.PasswordRulesUpdate(v2accountPasswordRules)
+	return o
+}
+
+type v2PasswordRulesUpdateOp struct {
+	op  *v2accounts.PasswordRulesUpdateOp
+	err error
+}
+
+func (o *v2PasswordRulesUpdateOp) Do(ctx context.Context) (*model.AccountPasswordRules, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountPasswordRules
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PermissionProfilesCreate(accountPermissionProfiles *model.PermissionProfile) PermissionProfilesCreateOp {
+	o := &v2PermissionProfilesCreateOp{}
+	var v2accountPermissionProfiles *v2model.PermissionProfile
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountPermissionProfiles, accountPermissionProfiles)
+	}
+	o.op = s.s.PermissionProfilesCreate(v2accountPermissionProfiles)
+	return o
+}
+
+type v2PermissionProfilesCreateOp struct {
+	op  *v2accounts.PermissionProfilesCreateOp
+	err error
+}
+
+func (o *v2PermissionProfilesCreateOp) Include(val ...string) PermissionProfilesCreateOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2PermissionProfilesCreateOp) Do(ctx context.Context) (*model.PermissionProfile, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PermissionProfile
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PermissionProfilesDelete(permissionProfileID string) PermissionProfilesDeleteOp {
+	return &v2PermissionProfilesDeleteOp{op: s.s.PermissionProfilesDelete(permissionProfileID)}
+}
+
+type v2PermissionProfilesDeleteOp struct {
+	op *v2accounts.PermissionProfilesDeleteOp
+}
+
+func (o *v2PermissionProfilesDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) PermissionProfilesGet(permissionProfileID string) PermissionProfilesGetOp {
+	return &v2PermissionProfilesGetOp{op: s.s.PermissionProfilesGet(permissionProfileID)}
+}
+
+type v2PermissionProfilesGetOp struct {
+	op  *v2accounts.PermissionProfilesGetOp
+	err error
+}
+
+func (o *v2PermissionProfilesGetOp) Include(val ...string) PermissionProfilesGetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2PermissionProfilesGetOp) Do(ctx context.Context) (*model.PermissionProfile, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PermissionProfile
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PermissionProfilesList() PermissionProfilesListOp {
+	return &v2PermissionProfilesListOp{op: s.s.PermissionProfilesList()}
+}
+
+type v2PermissionProfilesListOp struct {
+	op  *v2accounts.PermissionProfilesListOp
+	err error
+}
+
+func (o *v2PermissionProfilesListOp) Include(val string) PermissionProfilesListOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v2PermissionProfilesListOp) Do(ctx context.Context) (*model.PermissionProfileInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PermissionProfileInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PermissionProfilesUpdate(permissionProfileID string, accountPermissionProfiles *model.PermissionProfile) PermissionProfilesUpdateOp {
+	o := &v2PermissionProfilesUpdateOp{}
+	var v2accountPermissionProfiles *v2model.PermissionProfile
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountPermissionProfiles, accountPermissionProfiles)
+	}
+	o.op = s.s.PermissionProfilesUpdate(permissionProfileID, v2accountPermissionProfiles)
+	return o
+}
+
+type v2PermissionProfilesUpdateOp struct {
+	op  *v2accounts.PermissionProfilesUpdateOp
+	err error
+}
+
+func (o *v2PermissionProfilesUpdateOp) Include(val ...string) PermissionProfilesUpdateOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2PermissionProfilesUpdateOp) Do(ctx context.Context) (*model.PermissionProfile, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PermissionProfile
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignatureProvidersList() SignatureProvidersListOp {
+	return &v2SignatureProvidersListOp{op: s.s.SignatureProvidersList()}
+}
+
+type v2SignatureProvidersListOp struct {
+	op  *v2accounts.SignatureProvidersListOp
+	err error
+}
+
+func (o *v2SignatureProvidersListOp) Do(ctx context.Context) (*model.AccountSignatureProviders, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountSignatureProviders
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TabSettingsGet() TabSettingsGetOp {
+	return &v2TabSettingsGetOp{op: s.s.TabSettingsGet()}
+}
+
+type v2TabSettingsGetOp struct {
+	op  *v2accounts.TabSettingsGetOp
+	err error
+}
+
+func (o *v2TabSettingsGetOp) Do(ctx context.Context) (*model.TabAccountSettings, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TabAccountSettings
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TabSettingsUpdate(accountTabSettings *model.TabAccountSettings) TabSettingsUpdateOp {
+	o := &v2TabSettingsUpdateOp{}
+	var v2accountTabSettings *v2model.TabAccountSettings
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountTabSettings, accountTabSettings)
+	}
+	o.op = s.s.TabSettingsUpdate(v2accountTabSettings)
+	return o
+}
+
+type v2TabSettingsUpdateOp struct {
+	op  *v2accounts.TabSettingsUpdateOp
+	err error
+}
+
+func (o *v2TabSettingsUpdateOp) Do(ctx context.Context) (*model.TabAccountSettings, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TabAccountSettings
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) WatermarksGet() WatermarksGetOp {
+	return &v2WatermarksGetOp{op: s.s.WatermarksGet()}
+}
+
+type v2WatermarksGetOp struct {
+	op  *v2accounts.WatermarksGetOp
+	err error
+}
+
+func (o *v2WatermarksGetOp) Do(ctx context.Context) (*model.Watermark, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Watermark
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) WatermarksPreview(accountWatermarks *model.Watermark) WatermarksPreviewOp {
+	o := &v2WatermarksPreviewOp{}
+	var v2accountWatermarks *v2model.Watermark
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountWatermarks, accountWatermarks)
+	}
+	o.op = s.s.WatermarksPreview(v2accountWatermarks)
+	return o
+}
+
+type v2WatermarksPreviewOp struct {
+	op  *v2accounts.WatermarksPreviewOp
+	err error
+}
+
+func (o *v2WatermarksPreviewOp) Do(ctx context.Context) (*model.Watermark, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Watermark
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) WatermarksUpdate(accountWatermarks *model.Watermark) WatermarksUpdateOp {
+	o := &v2WatermarksUpdateOp{}
+	var v2accountWatermarks *v2model.Watermark
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountWatermarks, accountWatermarks)
+	}
+	o.op = s.s.WatermarksUpdate(v2accountWatermarks)
+	return o
+}
+
+type v2WatermarksUpdateOp struct {
+	op  *v2accounts.WatermarksUpdateOp
+	err error
+}
+
+func (o *v2WatermarksUpdateOp) Do(ctx context.Context) (*model.Watermark, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Watermark
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(newAccountDefinition *model.NewAccountDefinition) CreateOp {
+	o := &v2CreateOp{}
+	var v2newAccountDefinition *v2model.NewAccountDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2newAccountDefinition, newAccountDefinition)
+	}
+	o.op = s.s.Create(v2newAccountDefinition)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2accounts.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) PreviewBillingPlan() CreateOp {
+	o.op.PreviewBillingPlan()
+	return o
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.NewAccountSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.NewAccountSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Delete() DeleteOp {
+	return &v2DeleteOp{op: s.s.Delete()}
+}
+
+type v2DeleteOp struct {
+	op *v2accounts.DeleteOp
+}
+
+func (o *v2DeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) DeleteCaptiveRecipient(recipientPart string, captiveRecipientInformation *model.CaptiveRecipientInformation) DeleteCaptiveRecipientOp {
+	o := &v2DeleteCaptiveRecipientOp{}
+	var v2captiveRecipientInformation *v2model.CaptiveRecipientInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2captiveRecipientInformation, captiveRecipientInformation)
+	}
+	o.op = s.s.DeleteCaptiveRecipient(recipientPart, v2captiveRecipientInformation)
+	return o
+}
+
+type v2DeleteCaptiveRecipientOp struct {
+	op  *v2accounts.DeleteCaptiveRecipientOp
+	err error
+}
+
+func (o *v2DeleteCaptiveRecipientOp) Do(ctx context.Context) (*model.CaptiveRecipientInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CaptiveRecipientInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Get() GetOp {
+	return &v2GetOp{op: s.s.Get()}
+}
+
+type v2GetOp struct {
+	op  *v2accounts.GetOp
+	err error
+}
+
+func (o *v2GetOp) IncludeAccountSettings() GetOp {
+	o.op.IncludeAccountSettings()
+	return o
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.AccountInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetBillingCharges() GetBillingChargesOp {
+	return &v2GetBillingChargesOp{op: s.s.GetBillingCharges()}
+}
+
+type v2GetBillingChargesOp struct {
+	op  *v2accounts.GetBillingChargesOp
+	err error
+}
+
+func (o *v2GetBillingChargesOp) IncludeCharges(val string) GetBillingChargesOp {
+	o.op.IncludeCharges(val)
+	return o
+}
+
+func (o *v2GetBillingChargesOp) Do(ctx context.Context) (*model.BillingChargeResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingChargeResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetProvisioning() GetProvisioningOp {
+	return &v2GetProvisioningOp{op: s.s.GetProvisioning()}
+}
+
+type v2GetProvisioningOp struct {
+	op  *v2accounts.GetProvisioningOp
+	err error
+}
+
+func (o *v2GetProvisioningOp) Do(ctx context.Context) (*model.ProvisioningInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ProvisioningInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListRecipientNamesByEmail() ListRecipientNamesByEmailOp {
+	return &v2ListRecipientNamesByEmailOp{op: s.s.ListRecipientNamesByEmail()}
+}
+
+type v2ListRecipientNamesByEmailOp struct {
+	op  *v2accounts.ListRecipientNamesByEmailOp
+	err error
+}
+
+func (o *v2ListRecipientNamesByEmailOp) Email(val string) ListRecipientNamesByEmailOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v2ListRecipientNamesByEmailOp) Do(ctx context.Context) (*model.RecipientNamesResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.RecipientNamesResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListSettings() ListSettingsOp {
+	return &v2ListSettingsOp{op: s.s.ListSettings()}
+}
+
+type v2ListSettingsOp struct {
+	op  *v2accounts.ListSettingsOp
+	err error
+}
+
+func (o *v2ListSettingsOp) Do(ctx context.Context) (*model.AccountSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListSharedAccess() ListSharedAccessOp {
+	return &v2ListSharedAccessOp{op: s.s.ListSharedAccess()}
+}
+
+type v2ListSharedAccessOp struct {
+	op  *v2accounts.ListSharedAccessOp
+	err error
+}
+
+func (o *v2ListSharedAccessOp) Count(val int) ListSharedAccessOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) EnvelopesNotSharedUserStatus(val string) ListSharedAccessOp {
+	o.op.EnvelopesNotSharedUserStatus(val)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) FolderIds(val ...string) ListSharedAccessOp {
+	o.op.FolderIds(val...)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) ItemType(val string) ListSharedAccessOp {
+	o.op.ItemType(val)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) SearchText(val string) ListSharedAccessOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) Shared(val string) ListSharedAccessOp {
+	o.op.Shared(val)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) StartPosition(val int) ListSharedAccessOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) UserIds(val ...string) ListSharedAccessOp {
+	o.op.UserIds(val...)
+	return o
+}
+
+func (o *v2ListSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAccess, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountSharedAccess
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListSupportedLanguages() ListSupportedLanguagesOp {
+	return &v2ListSupportedLanguagesOp{op: s.s.ListSupportedLanguages()}
+}
+
+type v2ListSupportedLanguagesOp struct {
+	op  *v2accounts.ListSupportedLanguagesOp
+	err error
+}
+
+func (o *v2ListSupportedLanguagesOp) Do(ctx context.Context) (*model.SupportedLanguages, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SupportedLanguages
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListUnsupportedFileTypes() ListUnsupportedFileTypesOp {
+	return &v2ListUnsupportedFileTypesOp{op: s.s.ListUnsupportedFileTypes()}
+}
+
+type v2ListUnsupportedFileTypesOp struct {
+	op  *v2accounts.ListUnsupportedFileTypesOp
+	err error
+}
+
+func (o *v2ListUnsupportedFileTypesOp) Do(ctx context.Context) (*model.FileTypeList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.FileTypeList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateSettings(accountSettingsInformation *model.AccountSettingsInformation) UpdateSettingsOp {
+	o := &v2UpdateSettingsOp{}
+	var v2accountSettingsInformation *v2model.AccountSettingsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountSettingsInformation, accountSettingsInformation)
+	}
+	o.op = s.s.UpdateSettings(v2accountSettingsInformation)
+	return o
+}
+
+type v2UpdateSettingsOp struct {
+	op  *v2accounts.UpdateSettingsOp
+	err error
+}
+
+func (o *v2UpdateSettingsOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) UpdateSharedAccess(accountSharedAccess *model.AccountSharedAccess) UpdateSharedAccessOp {
+	o := &v2UpdateSharedAccessOp{}
+	var v2accountSharedAccess *v2model.AccountSharedAccess
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2accountSharedAccess, accountSharedAccess)
+	}
+	o.op = s.s.UpdateSharedAccess(v2accountSharedAccess)
+	return o
+}
+
+type v2UpdateSharedAccessOp struct {
+	op  *v2accounts.UpdateSharedAccessOp
+	err error
+}
+
+func (o *v2UpdateSharedAccessOp) ItemType(val string) UpdateSharedAccessOp {
+	o.op.ItemType(val)
+	return o
+}
+
+func (o *v2UpdateSharedAccessOp) UserIds(val ...string) UpdateSharedAccessOp {
+	o.op.UserIds(val...)
+	return o
+}
+
+func (o *v2UpdateSharedAccessOp) Do(ctx context.Context) (*model.AccountSharedAccess, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountSharedAccess
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ENoteConfigurationsDelete() ENoteConfigurationsDeleteOp {
+	return &v2ENoteConfigurationsDeleteOp{op: s.s.ENoteConfigurationsDelete()}
+}
+
+type v2ENoteConfigurationsDeleteOp struct {
+	op *v2accounts.ENoteConfigurationsDeleteOp
+}
+
+func (o *v2ENoteConfigurationsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) ENoteConfigurationsGet() ENoteConfigurationsGetOp {
+	return &v2ENoteConfigurationsGetOp{op: s.s.ENoteConfigurationsGet()}
+}
+
+type v2ENoteConfigurationsGetOp struct {
+	op  *v2accounts.ENoteConfigurationsGetOp
+	err error
+}
+
+func (o *v2ENoteConfigurationsGetOp) Do(ctx context.Context) (*model.ENoteConfiguration, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ENoteConfiguration
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ENoteConfigurationsUpdate(eNoteConfigurations *model.ENoteConfiguration) ENoteConfigurationsUpdateOp {
+	o := &v2ENoteConfigurationsUpdateOp{}
+	var v2eNoteConfigurations *v2model.ENoteConfiguration
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2eNoteConfigurations, eNoteConfigurations)
+	}
+	o.op = s.s.ENoteConfigurationsUpdate(v2eNoteConfigurations)
+	return o
+}
+
+type v2ENoteConfigurationsUpdateOp struct {
+	op  *v2accounts.ENoteConfigurationsUpdateOp
+	err error
+}
+
+func (o *v2ENoteConfigurationsUpdateOp) Do(ctx context.Context) (*model.ENoteConfiguration, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ENoteConfiguration
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PaymentGatewayAccountsList() PaymentGatewayAccountsListOp {
+	return &v2PaymentGatewayAccountsListOp{op: s.s.PaymentGatewayAccountsList()}
+}
+
+type v2PaymentGatewayAccountsListOp struct {
+	op  *v2accounts.PaymentGatewayAccountsListOp
+	err error
+}
+
+func (o *v2PaymentGatewayAccountsListOp) Do(ctx context.Context) (*model.PaymentGatewayAccountsInfo, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PaymentGatewayAccountsInfo
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SealProvidersList() SealProvidersListOp {
+	return &v2SealProvidersListOp{op: s.s.SealProvidersList()}
+}
+
+type v2SealProvidersListOp struct {
+	op  *v2accounts.SealProvidersListOp
+	err error
+}
+
+func (o *v2SealProvidersListOp) Do(ctx context.Context) (*model.AccountSeals, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountSeals
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) IdentityVerificationsList() IdentityVerificationsListOp {
+	return &v2IdentityVerificationsListOp{op: s.s.IdentityVerificationsList()}
+}
+
+type v2IdentityVerificationsListOp struct {
+	op  *v2accounts.IdentityVerificationsListOp
+	err error
+}
+
+func (o *v2IdentityVerificationsListOp) Do(ctx context.Context) (*model.AccountIdentityVerificationResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountIdentityVerificationResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
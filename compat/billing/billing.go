@@ -0,0 +1,658 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package billing defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 billing services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/billing"
+//	)
+//	...
+//	billingService := billing.New(esignCredential, cfg.UseV2)
+package billing // import "github.com/jfcote87/esign/compat/billing"
+
+import (
+	"context"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/billing"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2billing "github.com/jfcote87/esign/v2/billing"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 billing services.
+type Service interface {
+	// PlansGet get the billing plan details.
+	PlansGet(billingPlanID string) PlansGetOp
+	// PlansGetAccountPlan get Account Billing Plan
+	PlansGetAccountPlan() PlansGetAccountPlanOp
+	// PlansGetCreditCard get credit card information
+	PlansGetCreditCard() PlansGetCreditCardOp
+	// PlansList gets the list of available billing plans.
+	PlansList() PlansListOp
+	// PlansPurchaseEnvelopes reserverd: Purchase additional envelopes.
+	PlansPurchaseEnvelopes(purchasedEnvelopesInformation *model.PurchasedEnvelopesInformation) PlansPurchaseEnvelopesOp
+	// PlansUpdate updates the account billing plan.
+	PlansUpdate(billingPlanInformation *model.BillingPlanInformation) PlansUpdateOp
+	// InvoicesGet retrieves a billing invoice.
+	InvoicesGet(invoiceID string) InvoicesGetOp
+	// InvoicesList get a List of Billing Invoices
+	InvoicesList() InvoicesListOp
+	// InvoicesListPastDue get a list of past due invoices.
+	InvoicesListPastDue() InvoicesListPastDueOp
+	// PaymentsCreate posts a payment to a past due invoice.
+	PaymentsCreate(billingPaymentRequest *model.BillingPaymentRequest) PaymentsCreateOp
+	// PaymentsGet gets billing payment information for a specific payment.
+	PaymentsGet(paymentID string) PaymentsGetOp
+	// PaymentsList gets payment information for one or more payments.
+	PaymentsList() PaymentsListOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2billing.New(cred)}
+	}
+	return v21Service{s: billing.New(cred)}
+}
+
+// PlansGetOp is the version-neutral PlansGet op.
+type PlansGetOp interface {
+	Do(ctx context.Context) (*model.BillingPlanResponse, error)
+}
+
+// PlansGetAccountPlanOp is the version-neutral PlansGetAccountPlan op.
+type PlansGetAccountPlanOp interface {
+	IncludeCreditCardInformation() PlansGetAccountPlanOp
+	IncludeMetadata() PlansGetAccountPlanOp
+	IncludeSuccessorPlans() PlansGetAccountPlanOp
+	Do(ctx context.Context) (*model.AccountBillingPlanResponse, error)
+}
+
+// PlansGetCreditCardOp is the version-neutral PlansGetCreditCard op.
+type PlansGetCreditCardOp interface {
+	Do(ctx context.Context) (*model.CreditCardInformation, error)
+}
+
+// PlansListOp is the version-neutral PlansList op.
+type PlansListOp interface {
+	Do(ctx context.Context) (*model.BillingPlansResponse, error)
+}
+
+// PlansPurchaseEnvelopesOp is the version-neutral PlansPurchaseEnvelopes op.
+type PlansPurchaseEnvelopesOp interface {
+	Do(ctx context.Context) error
+}
+
+// PlansUpdateOp is the version-neutral PlansUpdate op.
+type PlansUpdateOp interface {
+	PreviewBillingPlan() PlansUpdateOp
+	Do(ctx context.Context) (*model.BillingPlanUpdateResponse, error)
+}
+
+// InvoicesGetOp is the version-neutral InvoicesGet op.
+type InvoicesGetOp interface {
+	Do(ctx context.Context) (*model.BillingInvoice, error)
+	PDF(ctx context.Context) (*esign.Download, error)
+}
+
+// InvoicesListOp is the version-neutral InvoicesList op.
+type InvoicesListOp interface {
+	FromDate(val time.Time) InvoicesListOp
+	ToDate(val time.Time) InvoicesListOp
+	Do(ctx context.Context) (*model.BillingInvoicesResponse, error)
+}
+
+// InvoicesListPastDueOp is the version-neutral InvoicesListPastDue op.
+type InvoicesListPastDueOp interface {
+	Do(ctx context.Context) (*model.BillingInvoicesSummary, error)
+}
+
+// PaymentsCreateOp is the version-neutral PaymentsCreate op.
+type PaymentsCreateOp interface {
+	Do(ctx context.Context) (*model.BillingPaymentResponse, error)
+}
+
+// PaymentsGetOp is the version-neutral PaymentsGet op.
+type PaymentsGetOp interface {
+	Do(ctx context.Context) (*model.BillingPaymentItem, error)
+}
+
+// PaymentsListOp is the version-neutral PaymentsList op.
+type PaymentsListOp interface {
+	FromDate(val time.Time) PaymentsListOp
+	ToDate(val time.Time) PaymentsListOp
+	Do(ctx context.Context) (*model.BillingPaymentsResponse, error)
+}
+
+type v21Service struct {
+	s *billing.Service
+}
+
+func (s v21Service) PlansGet(billingPlanID string) PlansGetOp {
+	return &v21PlansGetOp{op: s.s.PlansGet(billingPlanID)}
+}
+
+type v21PlansGetOp struct {
+	op *billing.PlansGetOp
+}
+
+func (o *v21PlansGetOp) Do(ctx context.Context) (*model.BillingPlanResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PlansGetAccountPlan() PlansGetAccountPlanOp {
+	return &v21PlansGetAccountPlanOp{op: s.s.PlansGetAccountPlan()}
+}
+
+type v21PlansGetAccountPlanOp struct {
+	op *billing.PlansGetAccountPlanOp
+}
+
+func (o *v21PlansGetAccountPlanOp) IncludeCreditCardInformation() PlansGetAccountPlanOp {
+	o.op.IncludeCreditCardInformation()
+	return o
+}
+
+func (o *v21PlansGetAccountPlanOp) IncludeMetadata() PlansGetAccountPlanOp {
+	o.op.IncludeMetadata()
+	return o
+}
+
+func (o *v21PlansGetAccountPlanOp) IncludeSuccessorPlans() PlansGetAccountPlanOp {
+	o.op.IncludeSuccessorPlans()
+	return o
+}
+
+func (o *v21PlansGetAccountPlanOp) Do(ctx context.Context) (*model.AccountBillingPlanResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PlansGetCreditCard() PlansGetCreditCardOp {
+	return &v21PlansGetCreditCardOp{op: s.s.PlansGetCreditCard()}
+}
+
+type v21PlansGetCreditCardOp struct {
+	op *billing.PlansGetCreditCardOp
+}
+
+func (o *v21PlansGetCreditCardOp) Do(ctx context.Context) (*model.CreditCardInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PlansList() PlansListOp {
+	return &v21PlansListOp{op: s.s.PlansList()}
+}
+
+type v21PlansListOp struct {
+	op *billing.PlansListOp
+}
+
+func (o *v21PlansListOp) Do(ctx context.Context) (*model.BillingPlansResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PlansPurchaseEnvelopes(purchasedEnvelopesInformation *model.PurchasedEnvelopesInformation) PlansPurchaseEnvelopesOp {
+	return &v21PlansPurchaseEnvelopesOp{op: s.s.PlansPurchaseEnvelopes(purchasedEnvelopesInformation)}
+}
+
+type v21PlansPurchaseEnvelopesOp struct {
+	op *billing.PlansPurchaseEnvelopesOp
+}
+
+func (o *v21PlansPurchaseEnvelopesOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PlansUpdate(billingPlanInformation *model.BillingPlanInformation) PlansUpdateOp {
+	return &v21PlansUpdateOp{op: s.s.PlansUpdate(billingPlanInformation)}
+}
+
+type v21PlansUpdateOp struct {
+	op *billing.PlansUpdateOp
+}
+
+func (o *v21PlansUpdateOp) PreviewBillingPlan() PlansUpdateOp {
+	o.op.PreviewBillingPlan()
+	return o
+}
+
+func (o *v21PlansUpdateOp) Do(ctx context.Context) (*model.BillingPlanUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) InvoicesGet(invoiceID string) InvoicesGetOp {
+	return &v21InvoicesGetOp{op: s.s.InvoicesGet(invoiceID)}
+}
+
+type v21InvoicesGetOp struct {
+	op *billing.InvoicesGetOp
+}
+
+func (o *v21InvoicesGetOp) Do(ctx context.Context) (*model.BillingInvoice, error) {
+	return o.op.Do(ctx)
+}
+
+func (o *v21InvoicesGetOp) PDF(ctx context.Context) (*esign.Download, error) {
+	return o.op.PDF(ctx)
+}
+
+func (s v21Service) InvoicesList() InvoicesListOp {
+	return &v21InvoicesListOp{op: s.s.InvoicesList()}
+}
+
+type v21InvoicesListOp struct {
+	op *billing.InvoicesListOp
+}
+
+func (o *v21InvoicesListOp) FromDate(val time.Time) InvoicesListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21InvoicesListOp) ToDate(val time.Time) InvoicesListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21InvoicesListOp) Do(ctx context.Context) (*model.BillingInvoicesResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) InvoicesListPastDue() InvoicesListPastDueOp {
+	return &v21InvoicesListPastDueOp{op: s.s.InvoicesListPastDue()}
+}
+
+type v21InvoicesListPastDueOp struct {
+	op *billing.InvoicesListPastDueOp
+}
+
+func (o *v21InvoicesListPastDueOp) Do(ctx context.Context) (*model.BillingInvoicesSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PaymentsCreate(billingPaymentRequest *model.BillingPaymentRequest) PaymentsCreateOp {
+	return &v21PaymentsCreateOp{op: s.s.PaymentsCreate(billingPaymentRequest)}
+}
+
+type v21PaymentsCreateOp struct {
+	op *billing.PaymentsCreateOp
+}
+
+func (o *v21PaymentsCreateOp) Do(ctx context.Context) (*model.BillingPaymentResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PaymentsGet(paymentID string) PaymentsGetOp {
+	return &v21PaymentsGetOp{op: s.s.PaymentsGet(paymentID)}
+}
+
+type v21PaymentsGetOp struct {
+	op *billing.PaymentsGetOp
+}
+
+func (o *v21PaymentsGetOp) Do(ctx context.Context) (*model.BillingPaymentItem, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) PaymentsList() PaymentsListOp {
+	return &v21PaymentsListOp{op: s.s.PaymentsList()}
+}
+
+type v21PaymentsListOp struct {
+	op *billing.PaymentsListOp
+}
+
+func (o *v21PaymentsListOp) FromDate(val time.Time) PaymentsListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21PaymentsListOp) ToDate(val time.Time) PaymentsListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21PaymentsListOp) Do(ctx context.Context) (*model.BillingPaymentsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2billing.Service
+}
+
+func (s v2Service) PlansGet(billingPlanID string) PlansGetOp {
+	return &v2PlansGetOp{op: s.s.PlansGet(billingPlanID)}
+}
+
+type v2PlansGetOp struct {
+	op  *v2billing.PlansGetOp
+	err error
+}
+
+func (o *v2PlansGetOp) Do(ctx context.Context) (*model.BillingPlanResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingPlanResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PlansGetAccountPlan() PlansGetAccountPlanOp {
+	return &v2PlansGetAccountPlanOp{op: s.s.PlansGetAccountPlan()}
+}
+
+type v2PlansGetAccountPlanOp struct {
+	op  *v2billing.PlansGetAccountPlanOp
+	err error
+}
+
+func (o *v2PlansGetAccountPlanOp) IncludeCreditCardInformation() PlansGetAccountPlanOp {
+	o.op.IncludeCreditCardInformation()
+	return o
+}
+
+func (o *v2PlansGetAccountPlanOp) IncludeMetadata() PlansGetAccountPlanOp {
+	o.op.IncludeMetadata()
+	return o
+}
+
+func (o *v2PlansGetAccountPlanOp) IncludeSuccessorPlans() PlansGetAccountPlanOp {
+	o.op.IncludeSuccessorPlans()
+	return o
+}
+
+func (o *v2PlansGetAccountPlanOp) Do(ctx context.Context) (*model.AccountBillingPlanResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.AccountBillingPlanResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PlansGetCreditCard() PlansGetCreditCardOp {
+	return &v2PlansGetCreditCardOp{op: s.s.PlansGetCreditCard()}
+}
+
+type v2PlansGetCreditCardOp struct {
+	op  *v2billing.PlansGetCreditCardOp
+	err error
+}
+
+func (o *v2PlansGetCreditCardOp) Do(ctx context.Context) (*model.CreditCardInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CreditCardInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PlansList() PlansListOp {
+	return &v2PlansListOp{op: s.s.PlansList()}
+}
+
+type v2PlansListOp struct {
+	op  *v2billing.PlansListOp
+	err error
+}
+
+func (o *v2PlansListOp) Do(ctx context.Context) (*model.BillingPlansResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingPlansResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PlansPurchaseEnvelopes(purchasedEnvelopesInformation *model.PurchasedEnvelopesInformation) PlansPurchaseEnvelopesOp {
+	o := &v2PlansPurchaseEnvelopesOp{}
+	var v2purchasedEnvelopesInformation *v2model.PurchasedEnvelopesInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2purchasedEnvelopesInformation, purchasedEnvelopesInformation)
+	}
+	o.op = s.s.PlansPurchaseEnvelopes(v2purchasedEnvelopesInformation)
+	return o
+}
+
+type v2PlansPurchaseEnvelopesOp struct {
+	op  *v2billing.PlansPurchaseEnvelopesOp
+	err error
+}
+
+func (o *v2PlansPurchaseEnvelopesOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) PlansUpdate(billingPlanInformation *model.BillingPlanInformation) PlansUpdateOp {
+	o := &v2PlansUpdateOp{}
+	var v2billingPlanInformation *v2model.BillingPlanInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2billingPlanInformation, billingPlanInformation)
+	}
+	o.op = s.s.PlansUpdate(v2billingPlanInformation)
+	return o
+}
+
+type v2PlansUpdateOp struct {
+	op  *v2billing.PlansUpdateOp
+	err error
+}
+
+func (o *v2PlansUpdateOp) PreviewBillingPlan() PlansUpdateOp {
+	o.op.PreviewBillingPlan()
+	return o
+}
+
+func (o *v2PlansUpdateOp) Do(ctx context.Context) (*model.BillingPlanUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingPlanUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) InvoicesGet(invoiceID string) InvoicesGetOp {
+	return &v2InvoicesGetOp{op: s.s.InvoicesGet(invoiceID)}
+}
+
+type v2InvoicesGetOp struct {
+	op  *v2billing.InvoicesGetOp
+	err error
+}
+
+func (o *v2InvoicesGetOp) Do(ctx context.Context) (*model.BillingInvoice, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingInvoice
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (o *v2InvoicesGetOp) PDF(ctx context.Context) (*esign.Download, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	return o.op.PDF(ctx)
+}
+
+func (s v2Service) InvoicesList() InvoicesListOp {
+	return &v2InvoicesListOp{op: s.s.InvoicesList()}
+}
+
+type v2InvoicesListOp struct {
+	op  *v2billing.InvoicesListOp
+	err error
+}
+
+func (o *v2InvoicesListOp) FromDate(val time.Time) InvoicesListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2InvoicesListOp) ToDate(val time.Time) InvoicesListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2InvoicesListOp) Do(ctx context.Context) (*model.BillingInvoicesResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingInvoicesResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) InvoicesListPastDue() InvoicesListPastDueOp {
+	return &v2InvoicesListPastDueOp{op: s.s.InvoicesListPastDue()}
+}
+
+type v2InvoicesListPastDueOp struct {
+	op  *v2billing.InvoicesListPastDueOp
+	err error
+}
+
+func (o *v2InvoicesListPastDueOp) Do(ctx context.Context) (*model.BillingInvoicesSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingInvoicesSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PaymentsCreate(billingPaymentRequest *model.BillingPaymentRequest) PaymentsCreateOp {
+	o := &v2PaymentsCreateOp{}
+	var v2billingPaymentRequest *v2model.BillingPaymentRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2billingPaymentRequest, billingPaymentRequest)
+	}
+	o.op = s.s.PaymentsCreate(v2billingPaymentRequest)
+	return o
+}
+
+type v2PaymentsCreateOp struct {
+	op  *v2billing.PaymentsCreateOp
+	err error
+}
+
+func (o *v2PaymentsCreateOp) Do(ctx context.Context) (*model.BillingPaymentResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingPaymentResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PaymentsGet(paymentID string) PaymentsGetOp {
+	return &v2PaymentsGetOp{op: s.s.PaymentsGet(paymentID)}
+}
+
+type v2PaymentsGetOp struct {
+	op  *v2billing.PaymentsGetOp
+	err error
+}
+
+func (o *v2PaymentsGetOp) Do(ctx context.Context) (*model.BillingPaymentItem, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingPaymentItem
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) PaymentsList() PaymentsListOp {
+	return &v2PaymentsListOp{op: s.s.PaymentsList()}
+}
+
+type v2PaymentsListOp struct {
+	op  *v2billing.PaymentsListOp
+	err error
+}
+
+func (o *v2PaymentsListOp) FromDate(val time.Time) PaymentsListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2PaymentsListOp) ToDate(val time.Time) PaymentsListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2PaymentsListOp) Do(ctx context.Context) (*model.BillingPaymentsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BillingPaymentsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
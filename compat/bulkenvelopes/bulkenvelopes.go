@@ -0,0 +1,352 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package bulkenvelopes defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 bulkenvelopes services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/bulkenvelopes"
+//	)
+//	...
+//	bulkenvelopesService := bulkenvelopes.New(esignCredential, cfg.UseV2)
+package bulkenvelopes // import "github.com/jfcote87/esign/compat/bulkenvelopes"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/bulkenvelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2bulkenvelopes "github.com/jfcote87/esign/v2/bulkenvelopes"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 bulkenvelopes services.
+type Service interface {
+	// Get gets the status of a specified bulk send operation.
+	Get(batchID string) GetOp
+	// List gets status information about bulk recipient batches.
+	List() ListOp
+	// RecipientsDelete deletes the bulk recipient file from an envelope.
+	RecipientsDelete(envelopeID string, recipientID string) RecipientsDeleteOp
+	// RecipientsList gets the bulk recipient file from an envelope.
+	RecipientsList(envelopeID string, recipientID string) RecipientsListOp
+	// RecipientsUpdate adds or replaces envelope bulk recipients.
+	RecipientsUpdate(envelopeID string, recipientID string, bulkRecipientsRequest *model.BulkRecipientsRequest) RecipientsUpdateOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2bulkenvelopes.New(cred)}
+	}
+	return v21Service{s: bulkenvelopes.New(cred)}
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	Count(val int) GetOp
+	Include(val ...string) GetOp
+	StartPosition(val int) GetOp
+	Do(ctx context.Context) (*model.BulkEnvelopeStatus, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	Count(val int) ListOp
+	Include(val ...string) ListOp
+	StartPosition(val int) ListOp
+	Do(ctx context.Context) (*model.BulkEnvelopesResponse, error)
+}
+
+// RecipientsDeleteOp is the version-neutral RecipientsDelete op.
+type RecipientsDeleteOp interface {
+	Do(ctx context.Context) (*model.BulkRecipientsUpdateResponse, error)
+}
+
+// RecipientsListOp is the version-neutral RecipientsList op.
+type RecipientsListOp interface {
+	IncludeTabs() RecipientsListOp
+	StartPosition(val int) RecipientsListOp
+	Do(ctx context.Context) (*model.BulkRecipientsResponse, error)
+}
+
+// RecipientsUpdateOp is the version-neutral RecipientsUpdate op.
+type RecipientsUpdateOp interface {
+	Do(ctx context.Context) (*model.BulkRecipientsSummaryResponse, error)
+}
+
+type v21Service struct {
+	s *bulkenvelopes.Service
+}
+
+func (s v21Service) Get(batchID string) GetOp {
+	return &v21GetOp{op: s.s.Get(batchID)}
+}
+
+type v21GetOp struct {
+	op *bulkenvelopes.GetOp
+}
+
+func (o *v21GetOp) Count(val int) GetOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21GetOp) Include(val ...string) GetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21GetOp) StartPosition(val int) GetOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.BulkEnvelopeStatus, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *bulkenvelopes.ListOp
+}
+
+func (o *v21ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListOp) Include(val ...string) ListOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.BulkEnvelopesResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsDelete(envelopeID string, recipientID string) RecipientsDeleteOp {
+	return &v21RecipientsDeleteOp{op: s.s.RecipientsDelete(envelopeID, recipientID)}
+}
+
+type v21RecipientsDeleteOp struct {
+	op *bulkenvelopes.RecipientsDeleteOp
+}
+
+func (o *v21RecipientsDeleteOp) Do(ctx context.Context) (*model.BulkRecipientsUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsList(envelopeID string, recipientID string) RecipientsListOp {
+	return &v21RecipientsListOp{op: s.s.RecipientsList(envelopeID, recipientID)}
+}
+
+type v21RecipientsListOp struct {
+	op *bulkenvelopes.RecipientsListOp
+}
+
+func (o *v21RecipientsListOp) IncludeTabs() RecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v21RecipientsListOp) StartPosition(val int) RecipientsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21RecipientsListOp) Do(ctx context.Context) (*model.BulkRecipientsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsUpdate(envelopeID string, recipientID string, bulkRecipientsRequest *model.BulkRecipientsRequest) RecipientsUpdateOp {
+	return &v21RecipientsUpdateOp{op: s.s.RecipientsUpdate(envelopeID, recipientID, bulkRecipientsRequest)}
+}
+
+type v21RecipientsUpdateOp struct {
+	op *bulkenvelopes.RecipientsUpdateOp
+}
+
+func (o *v21RecipientsUpdateOp) Do(ctx context.Context) (*model.BulkRecipientsSummaryResponse, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2bulkenvelopes.Service
+}
+
+func (s v2Service) Get(batchID string) GetOp {
+	return &v2GetOp{op: s.s.Get(batchID)}
+}
+
+type v2GetOp struct {
+	op  *v2bulkenvelopes.GetOp
+	err error
+}
+
+func (o *v2GetOp) Count(val int) GetOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2GetOp) Include(val ...string) GetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2GetOp) StartPosition(val int) GetOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.BulkEnvelopeStatus, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkEnvelopeStatus
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2bulkenvelopes.ListOp
+	err error
+}
+
+func (o *v2ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListOp) Include(val ...string) ListOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.BulkEnvelopesResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkEnvelopesResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsDelete(envelopeID string, recipientID string) RecipientsDeleteOp {
+	return &v2RecipientsDeleteOp{op: s.s.RecipientsDelete(envelopeID, recipientID)}
+}
+
+type v2RecipientsDeleteOp struct {
+	op  *v2bulkenvelopes.RecipientsDeleteOp
+	err error
+}
+
+func (o *v2RecipientsDeleteOp) Do(ctx context.Context) (*model.BulkRecipientsUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkRecipientsUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsList(envelopeID string, recipientID string) RecipientsListOp {
+	return &v2RecipientsListOp{op: s.s.RecipientsList(envelopeID, recipientID)}
+}
+
+type v2RecipientsListOp struct {
+	op  *v2bulkenvelopes.RecipientsListOp
+	err error
+}
+
+func (o *v2RecipientsListOp) IncludeTabs() RecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v2RecipientsListOp) StartPosition(val int) RecipientsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2RecipientsListOp) Do(ctx context.Context) (*model.BulkRecipientsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkRecipientsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsUpdate(envelopeID string, recipientID string, bulkRecipientsRequest *model.BulkRecipientsRequest) RecipientsUpdateOp {
+	o := &v2RecipientsUpdateOp{}
+	var v2bulkRecipientsRequest *v2model.BulkRecipientsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2bulkRecipientsRequest, bulkRecipientsRequest)
+	}
+	o.op = s.s.RecipientsUpdate(envelopeID, recipientID, v2bulkRecipientsRequest)
+	return o
+}
+
+type v2RecipientsUpdateOp struct {
+	op  *v2bulkenvelopes.RecipientsUpdateOp
+	err error
+}
+
+func (o *v2RecipientsUpdateOp) Do(ctx context.Context) (*model.BulkRecipientsSummaryResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkRecipientsSummaryResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -0,0 +1,506 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package cloudstorage defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 cloudstorage services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/cloudstorage"
+//	)
+//	...
+//	cloudstorageService := cloudstorage.New(esignCredential, cfg.UseV2)
+package cloudstorage // import "github.com/jfcote87/esign/compat/cloudstorage"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/cloudstorage"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2cloudstorage "github.com/jfcote87/esign/v2/cloudstorage"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 cloudstorage services.
+type Service interface {
+	// List gets a list of all the items from the specified cloud storage provider.
+	List(folderID string, serviceID string, userID string) ListOp
+	// ListFolders retrieves a list of all the items in a specified folder from the specified cloud storage provider.
+	ListFolders(serviceID string, userID string) ListFoldersOp
+	// ProvidersCreate configures the redirect URL information  for one or more cloud storage providers for the specified user.
+	ProvidersCreate(userID string, cloudStorageProviders *model.CloudStorageProviders) ProvidersCreateOp
+	// ProvidersDelete deletes the user authentication information for the specified cloud storage provider.
+	ProvidersDelete(serviceID string, userID string) ProvidersDeleteOp
+	// ProvidersDeleteList deletes the user authentication information for one or more cloud storage providers.
+	ProvidersDeleteList(userID string, cloudStorageProviders *model.CloudStorageProviders) ProvidersDeleteListOp
+	// ProvidersGet gets the specified Cloud Storage Provider configuration for the User.
+	ProvidersGet(serviceID string, userID string) ProvidersGetOp
+	// ProvidersList get the Cloud Storage Provider configuration for the specified user.
+	ProvidersList(userID string) ProvidersListOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2cloudstorage.New(cred)}
+	}
+	return v21Service{s: cloudstorage.New(cred)}
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	CloudStorageFolderPath(val string) ListOp
+	Count(val int) ListOp
+	Order(val string) ListOp
+	OrderBy(val string) ListOp
+	SearchText(val string) ListOp
+	StartPosition(val int) ListOp
+	Do(ctx context.Context) (*model.ExternalFolder, error)
+}
+
+// ListFoldersOp is the version-neutral ListFolders op.
+type ListFoldersOp interface {
+	CloudStorageFolderPath(val ...string) ListFoldersOp
+	Count(val int) ListFoldersOp
+	Order(val string) ListFoldersOp
+	OrderBy(val string) ListFoldersOp
+	SearchText(val string) ListFoldersOp
+	StartPosition(val int) ListFoldersOp
+	Do(ctx context.Context) (*model.ExternalFolder, error)
+}
+
+// ProvidersCreateOp is the version-neutral ProvidersCreate op.
+type ProvidersCreateOp interface {
+	Do(ctx context.Context) (*model.CloudStorageProviders, error)
+}
+
+// ProvidersDeleteOp is the version-neutral ProvidersDelete op.
+type ProvidersDeleteOp interface {
+	Do(ctx context.Context) (*model.CloudStorageProviders, error)
+}
+
+// ProvidersDeleteListOp is the version-neutral ProvidersDeleteList op.
+type ProvidersDeleteListOp interface {
+	Do(ctx context.Context) (*model.CloudStorageProviders, error)
+}
+
+// ProvidersGetOp is the version-neutral ProvidersGet op.
+type ProvidersGetOp interface {
+	RedirectURL(val string) ProvidersGetOp
+	Do(ctx context.Context) (*model.CloudStorageProviders, error)
+}
+
+// ProvidersListOp is the version-neutral ProvidersList op.
+type ProvidersListOp interface {
+	RedirectURL(val string) ProvidersListOp
+	Do(ctx context.Context) (*model.CloudStorageProviders, error)
+}
+
+type v21Service struct {
+	s *cloudstorage.Service
+}
+
+func (s v21Service) List(folderID string, serviceID string, userID string) ListOp {
+	return &v21ListOp{op: s.s.List(folderID, serviceID, userID)}
+}
+
+type v21ListOp struct {
+	op *cloudstorage.ListOp
+}
+
+func (o *v21ListOp) CloudStorageFolderPath(val string) ListOp {
+	o.op.CloudStorageFolderPath(val)
+	return o
+}
+
+func (o *v21ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListOp) Order(val string) ListOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v21ListOp) OrderBy(val string) ListOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v21ListOp) SearchText(val string) ListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.ExternalFolder, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListFolders(serviceID string, userID string) ListFoldersOp {
+	return &v21ListFoldersOp{op: s.s.ListFolders(serviceID, userID)}
+}
+
+type v21ListFoldersOp struct {
+	op *cloudstorage.ListFoldersOp
+}
+
+func (o *v21ListFoldersOp) CloudStorageFolderPath(val ...string) ListFoldersOp {
+	o.op.CloudStorageFolderPath(val...)
+	return o
+}
+
+func (o *v21ListFoldersOp) Count(val int) ListFoldersOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListFoldersOp) Order(val string) ListFoldersOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v21ListFoldersOp) OrderBy(val string) ListFoldersOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v21ListFoldersOp) SearchText(val string) ListFoldersOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21ListFoldersOp) StartPosition(val int) ListFoldersOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListFoldersOp) Do(ctx context.Context) (*model.ExternalFolder, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProvidersCreate(userID string, cloudStorageProviders *model.CloudStorageProviders) ProvidersCreateOp {
+	return &v21ProvidersCreateOp{op: s.s.ProvidersCreate(userID, cloudStorageProviders)}
+}
+
+type v21ProvidersCreateOp struct {
+	op *cloudstorage.ProvidersCreateOp
+}
+
+func (o *v21ProvidersCreateOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProvidersDelete(serviceID string, userID string) ProvidersDeleteOp {
+	return &v21ProvidersDeleteOp{op: s.s.ProvidersDelete(serviceID, userID)}
+}
+
+type v21ProvidersDeleteOp struct {
+	op *cloudstorage.ProvidersDeleteOp
+}
+
+func (o *v21ProvidersDeleteOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProvidersDeleteList(userID string, cloudStorageProviders *model.CloudStorageProviders) ProvidersDeleteListOp {
+	return &v21ProvidersDeleteListOp{op: s.s.ProvidersDeleteList(userID, cloudStorageProviders)}
+}
+
+type v21ProvidersDeleteListOp struct {
+	op *cloudstorage.ProvidersDeleteListOp
+}
+
+func (o *v21ProvidersDeleteListOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProvidersGet(serviceID string, userID string) ProvidersGetOp {
+	return &v21ProvidersGetOp{op: s.s.ProvidersGet(serviceID, userID)}
+}
+
+type v21ProvidersGetOp struct {
+	op *cloudstorage.ProvidersGetOp
+}
+
+func (o *v21ProvidersGetOp) RedirectURL(val string) ProvidersGetOp {
+	o.op.RedirectURL(val)
+	return o
+}
+
+func (o *v21ProvidersGetOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProvidersList(userID string) ProvidersListOp {
+	return &v21ProvidersListOp{op: s.s.ProvidersList(userID)}
+}
+
+type v21ProvidersListOp struct {
+	op *cloudstorage.ProvidersListOp
+}
+
+func (o *v21ProvidersListOp) RedirectURL(val string) ProvidersListOp {
+	o.op.RedirectURL(val)
+	return o
+}
+
+func (o *v21ProvidersListOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2cloudstorage.Service
+}
+
+func (s v2Service) List(folderID string, serviceID string, userID string) ListOp {
+	return &v2ListOp{op: s.s.List(folderID, serviceID, userID)}
+}
+
+type v2ListOp struct {
+	op  *v2cloudstorage.ListOp
+	err error
+}
+
+func (o *v2ListOp) CloudStorageFolderPath(val string) ListOp {
+	o.op.CloudStorageFolderPath(val)
+	return o
+}
+
+func (o *v2ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListOp) Order(val string) ListOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v2ListOp) OrderBy(val string) ListOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v2ListOp) SearchText(val string) ListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.ExternalFolder, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ExternalFolder
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListFolders(serviceID string, userID string) ListFoldersOp {
+	return &v2ListFoldersOp{op: s.s.ListFolders(serviceID, userID)}
+}
+
+type v2ListFoldersOp struct {
+	op  *v2cloudstorage.ListFoldersOp
+	err error
+}
+
+func (o *v2ListFoldersOp) CloudStorageFolderPath(val ...string) ListFoldersOp {
+	o.op.CloudStorageFolderPath(val...)
+	return o
+}
+
+func (o *v2ListFoldersOp) Count(val int) ListFoldersOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListFoldersOp) Order(val string) ListFoldersOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v2ListFoldersOp) OrderBy(val string) ListFoldersOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v2ListFoldersOp) SearchText(val string) ListFoldersOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2ListFoldersOp) StartPosition(val int) ListFoldersOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListFoldersOp) Do(ctx context.Context) (*model.ExternalFolder, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ExternalFolder
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProvidersCreate(userID string, cloudStorageProviders *model.CloudStorageProviders) ProvidersCreateOp {
+	o := &v2ProvidersCreateOp{}
+	var v2cloudStorageProviders *v2model.CloudStorageProviders
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2cloudStorageProviders, cloudStorageProviders)
+	}
+	o.op = s.s.ProvidersCreate(userID, v2cloudStorageProviders)
+	return o
+}
+
+type v2ProvidersCreateOp struct {
+	op  *v2cloudstorage.ProvidersCreateOp
+	err error
+}
+
+func (o *v2ProvidersCreateOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CloudStorageProviders
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProvidersDelete(serviceID string, userID string) ProvidersDeleteOp {
+	return &v2ProvidersDeleteOp{op: s.s.ProvidersDelete(serviceID, userID)}
+}
+
+type v2ProvidersDeleteOp struct {
+	op  *v2cloudstorage.ProvidersDeleteOp
+	err error
+}
+
+func (o *v2ProvidersDeleteOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CloudStorageProviders
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProvidersDeleteList(userID string, cloudStorageProviders *model.CloudStorageProviders) ProvidersDeleteListOp {
+	o := &v2ProvidersDeleteListOp{}
+	var v2cloudStorageProviders *v2model.CloudStorageProviders
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2cloudStorageProviders, cloudStorageProviders)
+	}
+	o.op = s.s.ProvidersDeleteList(userID, v2cloudStorageProviders)
+	return o
+}
+
+type v2ProvidersDeleteListOp struct {
+	op  *v2cloudstorage.ProvidersDeleteListOp
+	err error
+}
+
+func (o *v2ProvidersDeleteListOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CloudStorageProviders
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProvidersGet(serviceID string, userID string) ProvidersGetOp {
+	return &v2ProvidersGetOp{op: s.s.ProvidersGet(serviceID, userID)}
+}
+
+type v2ProvidersGetOp struct {
+	op  *v2cloudstorage.ProvidersGetOp
+	err error
+}
+
+func (o *v2ProvidersGetOp) RedirectURL(val string) ProvidersGetOp {
+	o.op.RedirectURL(val)
+	return o
+}
+
+func (o *v2ProvidersGetOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CloudStorageProviders
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProvidersList(userID string) ProvidersListOp {
+	return &v2ProvidersListOp{op: s.s.ProvidersList(userID)}
+}
+
+type v2ProvidersListOp struct {
+	op  *v2cloudstorage.ProvidersListOp
+	err error
+}
+
+func (o *v2ProvidersListOp) RedirectURL(val string) ProvidersListOp {
+	o.op.RedirectURL(val)
+	return o
+}
+
+func (o *v2ProvidersListOp) Do(ctx context.Context) (*model.CloudStorageProviders, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CloudStorageProviders
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
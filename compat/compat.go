@@ -0,0 +1,206 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package compat assists moving code between the v2 and v2.1 service
+// packages.  The subpackages, generated by gen-esign, define
+// version-neutral interfaces for the ops each service shares in both
+// versions using v2.1 model types.  Convert copies model structs between
+// versions, and CompareServices reports the differences between the two
+// versions of a service.
+//
+//	import "github.com/jfcote87/esign/compat/envelopes"
+//	...
+//	sv := envelopes.New(cred, cfg.UseV2)
+//	env, err := sv.Get(envelopeID).Include("recipients").Do(ctx) // *model.Envelope (v2.1)
+package compat // import "github.com/jfcote87/esign/compat"
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Convert copies src into dst, a pointer to the corresponding model struct
+// of the other api version, by matching json field names.  The json paths
+// of src values that have no corresponding dst field are returned.
+func Convert(dst, src interface{}) ([]string, error) {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, dst); err != nil {
+		return nil, err
+	}
+	var srcVals, dstVals interface{}
+	if err = json.Unmarshal(b, &srcVals); err != nil {
+		return nil, err
+	}
+	if b, err = json.Marshal(dst); err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &dstVals); err != nil {
+		return nil, err
+	}
+	var dropped []string
+	missingPaths("", srcVals, dstVals, &dropped)
+	sort.Strings(dropped)
+	return dropped, nil
+}
+
+// missingPaths appends the paths of values in src not found in dst.
+func missingPaths(path string, src, dst interface{}, dropped *[]string) {
+	switch s := src.(type) {
+	case map[string]interface{}:
+		d, _ := dst.(map[string]interface{})
+		for k, v := range s {
+			dv, ok := d[k]
+			if !ok {
+				*dropped = append(*dropped, path+k)
+				continue
+			}
+			missingPaths(path+k+".", v, dv, dropped)
+		}
+	case []interface{}:
+		d, _ := dst.([]interface{})
+		for i, v := range s {
+			if i >= len(d) {
+				*dropped = append(*dropped, fmt.Sprintf("%s%d", path, i))
+				continue
+			}
+			missingPaths(fmt.Sprintf("%s%d.", path, i), v, d[i], dropped)
+		}
+	}
+}
+
+// DroppedFieldsError lists the json paths of values that have no
+// corresponding field in the other version.
+type DroppedFieldsError struct {
+	Paths []string
+}
+
+// Error fulfills the error interface.
+func (e *DroppedFieldsError) Error() string {
+	return "compat: values not supported by target version: " + strings.Join(e.Paths, ", ")
+}
+
+// ConvertAll copies src into dst as Convert does, returning a
+// *DroppedFieldsError if any value of src has no corresponding field.
+func ConvertAll(dst, src interface{}) error {
+	dropped, err := Convert(dst, src)
+	if err == nil && len(dropped) > 0 {
+		err = &DroppedFieldsError{Paths: dropped}
+	}
+	return err
+}
+
+// Difference describes how an op differs between two versions of a
+// service.
+type Difference struct {
+	// Op is the Service method creating the op.
+	Op     string
+	Detail string
+}
+
+// String fulfills the Stringer interface
+func (d Difference) String() string {
+	return d.Op + ": " + d.Detail
+}
+
+// CompareServices compares the ops of two versions of a service (e.g.
+// v2/envelopes.New(cred) and v2.1/envelopes.New(cred)).  Differences in ops,
+// parameters, query options and results are returned sorted by op. Model
+// types are compared by name.
+func CompareServices(a, b interface{}) []Difference {
+	ta, tb := reflect.TypeOf(a), reflect.TypeOf(b)
+	var diffs []Difference
+	for i := 0; i < ta.NumMethod(); i++ {
+		ma := ta.Method(i)
+		mb, ok := tb.MethodByName(ma.Name)
+		if !ok {
+			diffs = append(diffs, Difference{Op: ma.Name, Detail: "only in " + pkgOf(ta)})
+			continue
+		}
+		diffs = append(diffs, compareFuncs(ma.Name, ma.Type, mb.Type)...)
+		if ma.Type.NumOut() == 1 && mb.Type.NumOut() == 1 {
+			diffs = append(diffs, compareOps(ma.Name, ma.Type.Out(0), mb.Type.Out(0))...)
+		}
+	}
+	for i := 0; i < tb.NumMethod(); i++ {
+		if _, ok := ta.MethodByName(tb.Method(i).Name); !ok {
+			diffs = append(diffs, Difference{Op: tb.Method(i).Name, Detail: "only in " + pkgOf(tb)})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Op < diffs[j].Op
+	})
+	return diffs
+}
+
+// compareFuncs compares parameter and result types of methods.
+func compareFuncs(op string, fa, fb reflect.Type) []Difference {
+	if fa.NumIn() != fb.NumIn() {
+		return []Difference{{Op: op, Detail: fmt.Sprintf("parameters %s vs %s", funcArgs(fa), funcArgs(fb))}}
+	}
+	for i := 0; i < fa.NumIn(); i++ {
+		if fa.In(i).String() != fb.In(i).String() {
+			return []Difference{{Op: op, Detail: fmt.Sprintf("parameters %s vs %s", funcArgs(fa), funcArgs(fb))}}
+		}
+	}
+	return nil
+}
+
+// compareOps compares the query options and Do results of op types.
+func compareOps(op string, oa, ob reflect.Type) []Difference {
+	var diffs []Difference
+	for i := 0; i < oa.NumMethod(); i++ {
+		ma := oa.Method(i)
+		mb, ok := ob.MethodByName(ma.Name)
+		switch {
+		case !ok:
+			diffs = append(diffs, Difference{Op: op, Detail: "option " + ma.Name + " only in " + pkgOf(oa)})
+		case ma.Name == "Do":
+			if results(ma.Type) != results(mb.Type) {
+				diffs = append(diffs, Difference{Op: op, Detail: fmt.Sprintf("result %s vs %s", results(ma.Type), results(mb.Type))})
+			}
+		default:
+			if d := compareFuncs(op, ma.Type, mb.Type); len(d) > 0 {
+				diffs = append(diffs, Difference{Op: op, Detail: "option " + ma.Name + " " + d[0].Detail})
+			}
+		}
+	}
+	for i := 0; i < ob.NumMethod(); i++ {
+		if _, ok := oa.MethodByName(ob.Method(i).Name); !ok {
+			diffs = append(diffs, Difference{Op: op, Detail: "option " + ob.Method(i).Name + " only in " + pkgOf(ob)})
+		}
+	}
+	return diffs
+}
+
+// funcArgs lists parameter types excluding the receiver.
+func funcArgs(f reflect.Type) string {
+	var args []string
+	for i := 1; i < f.NumIn(); i++ {
+		args = append(args, f.In(i).String())
+	}
+	return "(" + strings.Join(args, ", ") + ")"
+}
+
+func results(f reflect.Type) string {
+	var res []string
+	for i := 0; i < f.NumOut(); i++ {
+		res = append(res, f.Out(i).String())
+	}
+	return "(" + strings.Join(res, ", ") + ")"
+}
+
+// pkgOf returns the package path of a (pointer) type.
+func pkgOf(t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.PkgPath()
+}
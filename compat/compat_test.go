@@ -0,0 +1,192 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package compat_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	caccounts "github.com/jfcote87/esign/compat/accounts"
+	cbilling "github.com/jfcote87/esign/compat/billing"
+	cbulkenvelopes "github.com/jfcote87/esign/compat/bulkenvelopes"
+	ccloudstorage "github.com/jfcote87/esign/compat/cloudstorage"
+	cconnect "github.com/jfcote87/esign/compat/connect"
+	ccustomtabs "github.com/jfcote87/esign/compat/customtabs"
+	cdiagnostics "github.com/jfcote87/esign/compat/diagnostics"
+	cenvelopes "github.com/jfcote87/esign/compat/envelopes"
+	cfolders "github.com/jfcote87/esign/compat/folders"
+	cpowerforms "github.com/jfcote87/esign/compat/powerforms"
+	csigninggroups "github.com/jfcote87/esign/compat/signinggroups"
+	ctemplates "github.com/jfcote87/esign/compat/templates"
+	cuncategorized "github.com/jfcote87/esign/compat/uncategorized"
+	cusergroups "github.com/jfcote87/esign/compat/usergroups"
+	cusers "github.com/jfcote87/esign/compat/users"
+	cworkspaces "github.com/jfcote87/esign/compat/workspaces"
+	"github.com/jfcote87/esign/v2.1/accounts"
+	"github.com/jfcote87/esign/v2.1/billing"
+	"github.com/jfcote87/esign/v2.1/bulkenvelopes"
+	"github.com/jfcote87/esign/v2.1/cloudstorage"
+	"github.com/jfcote87/esign/v2.1/connect"
+	"github.com/jfcote87/esign/v2.1/customtabs"
+	"github.com/jfcote87/esign/v2.1/diagnostics"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/folders"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/powerforms"
+	"github.com/jfcote87/esign/v2.1/signinggroups"
+	"github.com/jfcote87/esign/v2.1/templates"
+	"github.com/jfcote87/esign/v2.1/uncategorized"
+	"github.com/jfcote87/esign/v2.1/usergroups"
+	"github.com/jfcote87/esign/v2.1/users"
+	"github.com/jfcote87/esign/v2.1/workspaces"
+	v2accounts "github.com/jfcote87/esign/v2/accounts"
+	v2billing "github.com/jfcote87/esign/v2/billing"
+	v2bulkenvelopes "github.com/jfcote87/esign/v2/bulkenvelopes"
+	v2cloudstorage "github.com/jfcote87/esign/v2/cloudstorage"
+	v2connect "github.com/jfcote87/esign/v2/connect"
+	v2customtabs "github.com/jfcote87/esign/v2/customtabs"
+	v2diagnostics "github.com/jfcote87/esign/v2/diagnostics"
+	v2envelopes "github.com/jfcote87/esign/v2/envelopes"
+	v2folders "github.com/jfcote87/esign/v2/folders"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2powerforms "github.com/jfcote87/esign/v2/powerforms"
+	v2signinggroups "github.com/jfcote87/esign/v2/signinggroups"
+	v2templates "github.com/jfcote87/esign/v2/templates"
+	v2uncategorized "github.com/jfcote87/esign/v2/uncategorized"
+	v2usergroups "github.com/jfcote87/esign/v2/usergroups"
+	v2users "github.com/jfcote87/esign/v2/users"
+	v2workspaces "github.com/jfcote87/esign/v2/workspaces"
+	"github.com/jfcote87/testutils"
+)
+
+func TestConvert(t *testing.T) {
+	src := &model.Envelope{
+		EnvelopeID:    "ENVID",
+		EmailSubject:  "Subject",
+		AnySigner:     "true",
+		ExpireEnabled: "true",
+		Recipients: &model.Recipients{
+			Signers: []model.Signer{{Name: "Signer", Email: "signer@example.com"}},
+		},
+	}
+	var dst v2model.Envelope
+	dropped, err := compat.Convert(&dst, src)
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if dst.EnvelopeID != "ENVID" || dst.EmailSubject != "Subject" ||
+		dst.Recipients == nil || len(dst.Recipients.Signers) != 1 || dst.Recipients.Signers[0].Email != "signer@example.com" {
+		t.Errorf("expected values copied; got %#v", dst)
+	}
+	if want := []string{"anySigner", "expireEnabled"}; !reflect.DeepEqual(dropped, want) {
+		t.Errorf("expected dropped %v; got %v", want, dropped)
+	}
+
+	var back model.Envelope
+	if dropped, err = compat.Convert(&back, &dst); err != nil || len(dropped) > 0 {
+		t.Errorf("expected v2 to v2.1 without loss; got %v %v", dropped, err)
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	testTransport.Add(&testutils.RequestTester{
+		Path: "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(`{"sub": "USER", "accounts": [
+			{"account_id": "ACCOUNT1", "is_default": true, "base_uri": "https://gotest.docusign.net"}]}`), nil),
+	})
+	for _, useV2 := range []bool{true, false} {
+		ver := "v2.1"
+		if useV2 {
+			ver = "v2"
+		}
+		testTransport.Add(&testutils.RequestTester{
+			Path:     "/restapi/" + ver + "/accounts/ACCOUNT1/envelopes/ENVID",
+			Query:    "include=recipients",
+			Response: testutils.MakeResponse(200, []byte(`{"envelopeId":"ENVID","status":"sent"}`), nil),
+		}, &testutils.RequestTester{
+			Path:     "/restapi/" + ver + "/accounts/ACCOUNT1/envelopes",
+			Method:   "POST",
+			Response: testutils.MakeResponse(201, []byte(`{"envelopeId":"ENVID","status":"created"}`), nil),
+		})
+		sv := cenvelopes.New(cred, useV2)
+		env, err := sv.Get("ENVID").Include("recipients").Do(ctx)
+		if err != nil || env.EnvelopeID != "ENVID" || env.Status != "sent" {
+			t.Errorf("%s: expected sent envelope; got %#v %v", ver, env, err)
+		}
+		summary, err := sv.Create(&model.EnvelopeDefinition{EmailSubject: "Subject"}).Do(ctx)
+		if err != nil || summary.EnvelopeID != "ENVID" {
+			t.Errorf("%s: expected created envelope; got %#v %v", ver, summary, err)
+		}
+	}
+	// v2.1 only values may not be sent to v2
+	_, err := cenvelopes.New(cred, true).Create(&model.EnvelopeDefinition{AnySigner: "true"}).Do(ctx)
+	var droppedErr *compat.DroppedFieldsError
+	if !errors.As(err, &droppedErr) || !reflect.DeepEqual(droppedErr.Paths, []string{"anySigner"}) {
+		t.Errorf("expected dropped anySigner error; got %v", err)
+	}
+}
+
+// TestConformance logs the differences between the v2 and v2.1 services
+// and fails if a compat Service does not contain exactly the ops shared
+// by both versions.
+func TestConformance(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		v2, v21 interface{}
+		compat  reflect.Type
+	}{
+		{"accounts", v2accounts.New(nil), accounts.New(nil), reflect.TypeOf((*caccounts.Service)(nil)).Elem()},
+		{"billing", v2billing.New(nil), billing.New(nil), reflect.TypeOf((*cbilling.Service)(nil)).Elem()},
+		{"bulkenvelopes", v2bulkenvelopes.New(nil), bulkenvelopes.New(nil), reflect.TypeOf((*cbulkenvelopes.Service)(nil)).Elem()},
+		{"cloudstorage", v2cloudstorage.New(nil), cloudstorage.New(nil), reflect.TypeOf((*ccloudstorage.Service)(nil)).Elem()},
+		{"connect", v2connect.New(nil), connect.New(nil), reflect.TypeOf((*cconnect.Service)(nil)).Elem()},
+		{"customtabs", v2customtabs.New(nil), customtabs.New(nil), reflect.TypeOf((*ccustomtabs.Service)(nil)).Elem()},
+		{"diagnostics", v2diagnostics.New(nil), diagnostics.New(nil), reflect.TypeOf((*cdiagnostics.Service)(nil)).Elem()},
+		{"envelopes", v2envelopes.New(nil), envelopes.New(nil), reflect.TypeOf((*cenvelopes.Service)(nil)).Elem()},
+		{"folders", v2folders.New(nil), folders.New(nil), reflect.TypeOf((*cfolders.Service)(nil)).Elem()},
+		{"powerforms", v2powerforms.New(nil), powerforms.New(nil), reflect.TypeOf((*cpowerforms.Service)(nil)).Elem()},
+		{"signinggroups", v2signinggroups.New(nil), signinggroups.New(nil), reflect.TypeOf((*csigninggroups.Service)(nil)).Elem()},
+		{"templates", v2templates.New(nil), templates.New(nil), reflect.TypeOf((*ctemplates.Service)(nil)).Elem()},
+		{"uncategorized", v2uncategorized.New(nil), uncategorized.New(nil), reflect.TypeOf((*cuncategorized.Service)(nil)).Elem()},
+		{"usergroups", v2usergroups.New(nil), usergroups.New(nil), reflect.TypeOf((*cusergroups.Service)(nil)).Elem()},
+		{"users", v2users.New(nil), users.New(nil), reflect.TypeOf((*cusers.Service)(nil)).Elem()},
+		{"workspaces", v2workspaces.New(nil), workspaces.New(nil), reflect.TypeOf((*cworkspaces.Service)(nil)).Elem()},
+	} {
+		notShared := make(map[string]bool)
+		for _, d := range compat.CompareServices(tt.v2, tt.v21) {
+			if strings.HasPrefix(d.Detail, "only in") || strings.HasPrefix(d.Detail, "parameters") || strings.HasPrefix(d.Detail, "result") {
+				notShared[d.Op] = true
+			}
+			t.Logf("%s %s", tt.name, d)
+		}
+		var shared []string
+		sv := reflect.TypeOf(tt.v21)
+		for i := 0; i < sv.NumMethod(); i++ {
+			if nm := sv.Method(i).Name; !notShared[nm] {
+				shared = append(shared, nm)
+			}
+		}
+		var ops []string
+		for i := 0; i < tt.compat.NumMethod(); i++ {
+			ops = append(ops, tt.compat.Method(i).Name)
+		}
+		if !reflect.DeepEqual(ops, shared) {
+			t.Errorf("%s: compat service ops %v; expected %v", tt.name, ops, shared)
+		}
+	}
+}
@@ -0,0 +1,728 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package connect defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 connect services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/connect"
+//	)
+//	...
+//	connectService := connect.New(esignCredential, cfg.UseV2)
+package connect // import "github.com/jfcote87/esign/compat/connect"
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/connect"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2connect "github.com/jfcote87/esign/v2/connect"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 connect services.
+type Service interface {
+	// ConfigurationsCreate creates a connect configuration for the specified account.
+	ConfigurationsCreate(connectConfigurations *model.ConnectCustomConfiguration) ConfigurationsCreateOp
+	// ConfigurationsDelete deletes the specified connect configuration.
+	ConfigurationsDelete(connectID string) ConfigurationsDeleteOp
+	// ConfigurationsGet get information on a Connect Configuration
+	ConfigurationsGet(connectID string) ConfigurationsGetOp
+	// ConfigurationsList get Connect Configuration Information
+	ConfigurationsList() ConfigurationsListOp
+	// ConfigurationsListUsers returns users from the configured Connect service.
+	ConfigurationsListUsers(connectID string) ConfigurationsListUsersOp
+	// ConfigurationsUpdate updates a specified Connect configuration.
+	ConfigurationsUpdate(connectConfigurations *model.ConnectCustomConfiguration) ConfigurationsUpdateOp
+	// EventsDelete deletes a specified Connect log entry.
+	EventsDelete(logID string) EventsDeleteOp
+	// EventsDeleteFailure deletes a Connect failure log entry.
+	EventsDeleteFailure(failureID string) EventsDeleteFailureOp
+	// EventsDeleteList gets a list of Connect log entries.
+	EventsDeleteList() EventsDeleteListOp
+	// EventsGet get the specified Connect log entry.
+	EventsGet(logID string) EventsGetOp
+	// EventsList gets the Connect log.
+	EventsList() EventsListOp
+	// EventsListFailures gets the Connect failure log information.
+	EventsListFailures() EventsListFailuresOp
+	// EventsRetryForEnvelope republishes Connect information for the specified envelope.
+	EventsRetryForEnvelope(envelopeID string, media io.Reader, mimeType string) EventsRetryForEnvelopeOp
+	// EventsRetryForEnvelopes republishes Connect information for multiple envelopes.
+	EventsRetryForEnvelopes(connectFailureFilter *model.ConnectFailureFilter) EventsRetryForEnvelopesOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2connect.New(cred)}
+	}
+	return v21Service{s: connect.New(cred)}
+}
+
+// ConfigurationsCreateOp is the version-neutral ConfigurationsCreate op.
+type ConfigurationsCreateOp interface {
+	Do(ctx context.Context) (*model.ConnectCustomConfiguration, error)
+}
+
+// ConfigurationsDeleteOp is the version-neutral ConfigurationsDelete op.
+type ConfigurationsDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// ConfigurationsGetOp is the version-neutral ConfigurationsGet op.
+type ConfigurationsGetOp interface {
+	Do(ctx context.Context) (*model.ConnectConfigResults, error)
+}
+
+// ConfigurationsListOp is the version-neutral ConfigurationsList op.
+type ConfigurationsListOp interface {
+	Do(ctx context.Context) (*model.ConnectConfigResults, error)
+}
+
+// ConfigurationsListUsersOp is the version-neutral ConfigurationsListUsers op.
+type ConfigurationsListUsersOp interface {
+	Count(val int) ConfigurationsListUsersOp
+	EmailSubstring(val string) ConfigurationsListUsersOp
+	ListIncludedUsers() ConfigurationsListUsersOp
+	StartPosition(val int) ConfigurationsListUsersOp
+	Status(val ...string) ConfigurationsListUsersOp
+	UserNameSubstring(val string) ConfigurationsListUsersOp
+	Do(ctx context.Context) (*model.IntegratedUserInfoList, error)
+}
+
+// ConfigurationsUpdateOp is the version-neutral ConfigurationsUpdate op.
+type ConfigurationsUpdateOp interface {
+	Do(ctx context.Context) (*model.ConnectCustomConfiguration, error)
+}
+
+// EventsDeleteOp is the version-neutral EventsDelete op.
+type EventsDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// EventsDeleteFailureOp is the version-neutral EventsDeleteFailure op.
+type EventsDeleteFailureOp interface {
+	Do(ctx context.Context) error
+}
+
+// EventsDeleteListOp is the version-neutral EventsDeleteList op.
+type EventsDeleteListOp interface {
+	Do(ctx context.Context) error
+}
+
+// EventsGetOp is the version-neutral EventsGet op.
+type EventsGetOp interface {
+	AdditionalInfo() EventsGetOp
+	Do(ctx context.Context) (*model.ConnectLog, error)
+}
+
+// EventsListOp is the version-neutral EventsList op.
+type EventsListOp interface {
+	FromDate(val time.Time) EventsListOp
+	ToDate(val time.Time) EventsListOp
+	Do(ctx context.Context) (*model.ConnectLogs, error)
+}
+
+// EventsListFailuresOp is the version-neutral EventsListFailures op.
+type EventsListFailuresOp interface {
+	FromDate(val time.Time) EventsListFailuresOp
+	ToDate(val time.Time) EventsListFailuresOp
+	Do(ctx context.Context) (*model.ConnectLogs, error)
+}
+
+// EventsRetryForEnvelopeOp is the version-neutral EventsRetryForEnvelope op.
+type EventsRetryForEnvelopeOp interface {
+	Do(ctx context.Context) (*model.ConnectFailureResults, error)
+}
+
+// EventsRetryForEnvelopesOp is the version-neutral EventsRetryForEnvelopes op.
+type EventsRetryForEnvelopesOp interface {
+	Do(ctx context.Context) (*model.ConnectFailureResults, error)
+}
+
+type v21Service struct {
+	s *connect.Service
+}
+
+func (s v21Service) ConfigurationsCreate(connectConfigurations *model.ConnectCustomConfiguration) ConfigurationsCreateOp {
+	return &v21ConfigurationsCreateOp{op: s.s.ConfigurationsCreate(connectConfigurations)}
+}
+
+type v21ConfigurationsCreateOp struct {
+	op *connect.ConfigurationsCreateOp
+}
+
+func (o *v21ConfigurationsCreateOp) Do(ctx context.Context) (*model.ConnectCustomConfiguration, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConfigurationsDelete(connectID string) ConfigurationsDeleteOp {
+	return &v21ConfigurationsDeleteOp{op: s.s.ConfigurationsDelete(connectID)}
+}
+
+type v21ConfigurationsDeleteOp struct {
+	op *connect.ConfigurationsDeleteOp
+}
+
+func (o *v21ConfigurationsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConfigurationsGet(connectID string) ConfigurationsGetOp {
+	return &v21ConfigurationsGetOp{op: s.s.ConfigurationsGet(connectID)}
+}
+
+type v21ConfigurationsGetOp struct {
+	op *connect.ConfigurationsGetOp
+}
+
+func (o *v21ConfigurationsGetOp) Do(ctx context.Context) (*model.ConnectConfigResults, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConfigurationsList() ConfigurationsListOp {
+	return &v21ConfigurationsListOp{op: s.s.ConfigurationsList()}
+}
+
+type v21ConfigurationsListOp struct {
+	op *connect.ConfigurationsListOp
+}
+
+func (o *v21ConfigurationsListOp) Do(ctx context.Context) (*model.ConnectConfigResults, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConfigurationsListUsers(connectID string) ConfigurationsListUsersOp {
+	return &v21ConfigurationsListUsersOp{op: s.s.ConfigurationsListUsers(connectID)}
+}
+
+type v21ConfigurationsListUsersOp struct {
+	op *connect.ConfigurationsListUsersOp
+}
+
+func (o *v21ConfigurationsListUsersOp) Count(val int) ConfigurationsListUsersOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ConfigurationsListUsersOp) EmailSubstring(val string) ConfigurationsListUsersOp {
+	o.op.EmailSubstring(val)
+	return o
+}
+
+func (o *v21ConfigurationsListUsersOp) ListIncludedUsers() ConfigurationsListUsersOp {
+	o.op.ListIncludedUsers()
+	return o
+}
+
+func (o *v21ConfigurationsListUsersOp) StartPosition(val int) ConfigurationsListUsersOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ConfigurationsListUsersOp) Status(val ...string) ConfigurationsListUsersOp {
+	o.op.Status(val...)
+	return o
+}
+
+func (o *v21ConfigurationsListUsersOp) UserNameSubstring(val string) ConfigurationsListUsersOp {
+	o.op.UserNameSubstring(val)
+	return o
+}
+
+func (o *v21ConfigurationsListUsersOp) Do(ctx context.Context) (*model.IntegratedUserInfoList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConfigurationsUpdate(connectConfigurations *model.ConnectCustomConfiguration) ConfigurationsUpdateOp {
+	return &v21ConfigurationsUpdateOp{op: s.s.ConfigurationsUpdate(connectConfigurations)}
+}
+
+type v21ConfigurationsUpdateOp struct {
+	op *connect.ConfigurationsUpdateOp
+}
+
+func (o *v21ConfigurationsUpdateOp) Do(ctx context.Context) (*model.ConnectCustomConfiguration, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsDelete(logID string) EventsDeleteOp {
+	return &v21EventsDeleteOp{op: s.s.EventsDelete(logID)}
+}
+
+type v21EventsDeleteOp struct {
+	op *connect.EventsDeleteOp
+}
+
+func (o *v21EventsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsDeleteFailure(failureID string) EventsDeleteFailureOp {
+	return &v21EventsDeleteFailureOp{op: s.s.EventsDeleteFailure(failureID)}
+}
+
+type v21EventsDeleteFailureOp struct {
+	op *connect.EventsDeleteFailureOp
+}
+
+func (o *v21EventsDeleteFailureOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsDeleteList() EventsDeleteListOp {
+	return &v21EventsDeleteListOp{op: s.s.EventsDeleteList()}
+}
+
+type v21EventsDeleteListOp struct {
+	op *connect.EventsDeleteListOp
+}
+
+func (o *v21EventsDeleteListOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsGet(logID string) EventsGetOp {
+	return &v21EventsGetOp{op: s.s.EventsGet(logID)}
+}
+
+type v21EventsGetOp struct {
+	op *connect.EventsGetOp
+}
+
+func (o *v21EventsGetOp) AdditionalInfo() EventsGetOp {
+	o.op.AdditionalInfo()
+	return o
+}
+
+func (o *v21EventsGetOp) Do(ctx context.Context) (*model.ConnectLog, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsList() EventsListOp {
+	return &v21EventsListOp{op: s.s.EventsList()}
+}
+
+type v21EventsListOp struct {
+	op *connect.EventsListOp
+}
+
+func (o *v21EventsListOp) FromDate(val time.Time) EventsListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21EventsListOp) ToDate(val time.Time) EventsListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21EventsListOp) Do(ctx context.Context) (*model.ConnectLogs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsListFailures() EventsListFailuresOp {
+	return &v21EventsListFailuresOp{op: s.s.EventsListFailures()}
+}
+
+type v21EventsListFailuresOp struct {
+	op *connect.EventsListFailuresOp
+}
+
+func (o *v21EventsListFailuresOp) FromDate(val time.Time) EventsListFailuresOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21EventsListFailuresOp) ToDate(val time.Time) EventsListFailuresOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21EventsListFailuresOp) Do(ctx context.Context) (*model.ConnectLogs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsRetryForEnvelope(envelopeID string, media io.Reader, mimeType string) EventsRetryForEnvelopeOp {
+	return &v21EventsRetryForEnvelopeOp{op: s.s.EventsRetryForEnvelope(envelopeID, media, mimeType)}
+}
+
+type v21EventsRetryForEnvelopeOp struct {
+	op *connect.EventsRetryForEnvelopeOp
+}
+
+func (o *v21EventsRetryForEnvelopeOp) Do(ctx context.Context) (*model.ConnectFailureResults, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EventsRetryForEnvelopes(connectFailureFilter *model.ConnectFailureFilter) EventsRetryForEnvelopesOp {
+	return &v21EventsRetryForEnvelopesOp{op: s.s.EventsRetryForEnvelopes(connectFailureFilter)}
+}
+
+type v21EventsRetryForEnvelopesOp struct {
+	op *connect.EventsRetryForEnvelopesOp
+}
+
+func (o *v21EventsRetryForEnvelopesOp) Do(ctx context.Context) (*model.ConnectFailureResults, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2connect.Service
+}
+
+func (s v2Service) ConfigurationsCreate(connectConfigurations *model.ConnectCustomConfiguration) ConfigurationsCreateOp {
+	o := &v2ConfigurationsCreateOp{}
+	var v2connectConfigurations *v2model.ConnectCustomConfiguration
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2connectConfigurations, connectConfigurations)
+	}
+	o.op = s.s.ConfigurationsCreate(v2connectConfigurations)
+	return o
+}
+
+type v2ConfigurationsCreateOp struct {
+	op  *v2connect.ConfigurationsCreateOp
+	err error
+}
+
+func (o *v2ConfigurationsCreateOp) Do(ctx context.Context) (*model.ConnectCustomConfiguration, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectCustomConfiguration
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConfigurationsDelete(connectID string) ConfigurationsDeleteOp {
+	return &v2ConfigurationsDeleteOp{op: s.s.ConfigurationsDelete(connectID)}
+}
+
+type v2ConfigurationsDeleteOp struct {
+	op *v2connect.ConfigurationsDeleteOp
+}
+
+func (o *v2ConfigurationsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) ConfigurationsGet(connectID string) ConfigurationsGetOp {
+	return &v2ConfigurationsGetOp{op: s.s.ConfigurationsGet(connectID)}
+}
+
+type v2ConfigurationsGetOp struct {
+	op  *v2connect.ConfigurationsGetOp
+	err error
+}
+
+func (o *v2ConfigurationsGetOp) Do(ctx context.Context) (*model.ConnectConfigResults, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectConfigResults
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConfigurationsList() ConfigurationsListOp {
+	return &v2ConfigurationsListOp{op: s.s.ConfigurationsList()}
+}
+
+type v2ConfigurationsListOp struct {
+	op  *v2connect.ConfigurationsListOp
+	err error
+}
+
+func (o *v2ConfigurationsListOp) Do(ctx context.Context) (*model.ConnectConfigResults, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectConfigResults
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConfigurationsListUsers(connectID string) ConfigurationsListUsersOp {
+	return &v2ConfigurationsListUsersOp{op: s.s.ConfigurationsListUsers(connectID)}
+}
+
+type v2ConfigurationsListUsersOp struct {
+	op  *v2connect.ConfigurationsListUsersOp
+	err error
+}
+
+func (o *v2ConfigurationsListUsersOp) Count(val int) ConfigurationsListUsersOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ConfigurationsListUsersOp) EmailSubstring(val string) ConfigurationsListUsersOp {
+	o.op.EmailSubstring(val)
+	return o
+}
+
+func (o *v2ConfigurationsListUsersOp) ListIncludedUsers() ConfigurationsListUsersOp {
+	o.op.ListIncludedUsers()
+	return o
+}
+
+func (o *v2ConfigurationsListUsersOp) StartPosition(val int) ConfigurationsListUsersOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ConfigurationsListUsersOp) Status(val ...string) ConfigurationsListUsersOp {
+	o.op.Status(val...)
+	return o
+}
+
+func (o *v2ConfigurationsListUsersOp) UserNameSubstring(val string) ConfigurationsListUsersOp {
+	o.op.UserNameSubstring(val)
+	return o
+}
+
+func (o *v2ConfigurationsListUsersOp) Do(ctx context.Context) (*model.IntegratedUserInfoList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.IntegratedUserInfoList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConfigurationsUpdate(connectConfigurations *model.ConnectCustomConfiguration) ConfigurationsUpdateOp {
+	o := &v2ConfigurationsUpdateOp{}
+	var v2connectConfigurations *v2model.ConnectCustomConfiguration
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2connectConfigurations, connectConfigurations)
+	}
+	o.op = s.s.ConfigurationsUpdate(v2connectConfigurations)
+	return o
+}
+
+type v2ConfigurationsUpdateOp struct {
+	op  *v2connect.ConfigurationsUpdateOp
+	err error
+}
+
+func (o *v2ConfigurationsUpdateOp) Do(ctx context.Context) (*model.ConnectCustomConfiguration, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectCustomConfiguration
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EventsDelete(logID string) EventsDeleteOp {
+	return &v2EventsDeleteOp{op: s.s.EventsDelete(logID)}
+}
+
+type v2EventsDeleteOp struct {
+	op *v2connect.EventsDeleteOp
+}
+
+func (o *v2EventsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) EventsDeleteFailure(failureID string) EventsDeleteFailureOp {
+	return &v2EventsDeleteFailureOp{op: s.s.EventsDeleteFailure(failureID)}
+}
+
+type v2EventsDeleteFailureOp struct {
+	op *v2connect.EventsDeleteFailureOp
+}
+
+func (o *v2EventsDeleteFailureOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) EventsDeleteList() EventsDeleteListOp {
+	return &v2EventsDeleteListOp{op: s.s.EventsDeleteList()}
+}
+
+type v2EventsDeleteListOp struct {
+	op *v2connect.EventsDeleteListOp
+}
+
+func (o *v2EventsDeleteListOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) EventsGet(logID string) EventsGetOp {
+	return &v2EventsGetOp{op: s.s.EventsGet(logID)}
+}
+
+type v2EventsGetOp struct {
+	op  *v2connect.EventsGetOp
+	err error
+}
+
+func (o *v2EventsGetOp) AdditionalInfo() EventsGetOp {
+	o.op.AdditionalInfo()
+	return o
+}
+
+func (o *v2EventsGetOp) Do(ctx context.Context) (*model.ConnectLog, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectLog
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EventsList() EventsListOp {
+	return &v2EventsListOp{op: s.s.EventsList()}
+}
+
+type v2EventsListOp struct {
+	op  *v2connect.EventsListOp
+	err error
+}
+
+func (o *v2EventsListOp) FromDate(val time.Time) EventsListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2EventsListOp) ToDate(val time.Time) EventsListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2EventsListOp) Do(ctx context.Context) (*model.ConnectLogs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectLogs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EventsListFailures() EventsListFailuresOp {
+	return &v2EventsListFailuresOp{op: s.s.EventsListFailures()}
+}
+
+type v2EventsListFailuresOp struct {
+	op  *v2connect.EventsListFailuresOp
+	err error
+}
+
+func (o *v2EventsListFailuresOp) FromDate(val time.Time) EventsListFailuresOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2EventsListFailuresOp) ToDate(val time.Time) EventsListFailuresOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2EventsListFailuresOp) Do(ctx context.Context) (*model.ConnectLogs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectLogs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EventsRetryForEnvelope(envelopeID string, media io.Reader, mimeType string) EventsRetryForEnvelopeOp {
+	return &v2EventsRetryForEnvelopeOp{op: s.s.EventsRetryForEnvelope(envelopeID, media, mimeType)}
+}
+
+type v2EventsRetryForEnvelopeOp struct {
+	op  *v2connect.EventsRetryForEnvelopeOp
+	err error
+}
+
+func (o *v2EventsRetryForEnvelopeOp) Do(ctx context.Context) (*model.ConnectFailureResults, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectFailureResults
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EventsRetryForEnvelopes(connectFailureFilter *model.ConnectFailureFilter) EventsRetryForEnvelopesOp {
+	o := &v2EventsRetryForEnvelopesOp{}
+	var v2connectFailureFilter *v2model.ConnectFailureFilter
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2connectFailureFilter, connectFailureFilter)
+	}
+	o.op = s.s.EventsRetryForEnvelopes(v2connectFailureFilter)
+	return o
+}
+
+type v2EventsRetryForEnvelopesOp struct {
+	op  *v2connect.EventsRetryForEnvelopesOp
+	err error
+}
+
+func (o *v2EventsRetryForEnvelopesOp) Do(ctx context.Context) (*model.ConnectFailureResults, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConnectFailureResults
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -0,0 +1,271 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package customtabs defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 customtabs services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/customtabs"
+//	)
+//	...
+//	customtabsService := customtabs.New(esignCredential, cfg.UseV2)
+package customtabs // import "github.com/jfcote87/esign/compat/customtabs"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/customtabs"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2customtabs "github.com/jfcote87/esign/v2/customtabs"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 customtabs services.
+type Service interface {
+	// Create creates a custom tab.
+	Create(customTabs *model.TabMetadata) CreateOp
+	// Delete deletes custom tab information.
+	Delete(customTabID string) DeleteOp
+	// Get gets custom tab information.
+	Get(customTabID string) GetOp
+	// List gets a list of all account tabs.
+	List() ListOp
+	// Update updates custom tab information.
+	Update(customTabID string, customTabs *model.TabMetadata) UpdateOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2customtabs.New(cred)}
+	}
+	return v21Service{s: customtabs.New(cred)}
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	Do(ctx context.Context) (*model.TabMetadata, error)
+}
+
+// DeleteOp is the version-neutral Delete op.
+type DeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	Do(ctx context.Context) (*model.TabMetadata, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	CustomTabOnly() ListOp
+	Do(ctx context.Context) (*model.TabMetadataList, error)
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	Do(ctx context.Context) (*model.TabMetadata, error)
+}
+
+type v21Service struct {
+	s *customtabs.Service
+}
+
+func (s v21Service) Create(customTabs *model.TabMetadata) CreateOp {
+	return &v21CreateOp{op: s.s.Create(customTabs)}
+}
+
+type v21CreateOp struct {
+	op *customtabs.CreateOp
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.TabMetadata, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Delete(customTabID string) DeleteOp {
+	return &v21DeleteOp{op: s.s.Delete(customTabID)}
+}
+
+type v21DeleteOp struct {
+	op *customtabs.DeleteOp
+}
+
+func (o *v21DeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(customTabID string) GetOp {
+	return &v21GetOp{op: s.s.Get(customTabID)}
+}
+
+type v21GetOp struct {
+	op *customtabs.GetOp
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.TabMetadata, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *customtabs.ListOp
+}
+
+func (o *v21ListOp) CustomTabOnly() ListOp {
+	o.op.CustomTabOnly()
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.TabMetadataList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(customTabID string, customTabs *model.TabMetadata) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(customTabID, customTabs)}
+}
+
+type v21UpdateOp struct {
+	op *customtabs.UpdateOp
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.TabMetadata, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2customtabs.Service
+}
+
+func (s v2Service) Create(customTabs *model.TabMetadata) CreateOp {
+	o := &v2CreateOp{}
+	var v2customTabs *v2model.TabMetadata
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2customTabs, customTabs)
+	}
+	o.op = s.s.Create(v2customTabs)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2customtabs.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.TabMetadata, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TabMetadata
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Delete(customTabID string) DeleteOp {
+	return &v2DeleteOp{op: s.s.Delete(customTabID)}
+}
+
+type v2DeleteOp struct {
+	op *v2customtabs.DeleteOp
+}
+
+func (o *v2DeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) Get(customTabID string) GetOp {
+	return &v2GetOp{op: s.s.Get(customTabID)}
+}
+
+type v2GetOp struct {
+	op  *v2customtabs.GetOp
+	err error
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.TabMetadata, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TabMetadata
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2customtabs.ListOp
+	err error
+}
+
+func (o *v2ListOp) CustomTabOnly() ListOp {
+	o.op.CustomTabOnly()
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.TabMetadataList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TabMetadataList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Update(customTabID string, customTabs *model.TabMetadata) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2customTabs *v2model.TabMetadata
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2customTabs, customTabs)
+	}
+	o.op = s.s.Update(customTabID, v2customTabs)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2customtabs.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.TabMetadata, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TabMetadata
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
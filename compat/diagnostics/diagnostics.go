@@ -0,0 +1,349 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package diagnostics defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 diagnostics services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/diagnostics"
+//	)
+//	...
+//	diagnosticsService := diagnostics.New(esignCredential, cfg.UseV2)
+package diagnostics // import "github.com/jfcote87/esign/compat/diagnostics"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/diagnostics"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2diagnostics "github.com/jfcote87/esign/v2/diagnostics"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 diagnostics services.
+type Service interface {
+	// RequestLogsDelete deletes the request log files.
+	RequestLogsDelete() RequestLogsDeleteOp
+	// RequestLogsGet gets a request logging log file.
+	RequestLogsGet(requestLogID string) RequestLogsGetOp
+	// RequestLogsGetSettings gets the API request logging settings.
+	RequestLogsGetSettings() RequestLogsGetSettingsOp
+	// RequestLogsList gets the API request logging log files.
+	RequestLogsList() RequestLogsListOp
+	// RequestLogsUpdateSettings enables or disables API request logging for troubleshooting.
+	RequestLogsUpdateSettings(requestLogs *model.DiagnosticsSettingsInformation) RequestLogsUpdateSettingsOp
+	// ResourcesGet lists resources for REST version specified
+	ResourcesGet() ResourcesGetOp
+	// ServicesGet retrieves the available REST API versions.
+	ServicesGet() ServicesGetOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2diagnostics.New(cred)}
+	}
+	return v21Service{s: diagnostics.New(cred)}
+}
+
+// RequestLogsDeleteOp is the version-neutral RequestLogsDelete op.
+type RequestLogsDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// RequestLogsGetOp is the version-neutral RequestLogsGet op.
+type RequestLogsGetOp interface {
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// RequestLogsGetSettingsOp is the version-neutral RequestLogsGetSettings op.
+type RequestLogsGetSettingsOp interface {
+	Do(ctx context.Context) (*model.DiagnosticsSettingsInformation, error)
+}
+
+// RequestLogsListOp is the version-neutral RequestLogsList op.
+type RequestLogsListOp interface {
+	Encoding(val string) RequestLogsListOp
+	Do(ctx context.Context) (*model.APIRequestLogsResult, error)
+	Zip(ctx context.Context) (*esign.Download, error)
+}
+
+// RequestLogsUpdateSettingsOp is the version-neutral RequestLogsUpdateSettings op.
+type RequestLogsUpdateSettingsOp interface {
+	Do(ctx context.Context) (*model.DiagnosticsSettingsInformation, error)
+}
+
+// ResourcesGetOp is the version-neutral ResourcesGet op.
+type ResourcesGetOp interface {
+	Do(ctx context.Context) (*model.ResourceInformation, error)
+}
+
+// ServicesGetOp is the version-neutral ServicesGet op.
+type ServicesGetOp interface {
+	Do(ctx context.Context) (*model.ServiceInformation, error)
+}
+
+type v21Service struct {
+	s *diagnostics.Service
+}
+
+func (s v21Service) RequestLogsDelete() RequestLogsDeleteOp {
+	return &v21RequestLogsDeleteOp{op: s.s.RequestLogsDelete()}
+}
+
+type v21RequestLogsDeleteOp struct {
+	op *diagnostics.RequestLogsDeleteOp
+}
+
+func (o *v21RequestLogsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RequestLogsGet(requestLogID string) RequestLogsGetOp {
+	return &v21RequestLogsGetOp{op: s.s.RequestLogsGet(requestLogID)}
+}
+
+type v21RequestLogsGetOp struct {
+	op *diagnostics.RequestLogsGetOp
+}
+
+func (o *v21RequestLogsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RequestLogsGetSettings() RequestLogsGetSettingsOp {
+	return &v21RequestLogsGetSettingsOp{op: s.s.RequestLogsGetSettings()}
+}
+
+type v21RequestLogsGetSettingsOp struct {
+	op *diagnostics.RequestLogsGetSettingsOp
+}
+
+func (o *v21RequestLogsGetSettingsOp) Do(ctx context.Context) (*model.DiagnosticsSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RequestLogsList() RequestLogsListOp {
+	return &v21RequestLogsListOp{op: s.s.RequestLogsList()}
+}
+
+type v21RequestLogsListOp struct {
+	op *diagnostics.RequestLogsListOp
+}
+
+func (o *v21RequestLogsListOp) Encoding(val string) RequestLogsListOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v21RequestLogsListOp) Do(ctx context.Context) (*model.APIRequestLogsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (o *v21RequestLogsListOp) Zip(ctx context.Context) (*esign.Download, error) {
+	return o.op.Zip(ctx)
+}
+
+func (s v21Service) RequestLogsUpdateSettings(requestLogs *model.DiagnosticsSettingsInformation) RequestLogsUpdateSettingsOp {
+	return &v21RequestLogsUpdateSettingsOp{op: s.s.RequestLogsUpdateSettings(requestLogs)}
+}
+
+type v21RequestLogsUpdateSettingsOp struct {
+	op *diagnostics.RequestLogsUpdateSettingsOp
+}
+
+func (o *v21RequestLogsUpdateSettingsOp) Do(ctx context.Context) (*model.DiagnosticsSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ResourcesGet() ResourcesGetOp {
+	return &v21ResourcesGetOp{op: s.s.ResourcesGet()}
+}
+
+type v21ResourcesGetOp struct {
+	op *diagnostics.ResourcesGetOp
+}
+
+func (o *v21ResourcesGetOp) Do(ctx context.Context) (*model.ResourceInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ServicesGet() ServicesGetOp {
+	return &v21ServicesGetOp{op: s.s.ServicesGet()}
+}
+
+type v21ServicesGetOp struct {
+	op *diagnostics.ServicesGetOp
+}
+
+func (o *v21ServicesGetOp) Do(ctx context.Context) (*model.ServiceInformation, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2diagnostics.Service
+}
+
+func (s v2Service) RequestLogsDelete() RequestLogsDeleteOp {
+	return &v2RequestLogsDeleteOp{op: s.s.RequestLogsDelete()}
+}
+
+type v2RequestLogsDeleteOp struct {
+	op *v2diagnostics.RequestLogsDeleteOp
+}
+
+func (o *v2RequestLogsDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) RequestLogsGet(requestLogID string) RequestLogsGetOp {
+	return &v2RequestLogsGetOp{op: s.s.RequestLogsGet(requestLogID)}
+}
+
+type v2RequestLogsGetOp struct {
+	op *v2diagnostics.RequestLogsGetOp
+}
+
+func (o *v2RequestLogsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) RequestLogsGetSettings() RequestLogsGetSettingsOp {
+	return &v2RequestLogsGetSettingsOp{op: s.s.RequestLogsGetSettings()}
+}
+
+type v2RequestLogsGetSettingsOp struct {
+	op  *v2diagnostics.RequestLogsGetSettingsOp
+	err error
+}
+
+func (o *v2RequestLogsGetSettingsOp) Do(ctx context.Context) (*model.DiagnosticsSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DiagnosticsSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RequestLogsList() RequestLogsListOp {
+	return &v2RequestLogsListOp{op: s.s.RequestLogsList()}
+}
+
+type v2RequestLogsListOp struct {
+	op  *v2diagnostics.RequestLogsListOp
+	err error
+}
+
+func (o *v2RequestLogsListOp) Encoding(val string) RequestLogsListOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v2RequestLogsListOp) Do(ctx context.Context) (*model.APIRequestLogsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.APIRequestLogsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (o *v2RequestLogsListOp) Zip(ctx context.Context) (*esign.Download, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	return o.op.Zip(ctx)
+}
+
+func (s v2Service) RequestLogsUpdateSettings(requestLogs *model.DiagnosticsSettingsInformation) RequestLogsUpdateSettingsOp {
+	o := &v2RequestLogsUpdateSettingsOp{}
+	var v2requestLogs *v2model.DiagnosticsSettingsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2requestLogs, requestLogs)
+	}
+	o.op = s.s.RequestLogsUpdateSettings(v2requestLogs)
+	return o
+}
+
+type v2RequestLogsUpdateSettingsOp struct {
+	op  *v2diagnostics.RequestLogsUpdateSettingsOp
+	err error
+}
+
+func (o *v2RequestLogsUpdateSettingsOp) Do(ctx context.Context) (*model.DiagnosticsSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DiagnosticsSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ResourcesGet() ResourcesGetOp {
+	return &v2ResourcesGetOp{op: s.s.ResourcesGet()}
+}
+
+type v2ResourcesGetOp struct {
+	op  *v2diagnostics.ResourcesGetOp
+	err error
+}
+
+func (o *v2ResourcesGetOp) Do(ctx context.Context) (*model.ResourceInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ResourceInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ServicesGet() ServicesGetOp {
+	return &v2ServicesGetOp{op: s.s.ServicesGet()}
+}
+
+type v2ServicesGetOp struct {
+	op  *v2diagnostics.ServicesGetOp
+	err error
+}
+
+func (o *v2ServicesGetOp) Do(ctx context.Context) (*model.ServiceInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ServiceInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -0,0 +1,4223 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package envelopes defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 envelopes services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Ops not available in both versions:
+//
+//	DocumentsUpdate
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/envelopes"
+//	)
+//	...
+//	envelopesService := envelopes.New(esignCredential, cfg.UseV2)
+package envelopes // import "github.com/jfcote87/esign/compat/envelopes"
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2envelopes "github.com/jfcote87/esign/v2/envelopes"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 envelopes services.
+type Service interface {
+	// ChunkedUploadsCommit commit a chunked upload.
+	ChunkedUploadsCommit(chunkedUploadID string, media io.Reader, mimeType string) ChunkedUploadsCommitOp
+	// ChunkedUploadsCreate initiate a new chunked upload.
+	ChunkedUploadsCreate(chunkedUploadRequest *model.ChunkedUploadRequest) ChunkedUploadsCreateOp
+	// ChunkedUploadsDelete deletes a chunked upload.
+	ChunkedUploadsDelete(chunkedUploadID string) ChunkedUploadsDeleteOp
+	// ChunkedUploadsGet retrieves metadata about a chunked upload.
+	ChunkedUploadsGet(chunkedUploadID string) ChunkedUploadsGetOp
+	// ChunkedUploadsUpdate add a chunk to an existing chunked upload.
+	ChunkedUploadsUpdate(chunkedUploadID string, chunkedUploadPartSeq string, chunkedUploadRequest *model.ChunkedUploadRequest) ChunkedUploadsUpdateOp
+	// AttachmentsCreate add one or more attachments to a draft or in-process envelope.
+	AttachmentsCreate(envelopeID string, envelopeAttachmentsRequest *model.EnvelopeAttachmentsRequest) AttachmentsCreateOp
+	// AttachmentsDelete delete one or more attachments from a DRAFT envelope.
+	AttachmentsDelete(envelopeID string, envelopeAttachmentsRequest *model.EnvelopeAttachmentsRequest) AttachmentsDeleteOp
+	// AttachmentsGet retrieves an attachment from the envelope.
+	AttachmentsGet(attachmentID string, envelopeID string) AttachmentsGetOp
+	// AttachmentsList returns a list of attachments associated with the specified envelope
+	AttachmentsList(envelopeID string) AttachmentsListOp
+	// AttachmentsUpdate add an attachment to a DRAFT or IN-PROCESS envelope.
+	AttachmentsUpdate(attachmentID string, envelopeID string, attachment *model.Attachment) AttachmentsUpdateOp
+	// ConsumerDisclosuresGet reserved: Gets the Electronic Record and Signature Disclosure associated with the account.
+	ConsumerDisclosuresGet(envelopeID string, langCode string, recipientID string) ConsumerDisclosuresGetOp
+	// ConsumerDisclosuresGetDefault gets the Electronic Record and Signature Disclosure associated with the account.
+	ConsumerDisclosuresGetDefault(envelopeID string, recipientID string) ConsumerDisclosuresGetDefaultOp
+	// CustomFieldsCreate updates envelope custom fields for an envelope.
+	CustomFieldsCreate(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsCreateOp
+	// CustomFieldsDelete deletes envelope custom fields for draft and in-process envelopes.
+	CustomFieldsDelete(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsDeleteOp
+	// CustomFieldsList gets the custom field information for the specified envelope.
+	CustomFieldsList(envelopeID string) CustomFieldsListOp
+	// CustomFieldsUpdate updates envelope custom fields in an envelope.
+	CustomFieldsUpdate(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsUpdateOp
+	// DocumentFieldsCreate creates custom document fields in an existing envelope document.
+	DocumentFieldsCreate(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsCreateOp
+	// DocumentFieldsDelete deletes custom document fields from an existing envelope document.
+	DocumentFieldsDelete(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsDeleteOp
+	// DocumentFieldsList gets the custom document fields from an  existing envelope document.
+	DocumentFieldsList(documentID string, envelopeID string) DocumentFieldsListOp
+	// DocumentFieldsUpdate updates existing custom document fields in an existing envelope document.
+	DocumentFieldsUpdate(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsUpdateOp
+	// DocumentTabsGet returns the tabs on a document.
+	DocumentTabsGet(documentID string, envelopeID string) DocumentTabsGetOp
+	// DocumentTabsGetByPage returns tabs on the specified page.
+	DocumentTabsGetByPage(documentID string, envelopeID string, pageNumber string) DocumentTabsGetByPageOp
+	// DocumentVisibilityGet returns document visibility for a recipient
+	DocumentVisibilityGet(envelopeID string, recipientID string) DocumentVisibilityGetOp
+	// DocumentVisibilityUpdate updates document visibility for a recipient
+	DocumentVisibilityUpdate(envelopeID string, recipientID string, envelopeDocumentVisibility *model.DocumentVisibilityList) DocumentVisibilityUpdateOp
+	// DocumentVisibilityUpdateList updates document visibility for recipients
+	DocumentVisibilityUpdateList(envelopeID string, envelopeDocumentVisibility *model.DocumentVisibilityList) DocumentVisibilityUpdateListOp
+	// DocumentsDelete deletes documents from a draft envelope.
+	DocumentsDelete(envelopeID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsDeleteOp
+	// DocumentsGet gets a document from an envelope.
+	DocumentsGet(documentID string, envelopeID string) DocumentsGetOp
+	// DocumentsList gets a list of envelope documents.
+	DocumentsList(envelopeID string) DocumentsListOp
+	// DocumentsUpdateList adds one or more documents to an existing envelope document.
+	DocumentsUpdateList(envelopeID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateListOp
+	// EmailSettingsCreate adds email setting overrides to an envelope.
+	EmailSettingsCreate(envelopeID string, envelopeEmailSettings *model.EmailSettings) EmailSettingsCreateOp
+	// EmailSettingsDelete deletes the email setting overrides for an envelope.
+	EmailSettingsDelete(envelopeID string) EmailSettingsDeleteOp
+	// EmailSettingsGet gets the email setting overrides for an envelope.
+	EmailSettingsGet(envelopeID string) EmailSettingsGetOp
+	// EmailSettingsUpdate updates the email setting overrides for an envelope.
+	EmailSettingsUpdate(envelopeID string, envelopeEmailSettings *model.EmailSettings) EmailSettingsUpdateOp
+	// FormDataGet returns envelope form data for an existing envelope.
+	FormDataGet(envelopeID string) FormDataGetOp
+	// LocksCreate lock an envelope.
+	LocksCreate(envelopeID string, lockRequest *model.LockRequest) LocksCreateOp
+	// LocksDelete deletes an envelope lock.
+	LocksDelete(envelopeID string) LocksDeleteOp
+	// LocksGet gets envelope lock information.
+	LocksGet(envelopeID string) LocksGetOp
+	// LocksUpdate updates an envelope lock.
+	LocksUpdate(envelopeID string, lockRequest *model.LockRequest) LocksUpdateOp
+	// RecipientTabsCreate adds tabs for a recipient.
+	RecipientTabsCreate(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsCreateOp
+	// RecipientTabsDelete deletes the tabs associated with a recipient.
+	RecipientTabsDelete(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsDeleteOp
+	// RecipientTabsList gets the tabs information for a signer or sign-in-person recipient in an envelope.
+	RecipientTabsList(envelopeID string, recipientID string) RecipientTabsListOp
+	// RecipientTabsUpdate updates the tabs for a recipient.
+	RecipientTabsUpdate(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsUpdateOp
+	// RecipientsCreate adds one or more recipients to an envelope.
+	RecipientsCreate(envelopeID string, envelopeRecipients *model.Recipients) RecipientsCreateOp
+	// RecipientsDelete deletes a recipient from an envelope.
+	RecipientsDelete(envelopeID string, recipientID string) RecipientsDeleteOp
+	// RecipientsDeleteList deletes recipients from an envelope.
+	RecipientsDeleteList(envelopeID string, envelopeRecipients *model.Recipients) RecipientsDeleteListOp
+	// RecipientsList gets the status of recipients for an envelope.
+	RecipientsList(envelopeID string) RecipientsListOp
+	// RecipientsUpdate updates recipients in a draft envelope or corrects recipient information for an in process envelope.
+	RecipientsUpdate(envelopeID string, envelopeRecipients *model.Recipients) RecipientsUpdateOp
+	// TemplatesApply adds templates to an envelope.
+	TemplatesApply(envelopeID string, documentTemplateList *model.DocumentTemplateList) TemplatesApplyOp
+	// TemplatesApplyToDocument adds templates to a document in an  envelope.
+	TemplatesApplyToDocument(documentID string, envelopeID string, documentTemplateList *model.DocumentTemplateList) TemplatesApplyToDocumentOp
+	// TemplatesDelete deletes a template from a document in an existing envelope.
+	TemplatesDelete(documentID string, envelopeID string, templateID string) TemplatesDeleteOp
+	// TemplatesList get List of Templates used in an Envelope
+	TemplatesList(envelopeID string) TemplatesListOp
+	// TemplatesListByDocument gets the templates associated with a document in an existing envelope.
+	TemplatesListByDocument(documentID string, envelopeID string) TemplatesListByDocumentOp
+	// ViewsCreateConsole returns a URL to the authentication view UI.
+	ViewsCreateConsole(consoleViewRequest *model.ConsoleViewRequest) ViewsCreateConsoleOp
+	// ViewsCreateCorrect returns a URL to the envelope correction UI.
+	ViewsCreateCorrect(envelopeID string, correctViewRequest *model.CorrectViewRequest) ViewsCreateCorrectOp
+	// ViewsCreateEdit returns a URL to the edit view UI.
+	ViewsCreateEdit(envelopeID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateEditOp
+	// ViewsCreateRecipient returns a URL to the recipient view UI.
+	ViewsCreateRecipient(envelopeID string, recipientViewRequest *model.RecipientViewRequest) ViewsCreateRecipientOp
+	// ViewsCreateSender returns a URL to the sender view UI.
+	ViewsCreateSender(envelopeID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateSenderOp
+	// Create creates an envelope.
+	Create(envelopeDefinition *model.EnvelopeDefinition, uploads ...*esign.UploadFile) CreateOp
+	// DeleteDocumentPage deletes a page from a document in an envelope.
+	DeleteDocumentPage(documentID string, envelopeID string, pageNumber string) DeleteDocumentPageOp
+	// Get gets the status of a single envelope.
+	Get(envelopeID string) GetOp
+	// GetNotificationSettings gets envelope notification information.
+	GetNotificationSettings(envelopeID string) GetNotificationSettingsOp
+	// GetPageImage gets a page image from an envelope for display.
+	GetPageImage(documentID string, envelopeID string, pageNumber string) GetPageImageOp
+	// GetPageImages returns document page image(s) based on input.
+	GetPageImages(documentID string, envelopeID string) GetPageImagesOp
+	// GetRecipientInitialsImage gets the initials image for a user.
+	GetRecipientInitialsImage(envelopeID string, recipientID string) GetRecipientInitialsImageOp
+	// GetRecipientSignature gets signature information for a signer or sign-in-person recipient.
+	GetRecipientSignature(envelopeID string, recipientID string) GetRecipientSignatureOp
+	// GetRecipientSignatureImage retrieve signature image information for a signer/sign-in-person recipient.
+	GetRecipientSignatureImage(envelopeID string, recipientID string) GetRecipientSignatureImageOp
+	// ListAuditEvents gets the envelope audit events for an envelope.
+	ListAuditEvents(envelopeID string) ListAuditEventsOp
+	// ListStatus gets the envelope status for the specified envelopes.
+	ListStatus(envelopeIdsRequest *model.EnvelopeIdsRequest) ListStatusOp
+	// ListStatusChanges gets status changes for one or more envelopes.
+	ListStatusChanges() ListStatusChangesOp
+	// RotateDocumentPage rotates page image from an envelope for display.
+	RotateDocumentPage(documentID string, envelopeID string, pageNumber string, pageRequest *model.PageRequest) RotateDocumentPageOp
+	// Update send, void, or modify a draft envelope. Purge documents from a completed envelope.
+	Update(envelopeID string, envelopes *model.Envelope) UpdateOp
+	// UpdateNotificationSettings sets envelope notifications for an existing envelope.
+	UpdateNotificationSettings(envelopeID string, envelopeNotificationRequest *model.EnvelopeNotificationRequest) UpdateNotificationSettingsOp
+	// UpdateRecipientInitialsImage sets the initials image for an accountless signer.
+	UpdateRecipientInitialsImage(envelopeID string, recipientID string, media io.Reader, mimeType string) UpdateRecipientInitialsImageOp
+	// UpdateRecipientSignatureImage sets the signature image for an accountless signer.
+	UpdateRecipientSignatureImage(envelopeID string, recipientID string, media io.Reader, mimeType string) UpdateRecipientSignatureImageOp
+	// TemplateDocumentVisibilityGet returns document visibility for a template recipient
+	TemplateDocumentVisibilityGet(recipientID string, templateID string) TemplateDocumentVisibilityGetOp
+	// TemplateDocumentVisibilityUpdate updates document visibility for a template recipient
+	TemplateDocumentVisibilityUpdate(recipientID string, templateID string, templateDocumentVisibility *model.TemplateDocumentVisibilityList) TemplateDocumentVisibilityUpdateOp
+	// TemplateDocumentVisibilityUpdateList updates document visibility for template recipients
+	TemplateDocumentVisibilityUpdateList(templateID string, templateDocumentVisibility *model.TemplateDocumentVisibilityList) TemplateDocumentVisibilityUpdateListOp
+	// NotaryJournalsList is SDK Method Envelopes::listNotaryJournals
+	NotaryJournalsList() NotaryJournalsListOp
+	// ViewsCreateSharedRecipient is SDK Method Envelopes::createEnvelopeRecipientSharedView
+	ViewsCreateSharedRecipient(envelopeID string, recipientViewRequest *model.RecipientViewRequest) ViewsCreateSharedRecipientOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2envelopes.New(cred)}
+	}
+	return v21Service{s: envelopes.New(cred)}
+}
+
+// ChunkedUploadsCommitOp is the version-neutral ChunkedUploadsCommit op.
+type ChunkedUploadsCommitOp interface {
+	Action(val string) ChunkedUploadsCommitOp
+	Do(ctx context.Context) (*model.ChunkedUploadResponse, error)
+}
+
+// ChunkedUploadsCreateOp is the version-neutral ChunkedUploadsCreate op.
+type ChunkedUploadsCreateOp interface {
+	Do(ctx context.Context) (*model.ChunkedUploadResponse, error)
+}
+
+// ChunkedUploadsDeleteOp is the version-neutral ChunkedUploadsDelete op.
+type ChunkedUploadsDeleteOp interface {
+	Do(ctx context.Context) (*model.ChunkedUploadResponse, error)
+}
+
+// ChunkedUploadsGetOp is the version-neutral ChunkedUploadsGet op.
+type ChunkedUploadsGetOp interface {
+	Include(val ...string) ChunkedUploadsGetOp
+	Do(ctx context.Context) (*model.ChunkedUploadResponse, error)
+}
+
+// ChunkedUploadsUpdateOp is the version-neutral ChunkedUploadsUpdate op.
+type ChunkedUploadsUpdateOp interface {
+	Do(ctx context.Context) (*model.ChunkedUploadResponse, error)
+}
+
+// AttachmentsCreateOp is the version-neutral AttachmentsCreate op.
+type AttachmentsCreateOp interface {
+	Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error)
+}
+
+// AttachmentsDeleteOp is the version-neutral AttachmentsDelete op.
+type AttachmentsDeleteOp interface {
+	Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error)
+}
+
+// AttachmentsGetOp is the version-neutral AttachmentsGet op.
+type AttachmentsGetOp interface {
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// AttachmentsListOp is the version-neutral AttachmentsList op.
+type AttachmentsListOp interface {
+	Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error)
+}
+
+// AttachmentsUpdateOp is the version-neutral AttachmentsUpdate op.
+type AttachmentsUpdateOp interface {
+	Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error)
+}
+
+// ConsumerDisclosuresGetOp is the version-neutral ConsumerDisclosuresGet op.
+type ConsumerDisclosuresGetOp interface {
+	LangCode(val string) ConsumerDisclosuresGetOp
+	Do(ctx context.Context) (*model.ConsumerDisclosure, error)
+}
+
+// ConsumerDisclosuresGetDefaultOp is the version-neutral ConsumerDisclosuresGetDefault op.
+type ConsumerDisclosuresGetDefaultOp interface {
+	LangCode(val string) ConsumerDisclosuresGetDefaultOp
+	Do(ctx context.Context) (*model.ConsumerDisclosure, error)
+}
+
+// CustomFieldsCreateOp is the version-neutral CustomFieldsCreate op.
+type CustomFieldsCreateOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsDeleteOp is the version-neutral CustomFieldsDelete op.
+type CustomFieldsDeleteOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsListOp is the version-neutral CustomFieldsList op.
+type CustomFieldsListOp interface {
+	Do(ctx context.Context) (*model.CustomFieldsEnvelope, error)
+}
+
+// CustomFieldsUpdateOp is the version-neutral CustomFieldsUpdate op.
+type CustomFieldsUpdateOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// DocumentFieldsCreateOp is the version-neutral DocumentFieldsCreate op.
+type DocumentFieldsCreateOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentFieldsDeleteOp is the version-neutral DocumentFieldsDelete op.
+type DocumentFieldsDeleteOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentFieldsListOp is the version-neutral DocumentFieldsList op.
+type DocumentFieldsListOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentFieldsUpdateOp is the version-neutral DocumentFieldsUpdate op.
+type DocumentFieldsUpdateOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentTabsGetOp is the version-neutral DocumentTabsGet op.
+type DocumentTabsGetOp interface {
+	PageNumbers(val string) DocumentTabsGetOp
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// DocumentTabsGetByPageOp is the version-neutral DocumentTabsGetByPage op.
+type DocumentTabsGetByPageOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// DocumentVisibilityGetOp is the version-neutral DocumentVisibilityGet op.
+type DocumentVisibilityGetOp interface {
+	Do(ctx context.Context) (*model.DocumentVisibilityList, error)
+}
+
+// DocumentVisibilityUpdateOp is the version-neutral DocumentVisibilityUpdate op.
+type DocumentVisibilityUpdateOp interface {
+	Do(ctx context.Context) (*model.DocumentVisibilityList, error)
+}
+
+// DocumentVisibilityUpdateListOp is the version-neutral DocumentVisibilityUpdateList op.
+type DocumentVisibilityUpdateListOp interface {
+	Do(ctx context.Context) (*model.DocumentVisibilityList, error)
+}
+
+// DocumentsDeleteOp is the version-neutral DocumentsDelete op.
+type DocumentsDeleteOp interface {
+	Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error)
+}
+
+// DocumentsGetOp is the version-neutral DocumentsGet op.
+type DocumentsGetOp interface {
+	Certificate() DocumentsGetOp
+	Encoding(val string) DocumentsGetOp
+	Encrypt() DocumentsGetOp
+	Language(val string) DocumentsGetOp
+	RecipientID(val string) DocumentsGetOp
+	ShowChanges() DocumentsGetOp
+	Watermark() DocumentsGetOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// DocumentsListOp is the version-neutral DocumentsList op.
+type DocumentsListOp interface {
+	Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error)
+}
+
+// DocumentsUpdateListOp is the version-neutral DocumentsUpdateList op.
+type DocumentsUpdateListOp interface {
+	Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error)
+}
+
+// EmailSettingsCreateOp is the version-neutral EmailSettingsCreate op.
+type EmailSettingsCreateOp interface {
+	Do(ctx context.Context) (*model.EmailSettings, error)
+}
+
+// EmailSettingsDeleteOp is the version-neutral EmailSettingsDelete op.
+type EmailSettingsDeleteOp interface {
+	Do(ctx context.Context) (*model.EmailSettings, error)
+}
+
+// EmailSettingsGetOp is the version-neutral EmailSettingsGet op.
+type EmailSettingsGetOp interface {
+	Do(ctx context.Context) (*model.EmailSettings, error)
+}
+
+// EmailSettingsUpdateOp is the version-neutral EmailSettingsUpdate op.
+type EmailSettingsUpdateOp interface {
+	Do(ctx context.Context) (*model.EmailSettings, error)
+}
+
+// FormDataGetOp is the version-neutral FormDataGet op.
+type FormDataGetOp interface {
+	Do(ctx context.Context) (*model.EnvelopeFormData, error)
+}
+
+// LocksCreateOp is the version-neutral LocksCreate op.
+type LocksCreateOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// LocksDeleteOp is the version-neutral LocksDelete op.
+type LocksDeleteOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// LocksGetOp is the version-neutral LocksGet op.
+type LocksGetOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// LocksUpdateOp is the version-neutral LocksUpdate op.
+type LocksUpdateOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// RecipientTabsCreateOp is the version-neutral RecipientTabsCreate op.
+type RecipientTabsCreateOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientTabsDeleteOp is the version-neutral RecipientTabsDelete op.
+type RecipientTabsDeleteOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientTabsListOp is the version-neutral RecipientTabsList op.
+type RecipientTabsListOp interface {
+	IncludeAnchorTabLocations() RecipientTabsListOp
+	IncludeMetadata(val string) RecipientTabsListOp
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientTabsUpdateOp is the version-neutral RecipientTabsUpdate op.
+type RecipientTabsUpdateOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientsCreateOp is the version-neutral RecipientsCreate op.
+type RecipientsCreateOp interface {
+	ResendEnvelope() RecipientsCreateOp
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsDeleteOp is the version-neutral RecipientsDelete op.
+type RecipientsDeleteOp interface {
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsDeleteListOp is the version-neutral RecipientsDeleteList op.
+type RecipientsDeleteListOp interface {
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsListOp is the version-neutral RecipientsList op.
+type RecipientsListOp interface {
+	IncludeAnchorTabLocations() RecipientsListOp
+	IncludeExtended() RecipientsListOp
+	IncludeTabs() RecipientsListOp
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsUpdateOp is the version-neutral RecipientsUpdate op.
+type RecipientsUpdateOp interface {
+	OfflineSigning(val string) RecipientsUpdateOp
+	ResendEnvelope() RecipientsUpdateOp
+	Do(ctx context.Context) (*model.RecipientsUpdateSummary, error)
+}
+
+// TemplatesApplyOp is the version-neutral TemplatesApply op.
+type TemplatesApplyOp interface {
+	Do(ctx context.Context) (*model.DocumentTemplateList, error)
+}
+
+// TemplatesApplyToDocumentOp is the version-neutral TemplatesApplyToDocument op.
+type TemplatesApplyToDocumentOp interface {
+	Do(ctx context.Context) (*model.DocumentTemplateList, error)
+}
+
+// TemplatesDeleteOp is the version-neutral TemplatesDelete op.
+type TemplatesDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// TemplatesListOp is the version-neutral TemplatesList op.
+type TemplatesListOp interface {
+	Include(val string) TemplatesListOp
+	Do(ctx context.Context) (*model.TemplateInformation, error)
+}
+
+// TemplatesListByDocumentOp is the version-neutral TemplatesListByDocument op.
+type TemplatesListByDocumentOp interface {
+	Include(val ...string) TemplatesListByDocumentOp
+	Do(ctx context.Context) (*model.TemplateInformation, error)
+}
+
+// ViewsCreateConsoleOp is the version-neutral ViewsCreateConsole op.
+type ViewsCreateConsoleOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+// ViewsCreateCorrectOp is the version-neutral ViewsCreateCorrect op.
+type ViewsCreateCorrectOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+// ViewsCreateEditOp is the version-neutral ViewsCreateEdit op.
+type ViewsCreateEditOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+// ViewsCreateRecipientOp is the version-neutral ViewsCreateRecipient op.
+type ViewsCreateRecipientOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+// ViewsCreateSenderOp is the version-neutral ViewsCreateSender op.
+type ViewsCreateSenderOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	CdseMode(val string) CreateOp
+	ChangeRoutingOrder() CreateOp
+	CompletedDocumentsOnly(val string) CreateOp
+	MergeRolesOnDraft() CreateOp
+	Do(ctx context.Context) (*model.EnvelopeSummary, error)
+}
+
+// DeleteDocumentPageOp is the version-neutral DeleteDocumentPage op.
+type DeleteDocumentPageOp interface {
+	Do(ctx context.Context) error
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	AdvancedUpdate() GetOp
+	Include(val string) GetOp
+	Do(ctx context.Context) (*model.Envelope, error)
+}
+
+// GetNotificationSettingsOp is the version-neutral GetNotificationSettings op.
+type GetNotificationSettingsOp interface {
+	Do(ctx context.Context) (*model.Notification, error)
+}
+
+// GetPageImageOp is the version-neutral GetPageImage op.
+type GetPageImageOp interface {
+	Dpi(val int) GetPageImageOp
+	MaxHeight(val int) GetPageImageOp
+	MaxWidth(val int) GetPageImageOp
+	ShowChanges() GetPageImageOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// GetPageImagesOp is the version-neutral GetPageImages op.
+type GetPageImagesOp interface {
+	Count(val int) GetPageImagesOp
+	Dpi(val int) GetPageImagesOp
+	MaxHeight(val int) GetPageImagesOp
+	MaxWidth(val int) GetPageImagesOp
+	Nocache() GetPageImagesOp
+	ShowChanges() GetPageImagesOp
+	StartPosition(val int) GetPageImagesOp
+	Do(ctx context.Context) (*model.PageImages, error)
+}
+
+// GetRecipientInitialsImageOp is the version-neutral GetRecipientInitialsImage op.
+type GetRecipientInitialsImageOp interface {
+	IncludeChrome() GetRecipientInitialsImageOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// GetRecipientSignatureOp is the version-neutral GetRecipientSignature op.
+type GetRecipientSignatureOp interface {
+	Do(ctx context.Context) (*model.UserSignature, error)
+}
+
+// GetRecipientSignatureImageOp is the version-neutral GetRecipientSignatureImage op.
+type GetRecipientSignatureImageOp interface {
+	IncludeChrome() GetRecipientSignatureImageOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// ListAuditEventsOp is the version-neutral ListAuditEvents op.
+type ListAuditEventsOp interface {
+	Do(ctx context.Context) (*model.EnvelopeAuditEventResponse, error)
+}
+
+// ListStatusOp is the version-neutral ListStatus op.
+type ListStatusOp interface {
+	AcStatus(val string) ListStatusOp
+	Block(val string) ListStatusOp
+	Count(val string) ListStatusOp
+	Email(val string) ListStatusOp
+	EnvelopeIds(val string) ListStatusOp
+	FromDate(val time.Time) ListStatusOp
+	FromToStatus(val string) ListStatusOp
+	StartPosition(val int) ListStatusOp
+	Status(val string) ListStatusOp
+	ToDate(val time.Time) ListStatusOp
+	TransactionIds(val string) ListStatusOp
+	UserName(val string) ListStatusOp
+	Do(ctx context.Context) (*model.EnvelopesInformation, error)
+}
+
+// ListStatusChangesOp is the version-neutral ListStatusChanges op.
+type ListStatusChangesOp interface {
+	AcStatus(val string) ListStatusChangesOp
+	Block(val string) ListStatusChangesOp
+	Count(val int) ListStatusChangesOp
+	CustomField(val string) ListStatusChangesOp
+	Email(val string) ListStatusChangesOp
+	EnvelopeIds(val ...string) ListStatusChangesOp
+	FromDate(val time.Time) ListStatusChangesOp
+	FromToStatus(val string) ListStatusChangesOp
+	StartPosition(val int) ListStatusChangesOp
+	Status(val ...string) ListStatusChangesOp
+	ToDate(val time.Time) ListStatusChangesOp
+	TransactionIds(val ...string) ListStatusChangesOp
+	UserName(val string) ListStatusChangesOp
+	Do(ctx context.Context) (*model.EnvelopesInformation, error)
+}
+
+// RotateDocumentPageOp is the version-neutral RotateDocumentPage op.
+type RotateDocumentPageOp interface {
+	Do(ctx context.Context) error
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	AdvancedUpdate() UpdateOp
+	ResendEnvelope() UpdateOp
+	Do(ctx context.Context) (*model.EnvelopeUpdateSummary, error)
+}
+
+// UpdateNotificationSettingsOp is the version-neutral UpdateNotificationSettings op.
+type UpdateNotificationSettingsOp interface {
+	Do(ctx context.Context) (*model.Notification, error)
+}
+
+// UpdateRecipientInitialsImageOp is the version-neutral UpdateRecipientInitialsImage op.
+type UpdateRecipientInitialsImageOp interface {
+	Do(ctx context.Context) error
+}
+
+// UpdateRecipientSignatureImageOp is the version-neutral UpdateRecipientSignatureImage op.
+type UpdateRecipientSignatureImageOp interface {
+	Do(ctx context.Context) error
+}
+
+// TemplateDocumentVisibilityGetOp is the version-neutral TemplateDocumentVisibilityGet op.
+type TemplateDocumentVisibilityGetOp interface {
+	Do(ctx context.Context) (*model.DocumentVisibilityList, error)
+}
+
+// TemplateDocumentVisibilityUpdateOp is the version-neutral TemplateDocumentVisibilityUpdate op.
+type TemplateDocumentVisibilityUpdateOp interface {
+	Do(ctx context.Context) (*model.TemplateDocumentVisibilityList, error)
+}
+
+// TemplateDocumentVisibilityUpdateListOp is the version-neutral TemplateDocumentVisibilityUpdateList op.
+type TemplateDocumentVisibilityUpdateListOp interface {
+	Do(ctx context.Context) (*model.TemplateDocumentVisibilityList, error)
+}
+
+// NotaryJournalsListOp is the version-neutral NotaryJournalsList op.
+type NotaryJournalsListOp interface {
+	Count(val string) NotaryJournalsListOp
+	SearchText(val string) NotaryJournalsListOp
+	StartPosition(val string) NotaryJournalsListOp
+	Do(ctx context.Context) (*model.NotaryJournalList, error)
+}
+
+// ViewsCreateSharedRecipientOp is the version-neutral ViewsCreateSharedRecipient op.
+type ViewsCreateSharedRecipientOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+type v21Service struct {
+	s *envelopes.Service
+}
+
+func (s v21Service) ChunkedUploadsCommit(chunkedUploadID string, media io.Reader, mimeType string) ChunkedUploadsCommitOp {
+	return &v21ChunkedUploadsCommitOp{op: s.s.ChunkedUploadsCommit(chunkedUploadID, media, mimeType)}
+}
+
+type v21ChunkedUploadsCommitOp struct {
+	op *envelopes.ChunkedUploadsCommitOp
+}
+
+func (o *v21ChunkedUploadsCommitOp) Action(val string) ChunkedUploadsCommitOp {
+	o.op.Action(val)
+	return o
+}
+
+func (o *v21ChunkedUploadsCommitOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ChunkedUploadsCreate(chunkedUploadRequest *model.ChunkedUploadRequest) ChunkedUploadsCreateOp {
+	return &v21ChunkedUploadsCreateOp{op: s.s.ChunkedUploadsCreate(chunkedUploadRequest)}
+}
+
+type v21ChunkedUploadsCreateOp struct {
+	op *envelopes.ChunkedUploadsCreateOp
+}
+
+func (o *v21ChunkedUploadsCreateOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ChunkedUploadsDelete(chunkedUploadID string) ChunkedUploadsDeleteOp {
+	return &v21ChunkedUploadsDeleteOp{op: s.s.ChunkedUploadsDelete(chunkedUploadID)}
+}
+
+type v21ChunkedUploadsDeleteOp struct {
+	op *envelopes.ChunkedUploadsDeleteOp
+}
+
+func (o *v21ChunkedUploadsDeleteOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ChunkedUploadsGet(chunkedUploadID string) ChunkedUploadsGetOp {
+	return &v21ChunkedUploadsGetOp{op: s.s.ChunkedUploadsGet(chunkedUploadID)}
+}
+
+type v21ChunkedUploadsGetOp struct {
+	op *envelopes.ChunkedUploadsGetOp
+}
+
+func (o *v21ChunkedUploadsGetOp) Include(val ...string) ChunkedUploadsGetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21ChunkedUploadsGetOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ChunkedUploadsUpdate(chunkedUploadID string, chunkedUploadPartSeq string, chunkedUploadRequest *model.ChunkedUploadRequest) ChunkedUploadsUpdateOp {
+	return &v21ChunkedUploadsUpdateOp{op: s.s.ChunkedUploadsUpdate(chunkedUploadID, chunkedUploadPartSeq, chunkedUploadRequest)}
+}
+
+type v21ChunkedUploadsUpdateOp struct {
+	op *envelopes.ChunkedUploadsUpdateOp
+}
+
+func (o *v21ChunkedUploadsUpdateOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) AttachmentsCreate(envelopeID string, envelopeAttachmentsRequest *model.EnvelopeAttachmentsRequest) AttachmentsCreateOp {
+	return &v21AttachmentsCreateOp{op: s.s.AttachmentsCreate(envelopeID, envelopeAttachmentsRequest)}
+}
+
+type v21AttachmentsCreateOp struct {
+	op *envelopes.AttachmentsCreateOp
+}
+
+func (o *v21AttachmentsCreateOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) AttachmentsDelete(envelopeID string, envelopeAttachmentsRequest *model.EnvelopeAttachmentsRequest) AttachmentsDeleteOp {
+	return &v21AttachmentsDeleteOp{op: s.s.AttachmentsDelete(envelopeID, envelopeAttachmentsRequest)}
+}
+
+type v21AttachmentsDeleteOp struct {
+	op *envelopes.AttachmentsDeleteOp
+}
+
+func (o *v21AttachmentsDeleteOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) AttachmentsGet(attachmentID string, envelopeID string) AttachmentsGetOp {
+	return &v21AttachmentsGetOp{op: s.s.AttachmentsGet(attachmentID, envelopeID)}
+}
+
+type v21AttachmentsGetOp struct {
+	op *envelopes.AttachmentsGetOp
+}
+
+func (o *v21AttachmentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) AttachmentsList(envelopeID string) AttachmentsListOp {
+	return &v21AttachmentsListOp{op: s.s.AttachmentsList(envelopeID)}
+}
+
+type v21AttachmentsListOp struct {
+	op *envelopes.AttachmentsListOp
+}
+
+func (o *v21AttachmentsListOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) AttachmentsUpdate(attachmentID string, envelopeID string, attachment *model.Attachment) AttachmentsUpdateOp {
+	return &v21AttachmentsUpdateOp{op: s.s.AttachmentsUpdate(attachmentID, envelopeID, attachment)}
+}
+
+type v21AttachmentsUpdateOp struct {
+	op *envelopes.AttachmentsUpdateOp
+}
+
+func (o *v21AttachmentsUpdateOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConsumerDisclosuresGet(envelopeID string, langCode string, recipientID string) ConsumerDisclosuresGetOp {
+	return &v21ConsumerDisclosuresGetOp{op: s.s.ConsumerDisclosuresGet(envelopeID, langCode, recipientID)}
+}
+
+type v21ConsumerDisclosuresGetOp struct {
+	op *envelopes.ConsumerDisclosuresGetOp
+}
+
+func (o *v21ConsumerDisclosuresGetOp) LangCode(val string) ConsumerDisclosuresGetOp {
+	o.op.LangCode(val)
+	return o
+}
+
+func (o *v21ConsumerDisclosuresGetOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ConsumerDisclosuresGetDefault(envelopeID string, recipientID string) ConsumerDisclosuresGetDefaultOp {
+	return &v21ConsumerDisclosuresGetDefaultOp{op: s.s.ConsumerDisclosuresGetDefault(envelopeID, recipientID)}
+}
+
+type v21ConsumerDisclosuresGetDefaultOp struct {
+	op *envelopes.ConsumerDisclosuresGetDefaultOp
+}
+
+func (o *v21ConsumerDisclosuresGetDefaultOp) LangCode(val string) ConsumerDisclosuresGetDefaultOp {
+	o.op.LangCode(val)
+	return o
+}
+
+func (o *v21ConsumerDisclosuresGetDefaultOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsCreate(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsCreateOp {
+	return &v21CustomFieldsCreateOp{op: s.s.CustomFieldsCreate(envelopeID, envelopeCustomFields)}
+}
+
+type v21CustomFieldsCreateOp struct {
+	op *envelopes.CustomFieldsCreateOp
+}
+
+func (o *v21CustomFieldsCreateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsDelete(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsDeleteOp {
+	return &v21CustomFieldsDeleteOp{op: s.s.CustomFieldsDelete(envelopeID, envelopeCustomFields)}
+}
+
+type v21CustomFieldsDeleteOp struct {
+	op *envelopes.CustomFieldsDeleteOp
+}
+
+func (o *v21CustomFieldsDeleteOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsList(envelopeID string) CustomFieldsListOp {
+	return &v21CustomFieldsListOp{op: s.s.CustomFieldsList(envelopeID)}
+}
+
+type v21CustomFieldsListOp struct {
+	op *envelopes.CustomFieldsListOp
+}
+
+func (o *v21CustomFieldsListOp) Do(ctx context.Context) (*model.CustomFieldsEnvelope, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsUpdate(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsUpdateOp {
+	return &v21CustomFieldsUpdateOp{op: s.s.CustomFieldsUpdate(envelopeID, envelopeCustomFields)}
+}
+
+type v21CustomFieldsUpdateOp struct {
+	op *envelopes.CustomFieldsUpdateOp
+}
+
+func (o *v21CustomFieldsUpdateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsCreate(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsCreateOp {
+	return &v21DocumentFieldsCreateOp{op: s.s.DocumentFieldsCreate(documentID, envelopeID, envelopeDocumentFields)}
+}
+
+type v21DocumentFieldsCreateOp struct {
+	op *envelopes.DocumentFieldsCreateOp
+}
+
+func (o *v21DocumentFieldsCreateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsDelete(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsDeleteOp {
+	return &v21DocumentFieldsDeleteOp{op: s.s.DocumentFieldsDelete(documentID, envelopeID, envelopeDocumentFields)}
+}
+
+type v21DocumentFieldsDeleteOp struct {
+	op *envelopes.DocumentFieldsDeleteOp
+}
+
+func (o *v21DocumentFieldsDeleteOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsList(documentID string, envelopeID string) DocumentFieldsListOp {
+	return &v21DocumentFieldsListOp{op: s.s.DocumentFieldsList(documentID, envelopeID)}
+}
+
+type v21DocumentFieldsListOp struct {
+	op *envelopes.DocumentFieldsListOp
+}
+
+func (o *v21DocumentFieldsListOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsUpdate(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsUpdateOp {
+	return &v21DocumentFieldsUpdateOp{op: s.s.DocumentFieldsUpdate(documentID, envelopeID, envelopeDocumentFields)}
+}
+
+type v21DocumentFieldsUpdateOp struct {
+	op *envelopes.DocumentFieldsUpdateOp
+}
+
+func (o *v21DocumentFieldsUpdateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentTabsGet(documentID string, envelopeID string) DocumentTabsGetOp {
+	return &v21DocumentTabsGetOp{op: s.s.DocumentTabsGet(documentID, envelopeID)}
+}
+
+type v21DocumentTabsGetOp struct {
+	op *envelopes.DocumentTabsGetOp
+}
+
+func (o *v21DocumentTabsGetOp) PageNumbers(val string) DocumentTabsGetOp {
+	o.op.PageNumbers(val)
+	return o
+}
+
+func (o *v21DocumentTabsGetOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentTabsGetByPage(documentID string, envelopeID string, pageNumber string) DocumentTabsGetByPageOp {
+	return &v21DocumentTabsGetByPageOp{op: s.s.DocumentTabsGetByPage(documentID, envelopeID, pageNumber)}
+}
+
+type v21DocumentTabsGetByPageOp struct {
+	op *envelopes.DocumentTabsGetByPageOp
+}
+
+func (o *v21DocumentTabsGetByPageOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentVisibilityGet(envelopeID string, recipientID string) DocumentVisibilityGetOp {
+	return &v21DocumentVisibilityGetOp{op: s.s.DocumentVisibilityGet(envelopeID, recipientID)}
+}
+
+type v21DocumentVisibilityGetOp struct {
+	op *envelopes.DocumentVisibilityGetOp
+}
+
+func (o *v21DocumentVisibilityGetOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentVisibilityUpdate(envelopeID string, recipientID string, envelopeDocumentVisibility *model.DocumentVisibilityList) DocumentVisibilityUpdateOp {
+	return &v21DocumentVisibilityUpdateOp{op: s.s.DocumentVisibilityUpdate(envelopeID, recipientID, envelopeDocumentVisibility)}
+}
+
+type v21DocumentVisibilityUpdateOp struct {
+	op *envelopes.DocumentVisibilityUpdateOp
+}
+
+func (o *v21DocumentVisibilityUpdateOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentVisibilityUpdateList(envelopeID string, envelopeDocumentVisibility *model.DocumentVisibilityList) DocumentVisibilityUpdateListOp {
+	return &v21DocumentVisibilityUpdateListOp{op: s.s.DocumentVisibilityUpdateList(envelopeID, envelopeDocumentVisibility)}
+}
+
+type v21DocumentVisibilityUpdateListOp struct {
+	op *envelopes.DocumentVisibilityUpdateListOp
+}
+
+func (o *v21DocumentVisibilityUpdateListOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsDelete(envelopeID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsDeleteOp {
+	return &v21DocumentsDeleteOp{op: s.s.DocumentsDelete(envelopeID, envelopeDefinition)}
+}
+
+type v21DocumentsDeleteOp struct {
+	op *envelopes.DocumentsDeleteOp
+}
+
+func (o *v21DocumentsDeleteOp) Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsGet(documentID string, envelopeID string) DocumentsGetOp {
+	return &v21DocumentsGetOp{op: s.s.DocumentsGet(documentID, envelopeID)}
+}
+
+type v21DocumentsGetOp struct {
+	op *envelopes.DocumentsGetOp
+}
+
+func (o *v21DocumentsGetOp) Certificate() DocumentsGetOp {
+	o.op.Certificate()
+	return o
+}
+
+func (o *v21DocumentsGetOp) Encoding(val string) DocumentsGetOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v21DocumentsGetOp) Encrypt() DocumentsGetOp {
+	o.op.Encrypt()
+	return o
+}
+
+func (o *v21DocumentsGetOp) Language(val string) DocumentsGetOp {
+	o.op.Language(val)
+	return o
+}
+
+func (o *v21DocumentsGetOp) RecipientID(val string) DocumentsGetOp {
+	o.op.RecipientID(val)
+	return o
+}
+
+func (o *v21DocumentsGetOp) ShowChanges() DocumentsGetOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v21DocumentsGetOp) Watermark() DocumentsGetOp {
+	o.op.Watermark()
+	return o
+}
+
+func (o *v21DocumentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsList(envelopeID string) DocumentsListOp {
+	return &v21DocumentsListOp{op: s.s.DocumentsList(envelopeID)}
+}
+
+type v21DocumentsListOp struct {
+	op *envelopes.DocumentsListOp
+}
+
+func (o *v21DocumentsListOp) Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsUpdateList(envelopeID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateListOp {
+	return &v21DocumentsUpdateListOp{op: s.s.DocumentsUpdateList(envelopeID, envelopeDefinition)}
+}
+
+type v21DocumentsUpdateListOp struct {
+	op *envelopes.DocumentsUpdateListOp
+}
+
+func (o *v21DocumentsUpdateListOp) Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EmailSettingsCreate(envelopeID string, envelopeEmailSettings *model.EmailSettings) EmailSettingsCreateOp {
+	return &v21EmailSettingsCreateOp{op: s.s.EmailSettingsCreate(envelopeID, envelopeEmailSettings)}
+}
+
+type v21EmailSettingsCreateOp struct {
+	op *envelopes.EmailSettingsCreateOp
+}
+
+func (o *v21EmailSettingsCreateOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EmailSettingsDelete(envelopeID string) EmailSettingsDeleteOp {
+	return &v21EmailSettingsDeleteOp{op: s.s.EmailSettingsDelete(envelopeID)}
+}
+
+type v21EmailSettingsDeleteOp struct {
+	op *envelopes.EmailSettingsDeleteOp
+}
+
+func (o *v21EmailSettingsDeleteOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EmailSettingsGet(envelopeID string) EmailSettingsGetOp {
+	return &v21EmailSettingsGetOp{op: s.s.EmailSettingsGet(envelopeID)}
+}
+
+type v21EmailSettingsGetOp struct {
+	op *envelopes.EmailSettingsGetOp
+}
+
+func (o *v21EmailSettingsGetOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EmailSettingsUpdate(envelopeID string, envelopeEmailSettings *model.EmailSettings) EmailSettingsUpdateOp {
+	return &v21EmailSettingsUpdateOp{op: s.s.EmailSettingsUpdate(envelopeID, envelopeEmailSettings)}
+}
+
+type v21EmailSettingsUpdateOp struct {
+	op *envelopes.EmailSettingsUpdateOp
+}
+
+func (o *v21EmailSettingsUpdateOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) FormDataGet(envelopeID string) FormDataGetOp {
+	return &v21FormDataGetOp{op: s.s.FormDataGet(envelopeID)}
+}
+
+type v21FormDataGetOp struct {
+	op *envelopes.FormDataGetOp
+}
+
+func (o *v21FormDataGetOp) Do(ctx context.Context) (*model.EnvelopeFormData, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksCreate(envelopeID string, lockRequest *model.LockRequest) LocksCreateOp {
+	return &v21LocksCreateOp{op: s.s.LocksCreate(envelopeID, lockRequest)}
+}
+
+type v21LocksCreateOp struct {
+	op *envelopes.LocksCreateOp
+}
+
+func (o *v21LocksCreateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksDelete(envelopeID string) LocksDeleteOp {
+	return &v21LocksDeleteOp{op: s.s.LocksDelete(envelopeID)}
+}
+
+type v21LocksDeleteOp struct {
+	op *envelopes.LocksDeleteOp
+}
+
+func (o *v21LocksDeleteOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksGet(envelopeID string) LocksGetOp {
+	return &v21LocksGetOp{op: s.s.LocksGet(envelopeID)}
+}
+
+type v21LocksGetOp struct {
+	op *envelopes.LocksGetOp
+}
+
+func (o *v21LocksGetOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksUpdate(envelopeID string, lockRequest *model.LockRequest) LocksUpdateOp {
+	return &v21LocksUpdateOp{op: s.s.LocksUpdate(envelopeID, lockRequest)}
+}
+
+type v21LocksUpdateOp struct {
+	op *envelopes.LocksUpdateOp
+}
+
+func (o *v21LocksUpdateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsCreate(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsCreateOp {
+	return &v21RecipientTabsCreateOp{op: s.s.RecipientTabsCreate(envelopeID, recipientID, envelopeRecipientTabs)}
+}
+
+type v21RecipientTabsCreateOp struct {
+	op *envelopes.RecipientTabsCreateOp
+}
+
+func (o *v21RecipientTabsCreateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsDelete(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsDeleteOp {
+	return &v21RecipientTabsDeleteOp{op: s.s.RecipientTabsDelete(envelopeID, recipientID, envelopeRecipientTabs)}
+}
+
+type v21RecipientTabsDeleteOp struct {
+	op *envelopes.RecipientTabsDeleteOp
+}
+
+func (o *v21RecipientTabsDeleteOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsList(envelopeID string, recipientID string) RecipientTabsListOp {
+	return &v21RecipientTabsListOp{op: s.s.RecipientTabsList(envelopeID, recipientID)}
+}
+
+type v21RecipientTabsListOp struct {
+	op *envelopes.RecipientTabsListOp
+}
+
+func (o *v21RecipientTabsListOp) IncludeAnchorTabLocations() RecipientTabsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v21RecipientTabsListOp) IncludeMetadata(val string) RecipientTabsListOp {
+	o.op.IncludeMetadata(val)
+	return o
+}
+
+func (o *v21RecipientTabsListOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsUpdate(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsUpdateOp {
+	return &v21RecipientTabsUpdateOp{op: s.s.RecipientTabsUpdate(envelopeID, recipientID, envelopeRecipientTabs)}
+}
+
+type v21RecipientTabsUpdateOp struct {
+	op *envelopes.RecipientTabsUpdateOp
+}
+
+func (o *v21RecipientTabsUpdateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsCreate(envelopeID string, envelopeRecipients *model.Recipients) RecipientsCreateOp {
+	return &v21RecipientsCreateOp{op: s.s.RecipientsCreate(envelopeID, envelopeRecipients)}
+}
+
+type v21RecipientsCreateOp struct {
+	op *envelopes.RecipientsCreateOp
+}
+
+func (o *v21RecipientsCreateOp) ResendEnvelope() RecipientsCreateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v21RecipientsCreateOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsDelete(envelopeID string, recipientID string) RecipientsDeleteOp {
+	return &v21RecipientsDeleteOp{op: s.s.RecipientsDelete(envelopeID, recipientID)}
+}
+
+type v21RecipientsDeleteOp struct {
+	op *envelopes.RecipientsDeleteOp
+}
+
+func (o *v21RecipientsDeleteOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsDeleteList(envelopeID string, envelopeRecipients *model.Recipients) RecipientsDeleteListOp {
+	return &v21RecipientsDeleteListOp{op: s.s.RecipientsDeleteList(envelopeID, envelopeRecipients)}
+}
+
+type v21RecipientsDeleteListOp struct {
+	op *envelopes.RecipientsDeleteListOp
+}
+
+func (o *v21RecipientsDeleteListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsList(envelopeID string) RecipientsListOp {
+	return &v21RecipientsListOp{op: s.s.RecipientsList(envelopeID)}
+}
+
+type v21RecipientsListOp struct {
+	op *envelopes.RecipientsListOp
+}
+
+func (o *v21RecipientsListOp) IncludeAnchorTabLocations() RecipientsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v21RecipientsListOp) IncludeExtended() RecipientsListOp {
+	o.op.IncludeExtended()
+	return o
+}
+
+func (o *v21RecipientsListOp) IncludeTabs() RecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v21RecipientsListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsUpdate(envelopeID string, envelopeRecipients *model.Recipients) RecipientsUpdateOp {
+	return &v21RecipientsUpdateOp{op: s.s.RecipientsUpdate(envelopeID, envelopeRecipients)}
+}
+
+type v21RecipientsUpdateOp struct {
+	op *envelopes.RecipientsUpdateOp
+}
+
+func (o *v21RecipientsUpdateOp) OfflineSigning(val string) RecipientsUpdateOp {
+	o.op.OfflineSigning(val)
+	return o
+}
+
+func (o *v21RecipientsUpdateOp) ResendEnvelope() RecipientsUpdateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v21RecipientsUpdateOp) Do(ctx context.Context) (*model.RecipientsUpdateSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplatesApply(envelopeID string, documentTemplateList *model.DocumentTemplateList) TemplatesApplyOp {
+	return &v21TemplatesApplyOp{op: s.s.TemplatesApply(envelopeID, documentTemplateList)}
+}
+
+type v21TemplatesApplyOp struct {
+	op *envelopes.TemplatesApplyOp
+}
+
+func (o *v21TemplatesApplyOp) Do(ctx context.Context) (*model.DocumentTemplateList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplatesApplyToDocument(documentID string, envelopeID string, documentTemplateList *model.DocumentTemplateList) TemplatesApplyToDocumentOp {
+	return &v21TemplatesApplyToDocumentOp{op: s.s.TemplatesApplyToDocument(documentID, envelopeID, documentTemplateList)}
+}
+
+type v21TemplatesApplyToDocumentOp struct {
+	op *envelopes.TemplatesApplyToDocumentOp
+}
+
+func (o *v21TemplatesApplyToDocumentOp) Do(ctx context.Context) (*model.DocumentTemplateList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplatesDelete(documentID string, envelopeID string, templateID string) TemplatesDeleteOp {
+	return &v21TemplatesDeleteOp{op: s.s.TemplatesDelete(documentID, envelopeID, templateID)}
+}
+
+type v21TemplatesDeleteOp struct {
+	op *envelopes.TemplatesDeleteOp
+}
+
+func (o *v21TemplatesDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplatesList(envelopeID string) TemplatesListOp {
+	return &v21TemplatesListOp{op: s.s.TemplatesList(envelopeID)}
+}
+
+type v21TemplatesListOp struct {
+	op *envelopes.TemplatesListOp
+}
+
+func (o *v21TemplatesListOp) Include(val string) TemplatesListOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v21TemplatesListOp) Do(ctx context.Context) (*model.TemplateInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplatesListByDocument(documentID string, envelopeID string) TemplatesListByDocumentOp {
+	return &v21TemplatesListByDocumentOp{op: s.s.TemplatesListByDocument(documentID, envelopeID)}
+}
+
+type v21TemplatesListByDocumentOp struct {
+	op *envelopes.TemplatesListByDocumentOp
+}
+
+func (o *v21TemplatesListByDocumentOp) Include(val ...string) TemplatesListByDocumentOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21TemplatesListByDocumentOp) Do(ctx context.Context) (*model.TemplateInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateConsole(consoleViewRequest *model.ConsoleViewRequest) ViewsCreateConsoleOp {
+	return &v21ViewsCreateConsoleOp{op: s.s.ViewsCreateConsole(consoleViewRequest)}
+}
+
+type v21ViewsCreateConsoleOp struct {
+	op *envelopes.ViewsCreateConsoleOp
+}
+
+func (o *v21ViewsCreateConsoleOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateCorrect(envelopeID string, correctViewRequest *model.CorrectViewRequest) ViewsCreateCorrectOp {
+	return &v21ViewsCreateCorrectOp{op: s.s.ViewsCreateCorrect(envelopeID, correctViewRequest)}
+}
+
+type v21ViewsCreateCorrectOp struct {
+	op *envelopes.ViewsCreateCorrectOp
+}
+
+func (o *v21ViewsCreateCorrectOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateEdit(envelopeID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateEditOp {
+	return &v21ViewsCreateEditOp{op: s.s.ViewsCreateEdit(envelopeID, returnURLRequest)}
+}
+
+type v21ViewsCreateEditOp struct {
+	op *envelopes.ViewsCreateEditOp
+}
+
+func (o *v21ViewsCreateEditOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateRecipient(envelopeID string, recipientViewRequest *model.RecipientViewRequest) ViewsCreateRecipientOp {
+	return &v21ViewsCreateRecipientOp{op: s.s.ViewsCreateRecipient(envelopeID, recipientViewRequest)}
+}
+
+type v21ViewsCreateRecipientOp struct {
+	op *envelopes.ViewsCreateRecipientOp
+}
+
+func (o *v21ViewsCreateRecipientOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateSender(envelopeID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateSenderOp {
+	return &v21ViewsCreateSenderOp{op: s.s.ViewsCreateSender(envelopeID, returnURLRequest)}
+}
+
+type v21ViewsCreateSenderOp struct {
+	op *envelopes.ViewsCreateSenderOp
+}
+
+func (o *v21ViewsCreateSenderOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(envelopeDefinition *model.EnvelopeDefinition, uploads ...*esign.UploadFile) CreateOp {
+	return &v21CreateOp{op: s.s.Create(envelopeDefinition, uploads...)}
+}
+
+type v21CreateOp struct {
+	op *envelopes.CreateOp
+}
+
+func (o *v21CreateOp) CdseMode(val string) CreateOp {
+	o.op.CdseMode(val)
+	return o
+}
+
+func (o *v21CreateOp) ChangeRoutingOrder() CreateOp {
+	o.op.ChangeRoutingOrder()
+	return o
+}
+
+func (o *v21CreateOp) CompletedDocumentsOnly(val string) CreateOp {
+	o.op.CompletedDocumentsOnly(val)
+	return o
+}
+
+func (o *v21CreateOp) MergeRolesOnDraft() CreateOp {
+	o.op.MergeRolesOnDraft()
+	return o
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.EnvelopeSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DeleteDocumentPage(documentID string, envelopeID string, pageNumber string) DeleteDocumentPageOp {
+	return &v21DeleteDocumentPageOp{op: s.s.DeleteDocumentPage(documentID, envelopeID, pageNumber)}
+}
+
+type v21DeleteDocumentPageOp struct {
+	op *envelopes.DeleteDocumentPageOp
+}
+
+func (o *v21DeleteDocumentPageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(envelopeID string) GetOp {
+	return &v21GetOp{op: s.s.Get(envelopeID)}
+}
+
+type v21GetOp struct {
+	op *envelopes.GetOp
+}
+
+func (o *v21GetOp) AdvancedUpdate() GetOp {
+	o.op.AdvancedUpdate()
+	return o
+}
+
+func (o *v21GetOp) Include(val string) GetOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.Envelope, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetNotificationSettings(envelopeID string) GetNotificationSettingsOp {
+	return &v21GetNotificationSettingsOp{op: s.s.GetNotificationSettings(envelopeID)}
+}
+
+type v21GetNotificationSettingsOp struct {
+	op *envelopes.GetNotificationSettingsOp
+}
+
+func (o *v21GetNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetPageImage(documentID string, envelopeID string, pageNumber string) GetPageImageOp {
+	return &v21GetPageImageOp{op: s.s.GetPageImage(documentID, envelopeID, pageNumber)}
+}
+
+type v21GetPageImageOp struct {
+	op *envelopes.GetPageImageOp
+}
+
+func (o *v21GetPageImageOp) Dpi(val int) GetPageImageOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v21GetPageImageOp) MaxHeight(val int) GetPageImageOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v21GetPageImageOp) MaxWidth(val int) GetPageImageOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v21GetPageImageOp) ShowChanges() GetPageImageOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v21GetPageImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetPageImages(documentID string, envelopeID string) GetPageImagesOp {
+	return &v21GetPageImagesOp{op: s.s.GetPageImages(documentID, envelopeID)}
+}
+
+type v21GetPageImagesOp struct {
+	op *envelopes.GetPageImagesOp
+}
+
+func (o *v21GetPageImagesOp) Count(val int) GetPageImagesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) Dpi(val int) GetPageImagesOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) MaxHeight(val int) GetPageImagesOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) MaxWidth(val int) GetPageImagesOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) Nocache() GetPageImagesOp {
+	o.op.Nocache()
+	return o
+}
+
+func (o *v21GetPageImagesOp) ShowChanges() GetPageImagesOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v21GetPageImagesOp) StartPosition(val int) GetPageImagesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetRecipientInitialsImage(envelopeID string, recipientID string) GetRecipientInitialsImageOp {
+	return &v21GetRecipientInitialsImageOp{op: s.s.GetRecipientInitialsImage(envelopeID, recipientID)}
+}
+
+type v21GetRecipientInitialsImageOp struct {
+	op *envelopes.GetRecipientInitialsImageOp
+}
+
+func (o *v21GetRecipientInitialsImageOp) IncludeChrome() GetRecipientInitialsImageOp {
+	o.op.IncludeChrome()
+	return o
+}
+
+func (o *v21GetRecipientInitialsImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetRecipientSignature(envelopeID string, recipientID string) GetRecipientSignatureOp {
+	return &v21GetRecipientSignatureOp{op: s.s.GetRecipientSignature(envelopeID, recipientID)}
+}
+
+type v21GetRecipientSignatureOp struct {
+	op *envelopes.GetRecipientSignatureOp
+}
+
+func (o *v21GetRecipientSignatureOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetRecipientSignatureImage(envelopeID string, recipientID string) GetRecipientSignatureImageOp {
+	return &v21GetRecipientSignatureImageOp{op: s.s.GetRecipientSignatureImage(envelopeID, recipientID)}
+}
+
+type v21GetRecipientSignatureImageOp struct {
+	op *envelopes.GetRecipientSignatureImageOp
+}
+
+func (o *v21GetRecipientSignatureImageOp) IncludeChrome() GetRecipientSignatureImageOp {
+	o.op.IncludeChrome()
+	return o
+}
+
+func (o *v21GetRecipientSignatureImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListAuditEvents(envelopeID string) ListAuditEventsOp {
+	return &v21ListAuditEventsOp{op: s.s.ListAuditEvents(envelopeID)}
+}
+
+type v21ListAuditEventsOp struct {
+	op *envelopes.ListAuditEventsOp
+}
+
+func (o *v21ListAuditEventsOp) Do(ctx context.Context) (*model.EnvelopeAuditEventResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListStatus(envelopeIdsRequest *model.EnvelopeIdsRequest) ListStatusOp {
+	return &v21ListStatusOp{op: s.s.ListStatus(envelopeIdsRequest)}
+}
+
+type v21ListStatusOp struct {
+	op *envelopes.ListStatusOp
+}
+
+func (o *v21ListStatusOp) AcStatus(val string) ListStatusOp {
+	o.op.AcStatus(val)
+	return o
+}
+
+func (o *v21ListStatusOp) Block(val string) ListStatusOp {
+	o.op.Block(val)
+	return o
+}
+
+func (o *v21ListStatusOp) Count(val string) ListStatusOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListStatusOp) Email(val string) ListStatusOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v21ListStatusOp) EnvelopeIds(val string) ListStatusOp {
+	o.op.EnvelopeIds(val)
+	return o
+}
+
+func (o *v21ListStatusOp) FromDate(val time.Time) ListStatusOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21ListStatusOp) FromToStatus(val string) ListStatusOp {
+	o.op.FromToStatus(val)
+	return o
+}
+
+func (o *v21ListStatusOp) StartPosition(val int) ListStatusOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListStatusOp) Status(val string) ListStatusOp {
+	o.op.Status(val)
+	return o
+}
+
+func (o *v21ListStatusOp) ToDate(val time.Time) ListStatusOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21ListStatusOp) TransactionIds(val string) ListStatusOp {
+	o.op.TransactionIds(val)
+	return o
+}
+
+func (o *v21ListStatusOp) UserName(val string) ListStatusOp {
+	o.op.UserName(val)
+	return o
+}
+
+func (o *v21ListStatusOp) Do(ctx context.Context) (*model.EnvelopesInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListStatusChanges() ListStatusChangesOp {
+	return &v21ListStatusChangesOp{op: s.s.ListStatusChanges()}
+}
+
+type v21ListStatusChangesOp struct {
+	op *envelopes.ListStatusChangesOp
+}
+
+func (o *v21ListStatusChangesOp) AcStatus(val string) ListStatusChangesOp {
+	o.op.AcStatus(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) Block(val string) ListStatusChangesOp {
+	o.op.Block(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) Count(val int) ListStatusChangesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) CustomField(val string) ListStatusChangesOp {
+	o.op.CustomField(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) Email(val string) ListStatusChangesOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) EnvelopeIds(val ...string) ListStatusChangesOp {
+	o.op.EnvelopeIds(val...)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) FromDate(val time.Time) ListStatusChangesOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) FromToStatus(val string) ListStatusChangesOp {
+	o.op.FromToStatus(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) StartPosition(val int) ListStatusChangesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) Status(val ...string) ListStatusChangesOp {
+	o.op.Status(val...)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) ToDate(val time.Time) ListStatusChangesOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) TransactionIds(val ...string) ListStatusChangesOp {
+	o.op.TransactionIds(val...)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) UserName(val string) ListStatusChangesOp {
+	o.op.UserName(val)
+	return o
+}
+
+func (o *v21ListStatusChangesOp) Do(ctx context.Context) (*model.EnvelopesInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RotateDocumentPage(documentID string, envelopeID string, pageNumber string, pageRequest *model.PageRequest) RotateDocumentPageOp {
+	return &v21RotateDocumentPageOp{op: s.s.RotateDocumentPage(documentID, envelopeID, pageNumber, pageRequest)}
+}
+
+type v21RotateDocumentPageOp struct {
+	op *envelopes.RotateDocumentPageOp
+}
+
+func (o *v21RotateDocumentPageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(envelopeID string, envelopes *model.Envelope) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(envelopeID, envelopes)}
+}
+
+type v21UpdateOp struct {
+	op *envelopes.UpdateOp
+}
+
+func (o *v21UpdateOp) AdvancedUpdate() UpdateOp {
+	o.op.AdvancedUpdate()
+	return o
+}
+
+func (o *v21UpdateOp) ResendEnvelope() UpdateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.EnvelopeUpdateSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateNotificationSettings(envelopeID string, envelopeNotificationRequest *model.EnvelopeNotificationRequest) UpdateNotificationSettingsOp {
+	return &v21UpdateNotificationSettingsOp{op: s.s.UpdateNotificationSettings(envelopeID, envelopeNotificationRequest)}
+}
+
+type v21UpdateNotificationSettingsOp struct {
+	op *envelopes.UpdateNotificationSettingsOp
+}
+
+func (o *v21UpdateNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateRecipientInitialsImage(envelopeID string, recipientID string, media io.Reader, mimeType string) UpdateRecipientInitialsImageOp {
+	return &v21UpdateRecipientInitialsImageOp{op: s.s.UpdateRecipientInitialsImage(envelopeID, recipientID, media, mimeType)}
+}
+
+type v21UpdateRecipientInitialsImageOp struct {
+	op *envelopes.UpdateRecipientInitialsImageOp
+}
+
+func (o *v21UpdateRecipientInitialsImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateRecipientSignatureImage(envelopeID string, recipientID string, media io.Reader, mimeType string) UpdateRecipientSignatureImageOp {
+	return &v21UpdateRecipientSignatureImageOp{op: s.s.UpdateRecipientSignatureImage(envelopeID, recipientID, media, mimeType)}
+}
+
+type v21UpdateRecipientSignatureImageOp struct {
+	op *envelopes.UpdateRecipientSignatureImageOp
+}
+
+func (o *v21UpdateRecipientSignatureImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateDocumentVisibilityGet(recipientID string, templateID string) TemplateDocumentVisibilityGetOp {
+	return &v21TemplateDocumentVisibilityGetOp{op: s.s.TemplateDocumentVisibilityGet(recipientID, templateID)}
+}
+
+type v21TemplateDocumentVisibilityGetOp struct {
+	op *envelopes.TemplateDocumentVisibilityGetOp
+}
+
+func (o *v21TemplateDocumentVisibilityGetOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateDocumentVisibilityUpdate(recipientID string, templateID string, templateDocumentVisibility *model.TemplateDocumentVisibilityList) TemplateDocumentVisibilityUpdateOp {
+	return &v21TemplateDocumentVisibilityUpdateOp{op: s.s.TemplateDocumentVisibilityUpdate(recipientID, templateID, templateDocumentVisibility)}
+}
+
+type v21TemplateDocumentVisibilityUpdateOp struct {
+	op *envelopes.TemplateDocumentVisibilityUpdateOp
+}
+
+func (o *v21TemplateDocumentVisibilityUpdateOp) Do(ctx context.Context) (*model.TemplateDocumentVisibilityList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateDocumentVisibilityUpdateList(templateID string, templateDocumentVisibility *model.TemplateDocumentVisibilityList) TemplateDocumentVisibilityUpdateListOp {
+	return &v21TemplateDocumentVisibilityUpdateListOp{op: s.s.TemplateDocumentVisibilityUpdateList(templateID, templateDocumentVisibility)}
+}
+
+type v21TemplateDocumentVisibilityUpdateListOp struct {
+	op *envelopes.TemplateDocumentVisibilityUpdateListOp
+}
+
+func (o *v21TemplateDocumentVisibilityUpdateListOp) Do(ctx context.Context) (*model.TemplateDocumentVisibilityList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) NotaryJournalsList() NotaryJournalsListOp {
+	return &v21NotaryJournalsListOp{op: s.s.NotaryJournalsList()}
+}
+
+type v21NotaryJournalsListOp struct {
+	op *envelopes.NotaryJournalsListOp
+}
+
+func (o *v21NotaryJournalsListOp) Count(val string) NotaryJournalsListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21NotaryJournalsListOp) SearchText(val string) NotaryJournalsListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21NotaryJournalsListOp) StartPosition(val string) NotaryJournalsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21NotaryJournalsListOp) Do(ctx context.Context) (*model.NotaryJournalList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateSharedRecipient(envelopeID string, recipientViewRequest *model.RecipientViewRequest) ViewsCreateSharedRecipientOp {
+	return &v21ViewsCreateSharedRecipientOp{op: s.s.ViewsCreateSharedRecipient(envelopeID, recipientViewRequest)}
+}
+
+type v21ViewsCreateSharedRecipientOp struct {
+	op *envelopes.ViewsCreateSharedRecipientOp
+}
+
+func (o *v21ViewsCreateSharedRecipientOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2envelopes.Service
+}
+
+func (s v2Service) ChunkedUploadsCommit(chunkedUploadID string, media io.Reader, mimeType string) ChunkedUploadsCommitOp {
+	return &v2ChunkedUploadsCommitOp{op: s.s.ChunkedUploadsCommit(chunkedUploadID, media, mimeType)}
+}
+
+type v2ChunkedUploadsCommitOp struct {
+	op  *v2envelopes.ChunkedUploadsCommitOp
+	err error
+}
+
+func (o *v2ChunkedUploadsCommitOp) Action(val string) ChunkedUploadsCommitOp {
+	o.op.Action(val)
+	return o
+}
+
+func (o *v2ChunkedUploadsCommitOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ChunkedUploadResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ChunkedUploadsCreate(chunkedUploadRequest *model.ChunkedUploadRequest) ChunkedUploadsCreateOp {
+	o := &v2ChunkedUploadsCreateOp{}
+	var v2chunkedUploadRequest *v2model.ChunkedUploadRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2chunkedUploadRequest, chunkedUploadRequest)
+	}
+	o.op = s.s.ChunkedUploadsCreate(v2chunkedUploadRequest)
+	return o
+}
+
+type v2ChunkedUploadsCreateOp struct {
+	op  *v2envelopes.ChunkedUploadsCreateOp
+	err error
+}
+
+func (o *v2ChunkedUploadsCreateOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ChunkedUploadResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ChunkedUploadsDelete(chunkedUploadID string) ChunkedUploadsDeleteOp {
+	return &v2ChunkedUploadsDeleteOp{op: s.s.ChunkedUploadsDelete(chunkedUploadID)}
+}
+
+type v2ChunkedUploadsDeleteOp struct {
+	op  *v2envelopes.ChunkedUploadsDeleteOp
+	err error
+}
+
+func (o *v2ChunkedUploadsDeleteOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ChunkedUploadResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ChunkedUploadsGet(chunkedUploadID string) ChunkedUploadsGetOp {
+	return &v2ChunkedUploadsGetOp{op: s.s.ChunkedUploadsGet(chunkedUploadID)}
+}
+
+type v2ChunkedUploadsGetOp struct {
+	op  *v2envelopes.ChunkedUploadsGetOp
+	err error
+}
+
+func (o *v2ChunkedUploadsGetOp) Include(val ...string) ChunkedUploadsGetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2ChunkedUploadsGetOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ChunkedUploadResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ChunkedUploadsUpdate(chunkedUploadID string, chunkedUploadPartSeq string, chunkedUploadRequest *model.ChunkedUploadRequest) ChunkedUploadsUpdateOp {
+	o := &v2ChunkedUploadsUpdateOp{}
+	var v2chunkedUploadRequest *v2model.ChunkedUploadRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2chunkedUploadRequest, chunkedUploadRequest)
+	}
+	o.op = s.s.ChunkedUploadsUpdate(chunkedUploadID, chunkedUploadPartSeq, v2chunkedUploadRequest)
+	return o
+}
+
+type v2ChunkedUploadsUpdateOp struct {
+	op  *v2envelopes.ChunkedUploadsUpdateOp
+	err error
+}
+
+func (o *v2ChunkedUploadsUpdateOp) Do(ctx context.Context) (*model.ChunkedUploadResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ChunkedUploadResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) AttachmentsCreate(envelopeID string, envelopeAttachmentsRequest *model.EnvelopeAttachmentsRequest) AttachmentsCreateOp {
+	o := &v2AttachmentsCreateOp{}
+	var v2envelopeAttachmentsRequest *v2model.EnvelopeAttachmentsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeAttachmentsRequest, envelopeAttachmentsRequest)
+	}
+	o.op = s.s.AttachmentsCreate(envelopeID, v2envelopeAttachmentsRequest)
+	return o
+}
+
+type v2AttachmentsCreateOp struct {
+	op  *v2envelopes.AttachmentsCreateOp
+	err error
+}
+
+func (o *v2AttachmentsCreateOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeAttachmentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) AttachmentsDelete(envelopeID string, envelopeAttachmentsRequest *model.EnvelopeAttachmentsRequest) AttachmentsDeleteOp {
+	o := &v2AttachmentsDeleteOp{}
+	var v2envelopeAttachmentsRequest *v2model.EnvelopeAttachmentsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeAttachmentsRequest, envelopeAttachmentsRequest)
+	}
+	o.op = s.s.AttachmentsDelete(envelopeID, v2envelopeAttachmentsRequest)
+	return o
+}
+
+type v2AttachmentsDeleteOp struct {
+	op  *v2envelopes.AttachmentsDeleteOp
+	err error
+}
+
+func (o *v2AttachmentsDeleteOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeAttachmentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) AttachmentsGet(attachmentID string, envelopeID string) AttachmentsGetOp {
+	return &v2AttachmentsGetOp{op: s.s.AttachmentsGet(attachmentID, envelopeID)}
+}
+
+type v2AttachmentsGetOp struct {
+	op *v2envelopes.AttachmentsGetOp
+}
+
+func (o *v2AttachmentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) AttachmentsList(envelopeID string) AttachmentsListOp {
+	return &v2AttachmentsListOp{op: s.s.AttachmentsList(envelopeID)}
+}
+
+type v2AttachmentsListOp struct {
+	op  *v2envelopes.AttachmentsListOp
+	err error
+}
+
+func (o *v2AttachmentsListOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeAttachmentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) AttachmentsUpdate(attachmentID string, envelopeID string, attachment *model.Attachment) AttachmentsUpdateOp {
+	o := &v2AttachmentsUpdateOp{}
+	var v2attachment *v2model.Attachment
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2attachment, attachment)
+	}
+	o.op = s.s.AttachmentsUpdate(attachmentID, envelopeID, v2attachment)
+	return o
+}
+
+type v2AttachmentsUpdateOp struct {
+	op  *v2envelopes.AttachmentsUpdateOp
+	err error
+}
+
+func (o *v2AttachmentsUpdateOp) Do(ctx context.Context) (*model.EnvelopeAttachmentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeAttachmentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConsumerDisclosuresGet(envelopeID string, langCode string, recipientID string) ConsumerDisclosuresGetOp {
+	return &v2ConsumerDisclosuresGetOp{op: s.s.ConsumerDisclosuresGet(envelopeID, langCode, recipientID)}
+}
+
+type v2ConsumerDisclosuresGetOp struct {
+	op  *v2envelopes.ConsumerDisclosuresGetOp
+	err error
+}
+
+func (o *v2ConsumerDisclosuresGetOp) LangCode(val string) ConsumerDisclosuresGetOp {
+	o.op.LangCode(val)
+	return o
+}
+
+func (o *v2ConsumerDisclosuresGetOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConsumerDisclosure
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ConsumerDisclosuresGetDefault(envelopeID string, recipientID string) ConsumerDisclosuresGetDefaultOp {
+	return &v2ConsumerDisclosuresGetDefaultOp{op: s.s.ConsumerDisclosuresGetDefault(envelopeID, recipientID)}
+}
+
+type v2ConsumerDisclosuresGetDefaultOp struct {
+	op  *v2envelopes.ConsumerDisclosuresGetDefaultOp
+	err error
+}
+
+func (o *v2ConsumerDisclosuresGetDefaultOp) LangCode(val string) ConsumerDisclosuresGetDefaultOp {
+	o.op.LangCode(val)
+	return o
+}
+
+func (o *v2ConsumerDisclosuresGetDefaultOp) Do(ctx context.Context) (*model.ConsumerDisclosure, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ConsumerDisclosure
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsCreate(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsCreateOp {
+	o := &v2CustomFieldsCreateOp{}
+	var v2envelopeCustomFields *v2model.CustomFields
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeCustomFields, envelopeCustomFields)
+	}
+	o.op = s.s.CustomFieldsCreate(envelopeID, v2envelopeCustomFields)
+	return o
+}
+
+type v2CustomFieldsCreateOp struct {
+	op  *v2envelopes.CustomFieldsCreateOp
+	err error
+}
+
+func (o *v2CustomFieldsCreateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsDelete(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsDeleteOp {
+	o := &v2CustomFieldsDeleteOp{}
+	var v2envelopeCustomFields *v2model.CustomFields
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeCustomFields, envelopeCustomFields)
+	}
+	o.op = s.s.CustomFieldsDelete(envelopeID, v2envelopeCustomFields)
+	return o
+}
+
+type v2CustomFieldsDeleteOp struct {
+	op  *v2envelopes.CustomFieldsDeleteOp
+	err error
+}
+
+func (o *v2CustomFieldsDeleteOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsList(envelopeID string) CustomFieldsListOp {
+	return &v2CustomFieldsListOp{op: s.s.CustomFieldsList(envelopeID)}
+}
+
+type v2CustomFieldsListOp struct {
+	op  *v2envelopes.CustomFieldsListOp
+	err error
+}
+
+func (o *v2CustomFieldsListOp) Do(ctx context.Context) (*model.CustomFieldsEnvelope, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFieldsEnvelope
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsUpdate(envelopeID string, envelopeCustomFields *model.CustomFields) CustomFieldsUpdateOp {
+	o := &v2CustomFieldsUpdateOp{}
+	var v2envelopeCustomFields *v2model.CustomFields
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeCustomFields, envelopeCustomFields)
+	}
+	o.op = s.s.CustomFieldsUpdate(envelopeID, v2envelopeCustomFields)
+	return o
+}
+
+type v2CustomFieldsUpdateOp struct {
+	op  *v2envelopes.CustomFieldsUpdateOp
+	err error
+}
+
+func (o *v2CustomFieldsUpdateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsCreate(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsCreateOp {
+	o := &v2DocumentFieldsCreateOp{}
+	var v2envelopeDocumentFields *v2model.DocumentFieldsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDocumentFields, envelopeDocumentFields)
+	}
+	o.op = s.s.DocumentFieldsCreate(documentID, envelopeID, v2envelopeDocumentFields)
+	return o
+}
+
+type v2DocumentFieldsCreateOp struct {
+	op  *v2envelopes.DocumentFieldsCreateOp
+	err error
+}
+
+func (o *v2DocumentFieldsCreateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsDelete(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsDeleteOp {
+	o := &v2DocumentFieldsDeleteOp{}
+	var v2envelopeDocumentFields *v2model.DocumentFieldsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDocumentFields, envelopeDocumentFields)
+	}
+	o.op = s.s.DocumentFieldsDelete(documentID, envelopeID, v2envelopeDocumentFields)
+	return o
+}
+
+type v2DocumentFieldsDeleteOp struct {
+	op  *v2envelopes.DocumentFieldsDeleteOp
+	err error
+}
+
+func (o *v2DocumentFieldsDeleteOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsList(documentID string, envelopeID string) DocumentFieldsListOp {
+	return &v2DocumentFieldsListOp{op: s.s.DocumentFieldsList(documentID, envelopeID)}
+}
+
+type v2DocumentFieldsListOp struct {
+	op  *v2envelopes.DocumentFieldsListOp
+	err error
+}
+
+func (o *v2DocumentFieldsListOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsUpdate(documentID string, envelopeID string, envelopeDocumentFields *model.DocumentFieldsInformation) DocumentFieldsUpdateOp {
+	o := &v2DocumentFieldsUpdateOp{}
+	var v2envelopeDocumentFields *v2model.DocumentFieldsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDocumentFields, envelopeDocumentFields)
+	}
+	o.op = s.s.DocumentFieldsUpdate(documentID, envelopeID, v2envelopeDocumentFields)
+	return o
+}
+
+type v2DocumentFieldsUpdateOp struct {
+	op  *v2envelopes.DocumentFieldsUpdateOp
+	err error
+}
+
+func (o *v2DocumentFieldsUpdateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentTabsGet(documentID string, envelopeID string) DocumentTabsGetOp {
+	return &v2DocumentTabsGetOp{op: s.s.DocumentTabsGet(documentID, envelopeID)}
+}
+
+type v2DocumentTabsGetOp struct {
+	op  *v2envelopes.DocumentTabsGetOp
+	err error
+}
+
+func (o *v2DocumentTabsGetOp) PageNumbers(val string) DocumentTabsGetOp {
+	o.op.PageNumbers(val)
+	return o
+}
+
+func (o *v2DocumentTabsGetOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentTabsGetByPage(documentID string, envelopeID string, pageNumber string) DocumentTabsGetByPageOp {
+	return &v2DocumentTabsGetByPageOp{op: s.s.DocumentTabsGetByPage(documentID, envelopeID, pageNumber)}
+}
+
+type v2DocumentTabsGetByPageOp struct {
+	op  *v2envelopes.DocumentTabsGetByPageOp
+	err error
+}
+
+func (o *v2DocumentTabsGetByPageOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentVisibilityGet(envelopeID string, recipientID string) DocumentVisibilityGetOp {
+	return &v2DocumentVisibilityGetOp{op: s.s.DocumentVisibilityGet(envelopeID, recipientID)}
+}
+
+type v2DocumentVisibilityGetOp struct {
+	op  *v2envelopes.DocumentVisibilityGetOp
+	err error
+}
+
+func (o *v2DocumentVisibilityGetOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentVisibilityList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentVisibilityUpdate(envelopeID string, recipientID string, envelopeDocumentVisibility *model.DocumentVisibilityList) DocumentVisibilityUpdateOp {
+	o := &v2DocumentVisibilityUpdateOp{}
+	var v2envelopeDocumentVisibility *v2model.DocumentVisibilityList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDocumentVisibility, envelopeDocumentVisibility)
+	}
+	o.op = s.s.DocumentVisibilityUpdate(envelopeID, recipientID, v2envelopeDocumentVisibility)
+	return o
+}
+
+type v2DocumentVisibilityUpdateOp struct {
+	op  *v2envelopes.DocumentVisibilityUpdateOp
+	err error
+}
+
+func (o *v2DocumentVisibilityUpdateOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentVisibilityList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentVisibilityUpdateList(envelopeID string, envelopeDocumentVisibility *model.DocumentVisibilityList) DocumentVisibilityUpdateListOp {
+	o := &v2DocumentVisibilityUpdateListOp{}
+	var v2envelopeDocumentVisibility *v2model.DocumentVisibilityList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDocumentVisibility, envelopeDocumentVisibility)
+	}
+	o.op = s.s.DocumentVisibilityUpdateList(envelopeID, v2envelopeDocumentVisibility)
+	return o
+}
+
+type v2DocumentVisibilityUpdateListOp struct {
+	op  *v2envelopes.DocumentVisibilityUpdateListOp
+	err error
+}
+
+func (o *v2DocumentVisibilityUpdateListOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentVisibilityList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsDelete(envelopeID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsDeleteOp {
+	o := &v2DocumentsDeleteOp{}
+	var v2envelopeDefinition *v2model.EnvelopeDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDefinition, envelopeDefinition)
+	}
+	o.op = s.s.DocumentsDelete(envelopeID, v2envelopeDefinition)
+	return o
+}
+
+type v2DocumentsDeleteOp struct {
+	op  *v2envelopes.DocumentsDeleteOp
+	err error
+}
+
+func (o *v2DocumentsDeleteOp) Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeDocumentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsGet(documentID string, envelopeID string) DocumentsGetOp {
+	return &v2DocumentsGetOp{op: s.s.DocumentsGet(documentID, envelopeID)}
+}
+
+type v2DocumentsGetOp struct {
+	op *v2envelopes.DocumentsGetOp
+}
+
+func (o *v2DocumentsGetOp) Certificate() DocumentsGetOp {
+	o.op.Certificate()
+	return o
+}
+
+func (o *v2DocumentsGetOp) Encoding(val string) DocumentsGetOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v2DocumentsGetOp) Encrypt() DocumentsGetOp {
+	o.op.Encrypt()
+	return o
+}
+
+func (o *v2DocumentsGetOp) Language(val string) DocumentsGetOp {
+	o.op.Language(val)
+	return o
+}
+
+func (o *v2DocumentsGetOp) RecipientID(val string) DocumentsGetOp {
+	o.op.RecipientID(val)
+	return o
+}
+
+func (o *v2DocumentsGetOp) ShowChanges() DocumentsGetOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v2DocumentsGetOp) Watermark() DocumentsGetOp {
+	o.op.Watermark()
+	return o
+}
+
+func (o *v2DocumentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) DocumentsList(envelopeID string) DocumentsListOp {
+	return &v2DocumentsListOp{op: s.s.DocumentsList(envelopeID)}
+}
+
+type v2DocumentsListOp struct {
+	op  *v2envelopes.DocumentsListOp
+	err error
+}
+
+func (o *v2DocumentsListOp) Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeDocumentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsUpdateList(envelopeID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateListOp {
+	o := &v2DocumentsUpdateListOp{}
+	var v2envelopeDefinition *v2model.EnvelopeDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDefinition, envelopeDefinition)
+	}
+	o.op = s.s.DocumentsUpdateList(envelopeID, v2envelopeDefinition)
+	return o
+}
+
+type v2DocumentsUpdateListOp struct {
+	op  *v2envelopes.DocumentsUpdateListOp
+	err error
+}
+
+func (o *v2DocumentsUpdateListOp) Do(ctx context.Context) (*model.EnvelopeDocumentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeDocumentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EmailSettingsCreate(envelopeID string, envelopeEmailSettings *model.EmailSettings) EmailSettingsCreateOp {
+	o := &v2EmailSettingsCreateOp{}
+	var v2envelopeEmailSettings *v2model.EmailSettings
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeEmailSettings, envelopeEmailSettings)
+	}
+	o.op = s.s.EmailSettingsCreate(envelopeID, v2envelopeEmailSettings)
+	return o
+}
+
+type v2EmailSettingsCreateOp struct {
+	op  *v2envelopes.EmailSettingsCreateOp
+	err error
+}
+
+func (o *v2EmailSettingsCreateOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EmailSettings
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EmailSettingsDelete(envelopeID string) EmailSettingsDeleteOp {
+	return &v2EmailSettingsDeleteOp{op: s.s.EmailSettingsDelete(envelopeID)}
+}
+
+type v2EmailSettingsDeleteOp struct {
+	op  *v2envelopes.EmailSettingsDeleteOp
+	err error
+}
+
+func (o *v2EmailSettingsDeleteOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EmailSettings
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EmailSettingsGet(envelopeID string) EmailSettingsGetOp {
+	return &v2EmailSettingsGetOp{op: s.s.EmailSettingsGet(envelopeID)}
+}
+
+type v2EmailSettingsGetOp struct {
+	op  *v2envelopes.EmailSettingsGetOp
+	err error
+}
+
+func (o *v2EmailSettingsGetOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EmailSettings
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EmailSettingsUpdate(envelopeID string, envelopeEmailSettings *model.EmailSettings) EmailSettingsUpdateOp {
+	o := &v2EmailSettingsUpdateOp{}
+	var v2envelopeEmailSettings *v2model.EmailSettings
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeEmailSettings, envelopeEmailSettings)
+	}
+	o.op = s.s.EmailSettingsUpdate(envelopeID, v2envelopeEmailSettings)
+	return o
+}
+
+type v2EmailSettingsUpdateOp struct {
+	op  *v2envelopes.EmailSettingsUpdateOp
+	err error
+}
+
+func (o *v2EmailSettingsUpdateOp) Do(ctx context.Context) (*model.EmailSettings, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EmailSettings
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) FormDataGet(envelopeID string) FormDataGetOp {
+	return &v2FormDataGetOp{op: s.s.FormDataGet(envelopeID)}
+}
+
+type v2FormDataGetOp struct {
+	op  *v2envelopes.FormDataGetOp
+	err error
+}
+
+func (o *v2FormDataGetOp) Do(ctx context.Context) (*model.EnvelopeFormData, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeFormData
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksCreate(envelopeID string, lockRequest *model.LockRequest) LocksCreateOp {
+	o := &v2LocksCreateOp{}
+	var v2lockRequest *v2model.LockRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2lockRequest, lockRequest)
+	}
+	o.op = s.s.LocksCreate(envelopeID, v2lockRequest)
+	return o
+}
+
+type v2LocksCreateOp struct {
+	op  *v2envelopes.LocksCreateOp
+	err error
+}
+
+func (o *v2LocksCreateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksDelete(envelopeID string) LocksDeleteOp {
+	return &v2LocksDeleteOp{op: s.s.LocksDelete(envelopeID)}
+}
+
+type v2LocksDeleteOp struct {
+	op  *v2envelopes.LocksDeleteOp
+	err error
+}
+
+func (o *v2LocksDeleteOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksGet(envelopeID string) LocksGetOp {
+	return &v2LocksGetOp{op: s.s.LocksGet(envelopeID)}
+}
+
+type v2LocksGetOp struct {
+	op  *v2envelopes.LocksGetOp
+	err error
+}
+
+func (o *v2LocksGetOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksUpdate(envelopeID string, lockRequest *model.LockRequest) LocksUpdateOp {
+	o := &v2LocksUpdateOp{}
+	var v2lockRequest *v2model.LockRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2lockRequest, lockRequest)
+	}
+	o.op = s.s.LocksUpdate(envelopeID, v2lockRequest)
+	return o
+}
+
+type v2LocksUpdateOp struct {
+	op  *v2envelopes.LocksUpdateOp
+	err error
+}
+
+func (o *v2LocksUpdateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsCreate(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsCreateOp {
+	o := &v2RecipientTabsCreateOp{}
+	var v2envelopeRecipientTabs *v2model.Tabs
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeRecipientTabs, envelopeRecipientTabs)
+	}
+	o.op = s.s.RecipientTabsCreate(envelopeID, recipientID, v2envelopeRecipientTabs)
+	return o
+}
+
+type v2RecipientTabsCreateOp struct {
+	op  *v2envelopes.RecipientTabsCreateOp
+	err error
+}
+
+func (o *v2RecipientTabsCreateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsDelete(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsDeleteOp {
+	o := &v2RecipientTabsDeleteOp{}
+	var v2envelopeRecipientTabs *v2model.Tabs
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeRecipientTabs, envelopeRecipientTabs)
+	}
+	o.op = s.s.RecipientTabsDelete(envelopeID, recipientID, v2envelopeRecipientTabs)
+	return o
+}
+
+type v2RecipientTabsDeleteOp struct {
+	op  *v2envelopes.RecipientTabsDeleteOp
+	err error
+}
+
+func (o *v2RecipientTabsDeleteOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsList(envelopeID string, recipientID string) RecipientTabsListOp {
+	return &v2RecipientTabsListOp{op: s.s.RecipientTabsList(envelopeID, recipientID)}
+}
+
+type v2RecipientTabsListOp struct {
+	op  *v2envelopes.RecipientTabsListOp
+	err error
+}
+
+func (o *v2RecipientTabsListOp) IncludeAnchorTabLocations() RecipientTabsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v2RecipientTabsListOp) IncludeMetadata(val string) RecipientTabsListOp {
+	o.op.IncludeMetadata(val)
+	return o
+}
+
+func (o *v2RecipientTabsListOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsUpdate(envelopeID string, recipientID string, envelopeRecipientTabs *model.Tabs) RecipientTabsUpdateOp {
+	o := &v2RecipientTabsUpdateOp{}
+	var v2envelopeRecipientTabs *v2model.Tabs
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeRecipientTabs, envelopeRecipientTabs)
+	}
+	o.op = s.s.RecipientTabsUpdate(envelopeID, recipientID, v2envelopeRecipientTabs)
+	return o
+}
+
+type v2RecipientTabsUpdateOp struct {
+	op  *v2envelopes.RecipientTabsUpdateOp
+	err error
+}
+
+func (o *v2RecipientTabsUpdateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsCreate(envelopeID string, envelopeRecipients *model.Recipients) RecipientsCreateOp {
+	o := &v2RecipientsCreateOp{}
+	var v2envelopeRecipients *v2model.Recipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeRecipients, envelopeRecipients)
+	}
+	o.op = s.s.RecipientsCreate(envelopeID, v2envelopeRecipients)
+	return o
+}
+
+type v2RecipientsCreateOp struct {
+	op  *v2envelopes.RecipientsCreateOp
+	err error
+}
+
+func (o *v2RecipientsCreateOp) ResendEnvelope() RecipientsCreateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v2RecipientsCreateOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsDelete(envelopeID string, recipientID string) RecipientsDeleteOp {
+	return &v2RecipientsDeleteOp{op: s.s.RecipientsDelete(envelopeID, recipientID)}
+}
+
+type v2RecipientsDeleteOp struct {
+	op  *v2envelopes.RecipientsDeleteOp
+	err error
+}
+
+func (o *v2RecipientsDeleteOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsDeleteList(envelopeID string, envelopeRecipients *model.Recipients) RecipientsDeleteListOp {
+	o := &v2RecipientsDeleteListOp{}
+	var v2envelopeRecipients *v2model.Recipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeRecipients, envelopeRecipients)
+	}
+	o.op = s.s.RecipientsDeleteList(envelopeID, v2envelopeRecipients)
+	return o
+}
+
+type v2RecipientsDeleteListOp struct {
+	op  *v2envelopes.RecipientsDeleteListOp
+	err error
+}
+
+func (o *v2RecipientsDeleteListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsList(envelopeID string) RecipientsListOp {
+	return &v2RecipientsListOp{op: s.s.RecipientsList(envelopeID)}
+}
+
+type v2RecipientsListOp struct {
+	op  *v2envelopes.RecipientsListOp
+	err error
+}
+
+func (o *v2RecipientsListOp) IncludeAnchorTabLocations() RecipientsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v2RecipientsListOp) IncludeExtended() RecipientsListOp {
+	o.op.IncludeExtended()
+	return o
+}
+
+func (o *v2RecipientsListOp) IncludeTabs() RecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v2RecipientsListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsUpdate(envelopeID string, envelopeRecipients *model.Recipients) RecipientsUpdateOp {
+	o := &v2RecipientsUpdateOp{}
+	var v2envelopeRecipients *v2model.Recipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeRecipients, envelopeRecipients)
+	}
+	o.op = s.s.RecipientsUpdate(envelopeID, v2envelopeRecipients)
+	return o
+}
+
+type v2RecipientsUpdateOp struct {
+	op  *v2envelopes.RecipientsUpdateOp
+	err error
+}
+
+func (o *v2RecipientsUpdateOp) OfflineSigning(val string) RecipientsUpdateOp {
+	o.op.OfflineSigning(val)
+	return o
+}
+
+func (o *v2RecipientsUpdateOp) ResendEnvelope() RecipientsUpdateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v2RecipientsUpdateOp) Do(ctx context.Context) (*model.RecipientsUpdateSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.RecipientsUpdateSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplatesApply(envelopeID string, documentTemplateList *model.DocumentTemplateList) TemplatesApplyOp {
+	o := &v2TemplatesApplyOp{}
+	var v2documentTemplateList *v2model.DocumentTemplateList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2documentTemplateList, documentTemplateList)
+	}
+	o.op = s.s.TemplatesApply(envelopeID, v2documentTemplateList)
+	return o
+}
+
+type v2TemplatesApplyOp struct {
+	op  *v2envelopes.TemplatesApplyOp
+	err error
+}
+
+func (o *v2TemplatesApplyOp) Do(ctx context.Context) (*model.DocumentTemplateList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentTemplateList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplatesApplyToDocument(documentID string, envelopeID string, documentTemplateList *model.DocumentTemplateList) TemplatesApplyToDocumentOp {
+	o := &v2TemplatesApplyToDocumentOp{}
+	var v2documentTemplateList *v2model.DocumentTemplateList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2documentTemplateList, documentTemplateList)
+	}
+	o.op = s.s.TemplatesApplyToDocument(documentID, envelopeID, v2documentTemplateList)
+	return o
+}
+
+type v2TemplatesApplyToDocumentOp struct {
+	op  *v2envelopes.TemplatesApplyToDocumentOp
+	err error
+}
+
+func (o *v2TemplatesApplyToDocumentOp) Do(ctx context.Context) (*model.DocumentTemplateList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentTemplateList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplatesDelete(documentID string, envelopeID string, templateID string) TemplatesDeleteOp {
+	return &v2TemplatesDeleteOp{op: s.s.TemplatesDelete(documentID, envelopeID, templateID)}
+}
+
+type v2TemplatesDeleteOp struct {
+	op *v2envelopes.TemplatesDeleteOp
+}
+
+func (o *v2TemplatesDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) TemplatesList(envelopeID string) TemplatesListOp {
+	return &v2TemplatesListOp{op: s.s.TemplatesList(envelopeID)}
+}
+
+type v2TemplatesListOp struct {
+	op  *v2envelopes.TemplatesListOp
+	err error
+}
+
+func (o *v2TemplatesListOp) Include(val string) TemplatesListOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v2TemplatesListOp) Do(ctx context.Context) (*model.TemplateInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplatesListByDocument(documentID string, envelopeID string) TemplatesListByDocumentOp {
+	return &v2TemplatesListByDocumentOp{op: s.s.TemplatesListByDocument(documentID, envelopeID)}
+}
+
+type v2TemplatesListByDocumentOp struct {
+	op  *v2envelopes.TemplatesListByDocumentOp
+	err error
+}
+
+func (o *v2TemplatesListByDocumentOp) Include(val ...string) TemplatesListByDocumentOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2TemplatesListByDocumentOp) Do(ctx context.Context) (*model.TemplateInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateConsole(consoleViewRequest *model.ConsoleViewRequest) ViewsCreateConsoleOp {
+	o := &v2ViewsCreateConsoleOp{}
+	var v2consoleViewRequest *v2model.ConsoleViewRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2consoleViewRequest, consoleViewRequest)
+	}
+	o.op = s.s.ViewsCreateConsole(v2consoleViewRequest)
+	return o
+}
+
+type v2ViewsCreateConsoleOp struct {
+	op  *v2envelopes.ViewsCreateConsoleOp
+	err error
+}
+
+func (o *v2ViewsCreateConsoleOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateCorrect(envelopeID string, correctViewRequest *model.CorrectViewRequest) ViewsCreateCorrectOp {
+	o := &v2ViewsCreateCorrectOp{}
+	var v2correctViewRequest *v2model.CorrectViewRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2correctViewRequest, correctViewRequest)
+	}
+	o.op = s.s.ViewsCreateCorrect(envelopeID, v2correctViewRequest)
+	return o
+}
+
+type v2ViewsCreateCorrectOp struct {
+	op  *v2envelopes.ViewsCreateCorrectOp
+	err error
+}
+
+func (o *v2ViewsCreateCorrectOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateEdit(envelopeID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateEditOp {
+	o := &v2ViewsCreateEditOp{}
+	var v2returnURLRequest *v2model.ReturnURLRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2returnURLRequest, returnURLRequest)
+	}
+	o.op = s.s.ViewsCreateEdit(envelopeID, v2returnURLRequest)
+	return o
+}
+
+type v2ViewsCreateEditOp struct {
+	op  *v2envelopes.ViewsCreateEditOp
+	err error
+}
+
+func (o *v2ViewsCreateEditOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateRecipient(envelopeID string, recipientViewRequest *model.RecipientViewRequest) ViewsCreateRecipientOp {
+	o := &v2ViewsCreateRecipientOp{}
+	var v2recipientViewRequest *v2model.RecipientViewRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2recipientViewRequest, recipientViewRequest)
+	}
+	o.op = s.s.ViewsCreateRecipient(envelopeID, v2recipientViewRequest)
+	return o
+}
+
+type v2ViewsCreateRecipientOp struct {
+	op  *v2envelopes.ViewsCreateRecipientOp
+	err error
+}
+
+func (o *v2ViewsCreateRecipientOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateSender(envelopeID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateSenderOp {
+	o := &v2ViewsCreateSenderOp{}
+	var v2returnURLRequest *v2model.ReturnURLRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2returnURLRequest, returnURLRequest)
+	}
+	o.op = s.s.ViewsCreateSender(envelopeID, v2returnURLRequest)
+	return o
+}
+
+type v2ViewsCreateSenderOp struct {
+	op  *v2envelopes.ViewsCreateSenderOp
+	err error
+}
+
+func (o *v2ViewsCreateSenderOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(envelopeDefinition *model.EnvelopeDefinition, uploads ...*esign.UploadFile) CreateOp {
+	o := &v2CreateOp{}
+	var v2envelopeDefinition *v2model.EnvelopeDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDefinition, envelopeDefinition)
+	}
+	o.op = s.s.Create(v2envelopeDefinition, uploads...)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2envelopes.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) CdseMode(val string) CreateOp {
+	o.op.CdseMode(val)
+	return o
+}
+
+func (o *v2CreateOp) ChangeRoutingOrder() CreateOp {
+	o.op.ChangeRoutingOrder()
+	return o
+}
+
+func (o *v2CreateOp) CompletedDocumentsOnly(val string) CreateOp {
+	o.op.CompletedDocumentsOnly(val)
+	return o
+}
+
+func (o *v2CreateOp) MergeRolesOnDraft() CreateOp {
+	o.op.MergeRolesOnDraft()
+	return o
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.EnvelopeSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DeleteDocumentPage(documentID string, envelopeID string, pageNumber string) DeleteDocumentPageOp {
+	return &v2DeleteDocumentPageOp{op: s.s.DeleteDocumentPage(documentID, envelopeID, pageNumber)}
+}
+
+type v2DeleteDocumentPageOp struct {
+	op *v2envelopes.DeleteDocumentPageOp
+}
+
+func (o *v2DeleteDocumentPageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) Get(envelopeID string) GetOp {
+	return &v2GetOp{op: s.s.Get(envelopeID)}
+}
+
+type v2GetOp struct {
+	op  *v2envelopes.GetOp
+	err error
+}
+
+func (o *v2GetOp) AdvancedUpdate() GetOp {
+	o.op.AdvancedUpdate()
+	return o
+}
+
+func (o *v2GetOp) Include(val string) GetOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.Envelope, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Envelope
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetNotificationSettings(envelopeID string) GetNotificationSettingsOp {
+	return &v2GetNotificationSettingsOp{op: s.s.GetNotificationSettings(envelopeID)}
+}
+
+type v2GetNotificationSettingsOp struct {
+	op  *v2envelopes.GetNotificationSettingsOp
+	err error
+}
+
+func (o *v2GetNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Notification
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetPageImage(documentID string, envelopeID string, pageNumber string) GetPageImageOp {
+	return &v2GetPageImageOp{op: s.s.GetPageImage(documentID, envelopeID, pageNumber)}
+}
+
+type v2GetPageImageOp struct {
+	op *v2envelopes.GetPageImageOp
+}
+
+func (o *v2GetPageImageOp) Dpi(val int) GetPageImageOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v2GetPageImageOp) MaxHeight(val int) GetPageImageOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v2GetPageImageOp) MaxWidth(val int) GetPageImageOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v2GetPageImageOp) ShowChanges() GetPageImageOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v2GetPageImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) GetPageImages(documentID string, envelopeID string) GetPageImagesOp {
+	return &v2GetPageImagesOp{op: s.s.GetPageImages(documentID, envelopeID)}
+}
+
+type v2GetPageImagesOp struct {
+	op  *v2envelopes.GetPageImagesOp
+	err error
+}
+
+func (o *v2GetPageImagesOp) Count(val int) GetPageImagesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) Dpi(val int) GetPageImagesOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) MaxHeight(val int) GetPageImagesOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) MaxWidth(val int) GetPageImagesOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) Nocache() GetPageImagesOp {
+	o.op.Nocache()
+	return o
+}
+
+func (o *v2GetPageImagesOp) ShowChanges() GetPageImagesOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v2GetPageImagesOp) StartPosition(val int) GetPageImagesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PageImages
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetRecipientInitialsImage(envelopeID string, recipientID string) GetRecipientInitialsImageOp {
+	return &v2GetRecipientInitialsImageOp{op: s.s.GetRecipientInitialsImage(envelopeID, recipientID)}
+}
+
+type v2GetRecipientInitialsImageOp struct {
+	op *v2envelopes.GetRecipientInitialsImageOp
+}
+
+func (o *v2GetRecipientInitialsImageOp) IncludeChrome() GetRecipientInitialsImageOp {
+	o.op.IncludeChrome()
+	return o
+}
+
+func (o *v2GetRecipientInitialsImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) GetRecipientSignature(envelopeID string, recipientID string) GetRecipientSignatureOp {
+	return &v2GetRecipientSignatureOp{op: s.s.GetRecipientSignature(envelopeID, recipientID)}
+}
+
+type v2GetRecipientSignatureOp struct {
+	op  *v2envelopes.GetRecipientSignatureOp
+	err error
+}
+
+func (o *v2GetRecipientSignatureOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignature
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetRecipientSignatureImage(envelopeID string, recipientID string) GetRecipientSignatureImageOp {
+	return &v2GetRecipientSignatureImageOp{op: s.s.GetRecipientSignatureImage(envelopeID, recipientID)}
+}
+
+type v2GetRecipientSignatureImageOp struct {
+	op *v2envelopes.GetRecipientSignatureImageOp
+}
+
+func (o *v2GetRecipientSignatureImageOp) IncludeChrome() GetRecipientSignatureImageOp {
+	o.op.IncludeChrome()
+	return o
+}
+
+func (o *v2GetRecipientSignatureImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) ListAuditEvents(envelopeID string) ListAuditEventsOp {
+	return &v2ListAuditEventsOp{op: s.s.ListAuditEvents(envelopeID)}
+}
+
+type v2ListAuditEventsOp struct {
+	op  *v2envelopes.ListAuditEventsOp
+	err error
+}
+
+func (o *v2ListAuditEventsOp) Do(ctx context.Context) (*model.EnvelopeAuditEventResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeAuditEventResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListStatus(envelopeIdsRequest *model.EnvelopeIdsRequest) ListStatusOp {
+	o := &v2ListStatusOp{}
+	var v2envelopeIdsRequest *v2model.EnvelopeIdsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeIdsRequest, envelopeIdsRequest)
+	}
+	o.op = s.s.ListStatus(v2envelopeIdsRequest)
+	return o
+}
+
+type v2ListStatusOp struct {
+	op  *v2envelopes.ListStatusOp
+	err error
+}
+
+func (o *v2ListStatusOp) AcStatus(val string) ListStatusOp {
+	o.op.AcStatus(val)
+	return o
+}
+
+func (o *v2ListStatusOp) Block(val string) ListStatusOp {
+	o.op.Block(val)
+	return o
+}
+
+func (o *v2ListStatusOp) Count(val string) ListStatusOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListStatusOp) Email(val string) ListStatusOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v2ListStatusOp) EnvelopeIds(val string) ListStatusOp {
+	o.op.EnvelopeIds(val)
+	return o
+}
+
+func (o *v2ListStatusOp) FromDate(val time.Time) ListStatusOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2ListStatusOp) FromToStatus(val string) ListStatusOp {
+	o.op.FromToStatus(val)
+	return o
+}
+
+func (o *v2ListStatusOp) StartPosition(val int) ListStatusOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListStatusOp) Status(val string) ListStatusOp {
+	o.op.Status(val)
+	return o
+}
+
+func (o *v2ListStatusOp) ToDate(val time.Time) ListStatusOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2ListStatusOp) TransactionIds(val string) ListStatusOp {
+	o.op.TransactionIds(val)
+	return o
+}
+
+func (o *v2ListStatusOp) UserName(val string) ListStatusOp {
+	o.op.UserName(val)
+	return o
+}
+
+func (o *v2ListStatusOp) Do(ctx context.Context) (*model.EnvelopesInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopesInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListStatusChanges() ListStatusChangesOp {
+	return &v2ListStatusChangesOp{op: s.s.ListStatusChanges()}
+}
+
+type v2ListStatusChangesOp struct {
+	op  *v2envelopes.ListStatusChangesOp
+	err error
+}
+
+func (o *v2ListStatusChangesOp) AcStatus(val string) ListStatusChangesOp {
+	o.op.AcStatus(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) Block(val string) ListStatusChangesOp {
+	o.op.Block(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) Count(val int) ListStatusChangesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) CustomField(val string) ListStatusChangesOp {
+	o.op.CustomField(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) Email(val string) ListStatusChangesOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) EnvelopeIds(val ...string) ListStatusChangesOp {
+	o.op.EnvelopeIds(val...)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) FromDate(val time.Time) ListStatusChangesOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) FromToStatus(val string) ListStatusChangesOp {
+	o.op.FromToStatus(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) StartPosition(val int) ListStatusChangesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) Status(val ...string) ListStatusChangesOp {
+	o.op.Status(val...)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) ToDate(val time.Time) ListStatusChangesOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) TransactionIds(val ...string) ListStatusChangesOp {
+	o.op.TransactionIds(val...)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) UserName(val string) ListStatusChangesOp {
+	o.op.UserName(val)
+	return o
+}
+
+func (o *v2ListStatusChangesOp) Do(ctx context.Context) (*model.EnvelopesInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopesInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RotateDocumentPage(documentID string, envelopeID string, pageNumber string, pageRequest *model.PageRequest) RotateDocumentPageOp {
+	o := &v2RotateDocumentPageOp{}
+	var v2pageRequest *v2model.PageRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2pageRequest, pageRequest)
+	}
+	o.op = s.s.RotateDocumentPage(documentID, envelopeID, pageNumber, v2pageRequest)
+	return o
+}
+
+type v2RotateDocumentPageOp struct {
+	op  *v2envelopes.RotateDocumentPageOp
+	err error
+}
+
+func (o *v2RotateDocumentPageOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) Update(envelopeID string, envelopes *model.Envelope) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2envelopes *v2model.Envelope
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopes, envelopes)
+	}
+	o.op = s.s.Update(envelopeID, v2envelopes)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2envelopes.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) AdvancedUpdate() UpdateOp {
+	o.op.AdvancedUpdate()
+	return o
+}
+
+func (o *v2UpdateOp) ResendEnvelope() UpdateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.EnvelopeUpdateSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeUpdateSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateNotificationSettings(envelopeID string, envelopeNotificationRequest *model.EnvelopeNotificationRequest) UpdateNotificationSettingsOp {
+	o := &v2UpdateNotificationSettingsOp{}
+	var v2envelopeNotificationRequest *v2model.EnvelopeNotificationRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeNotificationRequest, envelopeNotificationRequest)
+	}
+	o.op = s.s.UpdateNotificationSettings(envelopeID, v2envelopeNotificationRequest)
+	return o
+}
+
+type v2UpdateNotificationSettingsOp struct {
+	op  *v2envelopes.UpdateNotificationSettingsOp
+	err error
+}
+
+func (o *v2UpdateNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Notification
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateRecipientInitialsImage(envelopeID string, recipientID string, media io.Reader, mimeType string) UpdateRecipientInitialsImageOp {
+	return &v2UpdateRecipientInitialsImageOp{op: s.s.UpdateRecipientInitialsImage(envelopeID, recipientID, media, mimeType)}
+}
+
+type v2UpdateRecipientInitialsImageOp struct {
+	op *v2envelopes.UpdateRecipientInitialsImageOp
+}
+
+func (o *v2UpdateRecipientInitialsImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) UpdateRecipientSignatureImage(envelopeID string, recipientID string, media io.Reader, mimeType string) UpdateRecipientSignatureImageOp {
+	return &v2UpdateRecipientSignatureImageOp{op: s.s.UpdateRecipientSignatureImage(envelopeID, recipientID, media, mimeType)}
+}
+
+type v2UpdateRecipientSignatureImageOp struct {
+	op *v2envelopes.UpdateRecipientSignatureImageOp
+}
+
+func (o *v2UpdateRecipientSignatureImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) TemplateDocumentVisibilityGet(recipientID string, templateID string) TemplateDocumentVisibilityGetOp {
+	return &v2TemplateDocumentVisibilityGetOp{op: s.s.TemplateDocumentVisibilityGet(recipientID, templateID)}
+}
+
+type v2TemplateDocumentVisibilityGetOp struct {
+	op  *v2envelopes.TemplateDocumentVisibilityGetOp
+	err error
+}
+
+func (o *v2TemplateDocumentVisibilityGetOp) Do(ctx context.Context) (*model.DocumentVisibilityList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentVisibilityList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplateDocumentVisibilityUpdate(recipientID string, templateID string, templateDocumentVisibility *model.TemplateDocumentVisibilityList) TemplateDocumentVisibilityUpdateOp {
+	o := &v2TemplateDocumentVisibilityUpdateOp{}
+	var v2templateDocumentVisibility *v2model.TemplateDocumentVisibilityList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateDocumentVisibility, templateDocumentVisibility)
+	}
+	o.op = s.s.TemplateDocumentVisibilityUpdate(recipientID, templateID, v2templateDocumentVisibility)
+	return o
+}
+
+type v2TemplateDocumentVisibilityUpdateOp struct {
+	op  *v2envelopes.TemplateDocumentVisibilityUpdateOp
+	err error
+}
+
+func (o *v2TemplateDocumentVisibilityUpdateOp) Do(ctx context.Context) (*model.TemplateDocumentVisibilityList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateDocumentVisibilityList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplateDocumentVisibilityUpdateList(templateID string, templateDocumentVisibility *model.TemplateDocumentVisibilityList) TemplateDocumentVisibilityUpdateListOp {
+	o := &v2TemplateDocumentVisibilityUpdateListOp{}
+	var v2templateDocumentVisibility *v2model.TemplateDocumentVisibilityList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateDocumentVisibility, templateDocumentVisibility)
+	}
+	o.op = s.s.TemplateDocumentVisibilityUpdateList(templateID, v2templateDocumentVisibility)
+	return o
+}
+
+type v2TemplateDocumentVisibilityUpdateListOp struct {
+	op  *v2envelopes.TemplateDocumentVisibilityUpdateListOp
+	err error
+}
+
+func (o *v2TemplateDocumentVisibilityUpdateListOp) Do(ctx context.Context) (*model.TemplateDocumentVisibilityList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateDocumentVisibilityList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) NotaryJournalsList() NotaryJournalsListOp {
+	return &v2NotaryJournalsListOp{op: s.s.NotaryJournalsList()}
+}
+
+type v2NotaryJournalsListOp struct {
+	op  *v2envelopes.NotaryJournalsListOp
+	err error
+}
+
+func (o *v2NotaryJournalsListOp) Count(val string) NotaryJournalsListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2NotaryJournalsListOp) SearchText(val string) NotaryJournalsListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2NotaryJournalsListOp) StartPosition(val string) NotaryJournalsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2NotaryJournalsListOp) Do(ctx context.Context) (*model.NotaryJournalList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.NotaryJournalList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateSharedRecipient(envelopeID string, recipientViewRequest *model.RecipientViewRequest) ViewsCreateSharedRecipientOp {
+	o := &v2ViewsCreateSharedRecipientOp{}
+	var v2recipientViewRequest *v2model.RecipientViewRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2recipientViewRequest, recipientViewRequest)
+	}
+	o.op = s.s.ViewsCreateSharedRecipient(envelopeID, v2recipientViewRequest)
+	return o
+}
+
+type v2ViewsCreateSharedRecipientOp struct {
+	op  *v2envelopes.ViewsCreateSharedRecipientOp
+	err error
+}
+
+func (o *v2ViewsCreateSharedRecipientOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -0,0 +1,433 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package folders defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 folders services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/folders"
+//	)
+//	...
+//	foldersService := folders.New(esignCredential, cfg.UseV2)
+package folders // import "github.com/jfcote87/esign/compat/folders"
+
+import (
+	"context"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/folders"
+	"github.com/jfcote87/esign/v2.1/model"
+	v2folders "github.com/jfcote87/esign/v2/folders"
+	v2model "github.com/jfcote87/esign/v2/model"
+)
+
+// Service contains the ops shared by the v2 and v2.1 folders services.
+type Service interface {
+	// List gets a list of the folders for the account.
+	List() ListOp
+	// ListItems gets a list of the envelopes in the specified folder.
+	ListItems(folderID string) ListItemsOp
+	// MoveEnvelopes moves an envelope from its current folder to the specified folder.
+	MoveEnvelopes(folderID string, foldersRequest *model.FoldersRequest) MoveEnvelopesOp
+	// Search gets a list of envelopes in folders matching the specified criteria.
+	Search(searchFolderID string) SearchOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2folders.New(cred)}
+	}
+	return v21Service{s: folders.New(cred)}
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	Include(val string) ListOp
+	StartPosition(val int) ListOp
+	Template(val string) ListOp
+	UserFilter(val string) ListOp
+	Do(ctx context.Context) (*model.FoldersResponse, error)
+}
+
+// ListItemsOp is the version-neutral ListItems op.
+type ListItemsOp interface {
+	FromDate(val time.Time) ListItemsOp
+	OwnerEmail(val string) ListItemsOp
+	OwnerName(val string) ListItemsOp
+	SearchText(val string) ListItemsOp
+	StartPosition(val int) ListItemsOp
+	Status(val string) ListItemsOp
+	ToDate(val time.Time) ListItemsOp
+	Do(ctx context.Context) (*model.FolderItemsResponse, error)
+}
+
+// MoveEnvelopesOp is the version-neutral MoveEnvelopes op.
+type MoveEnvelopesOp interface {
+	Do(ctx context.Context) (*model.FoldersResponse, error)
+}
+
+// SearchOp is the version-neutral Search op.
+type SearchOp interface {
+	All() SearchOp
+	Count(val int) SearchOp
+	FromDate(val time.Time) SearchOp
+	IncludeRecipients() SearchOp
+	Order(val string) SearchOp
+	OrderBy(val string) SearchOp
+	StartPosition(val int) SearchOp
+	ToDate(val time.Time) SearchOp
+	Do(ctx context.Context) (*model.FolderItemResponse, error)
+}
+
+type v21Service struct {
+	s *folders.Service
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *folders.ListOp
+}
+
+func (o *v21ListOp) Include(val string) ListOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v21ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListOp) Template(val string) ListOp {
+	o.op.Template(val)
+	return o
+}
+
+func (o *v21ListOp) UserFilter(val string) ListOp {
+	o.op.UserFilter(val)
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.FoldersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListItems(folderID string) ListItemsOp {
+	return &v21ListItemsOp{op: s.s.ListItems(folderID)}
+}
+
+type v21ListItemsOp struct {
+	op *folders.ListItemsOp
+}
+
+func (o *v21ListItemsOp) FromDate(val time.Time) ListItemsOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21ListItemsOp) OwnerEmail(val string) ListItemsOp {
+	o.op.OwnerEmail(val)
+	return o
+}
+
+func (o *v21ListItemsOp) OwnerName(val string) ListItemsOp {
+	o.op.OwnerName(val)
+	return o
+}
+
+func (o *v21ListItemsOp) SearchText(val string) ListItemsOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21ListItemsOp) StartPosition(val int) ListItemsOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListItemsOp) Status(val string) ListItemsOp {
+	o.op.Status(val)
+	return o
+}
+
+func (o *v21ListItemsOp) ToDate(val time.Time) ListItemsOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21ListItemsOp) Do(ctx context.Context) (*model.FolderItemsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) MoveEnvelopes(folderID string, foldersRequest *model.FoldersRequest) MoveEnvelopesOp {
+	return &v21MoveEnvelopesOp{op: s.s.MoveEnvelopes(folderID, foldersRequest)}
+}
+
+type v21MoveEnvelopesOp struct {
+	op *folders.MoveEnvelopesOp
+}
+
+func (o *v21MoveEnvelopesOp) Do(ctx context.Context) (*model.FoldersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Search(searchFolderID string) SearchOp {
+	return &v21SearchOp{op: s.s.Search(searchFolderID)}
+}
+
+type v21SearchOp struct {
+	op *folders.SearchOp
+}
+
+func (o *v21SearchOp) All() SearchOp {
+	o.op.All()
+	return o
+}
+
+func (o *v21SearchOp) Count(val int) SearchOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21SearchOp) FromDate(val time.Time) SearchOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21SearchOp) IncludeRecipients() SearchOp {
+	o.op.IncludeRecipients()
+	return o
+}
+
+func (o *v21SearchOp) Order(val string) SearchOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v21SearchOp) OrderBy(val string) SearchOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v21SearchOp) StartPosition(val int) SearchOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21SearchOp) ToDate(val time.Time) SearchOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21SearchOp) Do(ctx context.Context) (*model.FolderItemResponse, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2folders.Service
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2folders.ListOp
+	err error
+}
+
+func (o *v2ListOp) Include(val string) ListOp {
+	o.op.Include(val)
+	return o
+}
+
+func (o *v2ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListOp) Template(val string) ListOp {
+	o.op.Template(val)
+	return o
+}
+
+func (o *v2ListOp) UserFilter(val string) ListOp {
+	o.op.UserFilter(val)
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.FoldersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.FoldersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListItems(folderID string) ListItemsOp {
+	return &v2ListItemsOp{op: s.s.ListItems(folderID)}
+}
+
+type v2ListItemsOp struct {
+	op  *v2folders.ListItemsOp
+	err error
+}
+
+func (o *v2ListItemsOp) FromDate(val time.Time) ListItemsOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2ListItemsOp) OwnerEmail(val string) ListItemsOp {
+	o.op.OwnerEmail(val)
+	return o
+}
+
+func (o *v2ListItemsOp) OwnerName(val string) ListItemsOp {
+	o.op.OwnerName(val)
+	return o
+}
+
+func (o *v2ListItemsOp) SearchText(val string) ListItemsOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2ListItemsOp) StartPosition(val int) ListItemsOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListItemsOp) Status(val string) ListItemsOp {
+	o.op.Status(val)
+	return o
+}
+
+func (o *v2ListItemsOp) ToDate(val time.Time) ListItemsOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2ListItemsOp) Do(ctx context.Context) (*model.FolderItemsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.FolderItemsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) MoveEnvelopes(folderID string, foldersRequest *model.FoldersRequest) MoveEnvelopesOp {
+	o := &v2MoveEnvelopesOp{}
+	var v2foldersRequest *v2model.FoldersRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2foldersRequest, foldersRequest)
+	}
+	o.op = s.s.MoveEnvelopes(folderID, v2foldersRequest)
+	return o
+}
+
+type v2MoveEnvelopesOp struct {
+	op  *v2folders.MoveEnvelopesOp
+	err error
+}
+
+func (o *v2MoveEnvelopesOp) Do(ctx context.Context) (*model.FoldersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.FoldersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Search(searchFolderID string) SearchOp {
+	return &v2SearchOp{op: s.s.Search(searchFolderID)}
+}
+
+type v2SearchOp struct {
+	op  *v2folders.SearchOp
+	err error
+}
+
+func (o *v2SearchOp) All() SearchOp {
+	o.op.All()
+	return o
+}
+
+func (o *v2SearchOp) Count(val int) SearchOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2SearchOp) FromDate(val time.Time) SearchOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2SearchOp) IncludeRecipients() SearchOp {
+	o.op.IncludeRecipients()
+	return o
+}
+
+func (o *v2SearchOp) Order(val string) SearchOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v2SearchOp) OrderBy(val string) SearchOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v2SearchOp) StartPosition(val int) SearchOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2SearchOp) ToDate(val time.Time) SearchOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2SearchOp) Do(ctx context.Context) (*model.FolderItemResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.FolderItemResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
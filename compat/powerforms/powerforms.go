@@ -0,0 +1,478 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package powerforms defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 powerforms services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/powerforms"
+//	)
+//	...
+//	powerformsService := powerforms.New(esignCredential, cfg.UseV2)
+package powerforms // import "github.com/jfcote87/esign/compat/powerforms"
+
+import (
+	"context"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/powerforms"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2powerforms "github.com/jfcote87/esign/v2/powerforms"
+)
+
+// Service contains the ops shared by the v2 and v2.1 powerforms services.
+type Service interface {
+	// DataList returns the data that users entered in a PowerForm.
+	DataList(powerFormID string) DataListOp
+	// Create creates a new PowerForm.
+	Create(powerForms *model.PowerForm) CreateOp
+	// Delete deletes a PowerForm.
+	Delete(powerFormID string) DeleteOp
+	// DeleteList deletes one or more PowerForms.
+	DeleteList(powerFormsRequest *model.PowerFormsRequest) DeleteListOp
+	// Get returns a single PowerForm.
+	Get(powerFormID string) GetOp
+	// List returns a list of PowerForms.
+	List() ListOp
+	// ListSenders gets PowerForm senders.
+	ListSenders() ListSendersOp
+	// Update updates an existing PowerForm.
+	Update(powerFormID string, powerForms *model.PowerForm) UpdateOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2powerforms.New(cred)}
+	}
+	return v21Service{s: powerforms.New(cred)}
+}
+
+// DataListOp is the version-neutral DataList op.
+type DataListOp interface {
+	DataLayout(val string) DataListOp
+	FromDate(val time.Time) DataListOp
+	ToDate(val time.Time) DataListOp
+	Do(ctx context.Context) (*model.PowerFormsFormDataResponse, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	Do(ctx context.Context) (*model.PowerForm, error)
+}
+
+// DeleteOp is the version-neutral Delete op.
+type DeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// DeleteListOp is the version-neutral DeleteList op.
+type DeleteListOp interface {
+	Do(ctx context.Context) (*model.PowerFormsResponse, error)
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	Do(ctx context.Context) (*model.PowerForm, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	FromDate(val time.Time) ListOp
+	Order(val string) ListOp
+	OrderBy(val string) ListOp
+	ToDate(val time.Time) ListOp
+	Do(ctx context.Context) (*model.PowerFormsResponse, error)
+}
+
+// ListSendersOp is the version-neutral ListSenders op.
+type ListSendersOp interface {
+	StartPosition(val int) ListSendersOp
+	Do(ctx context.Context) (*model.PowerFormSendersResponse, error)
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	Do(ctx context.Context) (*model.PowerForm, error)
+}
+
+type v21Service struct {
+	s *powerforms.Service
+}
+
+func (s v21Service) DataList(powerFormID string) DataListOp {
+	return &v21DataListOp{op: s.s.DataList(powerFormID)}
+}
+
+type v21DataListOp struct {
+	op *powerforms.DataListOp
+}
+
+func (o *v21DataListOp) DataLayout(val string) DataListOp {
+	o.op.DataLayout(val)
+	return o
+}
+
+func (o *v21DataListOp) FromDate(val time.Time) DataListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21DataListOp) ToDate(val time.Time) DataListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21DataListOp) Do(ctx context.Context) (*model.PowerFormsFormDataResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(powerForms *model.PowerForm) CreateOp {
+	return &v21CreateOp{op: s.s.Create(powerForms)}
+}
+
+type v21CreateOp struct {
+	op *powerforms.CreateOp
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.PowerForm, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Delete(powerFormID string) DeleteOp {
+	return &v21DeleteOp{op: s.s.Delete(powerFormID)}
+}
+
+type v21DeleteOp struct {
+	op *powerforms.DeleteOp
+}
+
+func (o *v21DeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DeleteList(powerFormsRequest *model.PowerFormsRequest) DeleteListOp {
+	return &v21DeleteListOp{op: s.s.DeleteList(powerFormsRequest)}
+}
+
+type v21DeleteListOp struct {
+	op *powerforms.DeleteListOp
+}
+
+func (o *v21DeleteListOp) Do(ctx context.Context) (*model.PowerFormsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(powerFormID string) GetOp {
+	return &v21GetOp{op: s.s.Get(powerFormID)}
+}
+
+type v21GetOp struct {
+	op *powerforms.GetOp
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.PowerForm, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *powerforms.ListOp
+}
+
+func (o *v21ListOp) FromDate(val time.Time) ListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21ListOp) Order(val string) ListOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v21ListOp) OrderBy(val string) ListOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v21ListOp) ToDate(val time.Time) ListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.PowerFormsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ListSenders() ListSendersOp {
+	return &v21ListSendersOp{op: s.s.ListSenders()}
+}
+
+type v21ListSendersOp struct {
+	op *powerforms.ListSendersOp
+}
+
+func (o *v21ListSendersOp) StartPosition(val int) ListSendersOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListSendersOp) Do(ctx context.Context) (*model.PowerFormSendersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(powerFormID string, powerForms *model.PowerForm) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(powerFormID, powerForms)}
+}
+
+type v21UpdateOp struct {
+	op *powerforms.UpdateOp
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.PowerForm, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2powerforms.Service
+}
+
+func (s v2Service) DataList(powerFormID string) DataListOp {
+	return &v2DataListOp{op: s.s.DataList(powerFormID)}
+}
+
+type v2DataListOp struct {
+	op  *v2powerforms.DataListOp
+	err error
+}
+
+func (o *v2DataListOp) DataLayout(val string) DataListOp {
+	o.op.DataLayout(val)
+	return o
+}
+
+func (o *v2DataListOp) FromDate(val time.Time) DataListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2DataListOp) ToDate(val time.Time) DataListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2DataListOp) Do(ctx context.Context) (*model.PowerFormsFormDataResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerFormsFormDataResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(powerForms *model.PowerForm) CreateOp {
+	o := &v2CreateOp{}
+	var v2powerForms *v2model.PowerForm
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2powerForms, powerForms)
+	}
+	o.op = s.s.Create(v2powerForms)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2powerforms.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.PowerForm, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerForm
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Delete(powerFormID string) DeleteOp {
+	return &v2DeleteOp{op: s.s.Delete(powerFormID)}
+}
+
+type v2DeleteOp struct {
+	op *v2powerforms.DeleteOp
+}
+
+func (o *v2DeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) DeleteList(powerFormsRequest *model.PowerFormsRequest) DeleteListOp {
+	o := &v2DeleteListOp{}
+	var v2powerFormsRequest *v2model.PowerFormsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2powerFormsRequest, powerFormsRequest)
+	}
+	o.op = s.s.DeleteList(v2powerFormsRequest)
+	return o
+}
+
+type v2DeleteListOp struct {
+	op  *v2powerforms.DeleteListOp
+	err error
+}
+
+func (o *v2DeleteListOp) Do(ctx context.Context) (*model.PowerFormsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerFormsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Get(powerFormID string) GetOp {
+	return &v2GetOp{op: s.s.Get(powerFormID)}
+}
+
+type v2GetOp struct {
+	op  *v2powerforms.GetOp
+	err error
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.PowerForm, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerForm
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2powerforms.ListOp
+	err error
+}
+
+func (o *v2ListOp) FromDate(val time.Time) ListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2ListOp) Order(val string) ListOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v2ListOp) OrderBy(val string) ListOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v2ListOp) ToDate(val time.Time) ListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.PowerFormsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerFormsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ListSenders() ListSendersOp {
+	return &v2ListSendersOp{op: s.s.ListSenders()}
+}
+
+type v2ListSendersOp struct {
+	op  *v2powerforms.ListSendersOp
+	err error
+}
+
+func (o *v2ListSendersOp) StartPosition(val int) ListSendersOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListSendersOp) Do(ctx context.Context) (*model.PowerFormSendersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerFormSendersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Update(powerFormID string, powerForms *model.PowerForm) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2powerForms *v2model.PowerForm
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2powerForms, powerForms)
+	}
+	o.op = s.s.Update(powerFormID, v2powerForms)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2powerforms.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.PowerForm, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PowerForm
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -0,0 +1,480 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package signinggroups defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 signinggroups services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/signinggroups"
+//	)
+//	...
+//	signinggroupsService := signinggroups.New(esignCredential, cfg.UseV2)
+package signinggroups // import "github.com/jfcote87/esign/compat/signinggroups"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/signinggroups"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2signinggroups "github.com/jfcote87/esign/v2/signinggroups"
+)
+
+// Service contains the ops shared by the v2 and v2.1 signinggroups services.
+type Service interface {
+	// UsersDelete deletes  one or more members from a signing group.
+	UsersDelete(signingGroupID string, signingGroupUsers *model.SigningGroupUsers) UsersDeleteOp
+	// UsersList gets a list of members in a Signing Group.
+	UsersList(signingGroupID string) UsersListOp
+	// UsersUpdate adds members to a signing group.
+	UsersUpdate(signingGroupID string, signingGroupUsers *model.SigningGroupUsers) UsersUpdateOp
+	// Create creates a signing group.
+	Create(signingGroupInformation *model.SigningGroupInformation) CreateOp
+	// Delete deletes one or more signing groups.
+	Delete(signingGroupInformation *model.SigningGroupInformation) DeleteOp
+	// Get gets information about a signing group.
+	Get(signingGroupID string) GetOp
+	// List gets a list of the Signing Groups in an account.
+	List() ListOp
+	// Update updates a signing group.
+	Update(signingGroupID string, signingGroups *model.SigningGroup) UpdateOp
+	// UpdateList updates signing group names.
+	UpdateList(signingGroupInformation *model.SigningGroupInformation) UpdateListOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2signinggroups.New(cred)}
+	}
+	return v21Service{s: signinggroups.New(cred)}
+}
+
+// UsersDeleteOp is the version-neutral UsersDelete op.
+type UsersDeleteOp interface {
+	Do(ctx context.Context) (*model.SigningGroupUsers, error)
+}
+
+// UsersListOp is the version-neutral UsersList op.
+type UsersListOp interface {
+	Do(ctx context.Context) (*model.SigningGroupUsers, error)
+}
+
+// UsersUpdateOp is the version-neutral UsersUpdate op.
+type UsersUpdateOp interface {
+	Do(ctx context.Context) (*model.SigningGroupUsers, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	Do(ctx context.Context) (*model.SigningGroupInformation, error)
+}
+
+// DeleteOp is the version-neutral Delete op.
+type DeleteOp interface {
+	Do(ctx context.Context) (*model.SigningGroupInformation, error)
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	Do(ctx context.Context) (*model.SigningGroup, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	GroupType(val string) ListOp
+	IncludeUsers() ListOp
+	Do(ctx context.Context) (*model.SigningGroupInformation, error)
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	Do(ctx context.Context) (*model.SigningGroup, error)
+}
+
+// UpdateListOp is the version-neutral UpdateList op.
+type UpdateListOp interface {
+	Do(ctx context.Context) (*model.SigningGroupInformation, error)
+}
+
+type v21Service struct {
+	s *signinggroups.Service
+}
+
+func (s v21Service) UsersDelete(signingGroupID string, signingGroupUsers *model.SigningGroupUsers) UsersDeleteOp {
+	return &v21UsersDeleteOp{op: s.s.UsersDelete(signingGroupID, signingGroupUsers)}
+}
+
+type v21UsersDeleteOp struct {
+	op *signinggroups.UsersDeleteOp
+}
+
+func (o *v21UsersDeleteOp) Do(ctx context.Context) (*model.SigningGroupUsers, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UsersList(signingGroupID string) UsersListOp {
+	return &v21UsersListOp{op: s.s.UsersList(signingGroupID)}
+}
+
+type v21UsersListOp struct {
+	op *signinggroups.UsersListOp
+}
+
+func (o *v21UsersListOp) Do(ctx context.Context) (*model.SigningGroupUsers, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UsersUpdate(signingGroupID string, signingGroupUsers *model.SigningGroupUsers) UsersUpdateOp {
+	return &v21UsersUpdateOp{op: s.s.UsersUpdate(signingGroupID, signingGroupUsers)}
+}
+
+type v21UsersUpdateOp struct {
+	op *signinggroups.UsersUpdateOp
+}
+
+func (o *v21UsersUpdateOp) Do(ctx context.Context) (*model.SigningGroupUsers, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(signingGroupInformation *model.SigningGroupInformation) CreateOp {
+	return &v21CreateOp{op: s.s.Create(signingGroupInformation)}
+}
+
+type v21CreateOp struct {
+	op *signinggroups.CreateOp
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Delete(signingGroupInformation *model.SigningGroupInformation) DeleteOp {
+	return &v21DeleteOp{op: s.s.Delete(signingGroupInformation)}
+}
+
+type v21DeleteOp struct {
+	op *signinggroups.DeleteOp
+}
+
+func (o *v21DeleteOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(signingGroupID string) GetOp {
+	return &v21GetOp{op: s.s.Get(signingGroupID)}
+}
+
+type v21GetOp struct {
+	op *signinggroups.GetOp
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.SigningGroup, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *signinggroups.ListOp
+}
+
+func (o *v21ListOp) GroupType(val string) ListOp {
+	o.op.GroupType(val)
+	return o
+}
+
+func (o *v21ListOp) IncludeUsers() ListOp {
+	o.op.IncludeUsers()
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(signingGroupID string, signingGroups *model.SigningGroup) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(signingGroupID, signingGroups)}
+}
+
+type v21UpdateOp struct {
+	op *signinggroups.UpdateOp
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.SigningGroup, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateList(signingGroupInformation *model.SigningGroupInformation) UpdateListOp {
+	return &v21UpdateListOp{op: s.s.UpdateList(signingGroupInformation)}
+}
+
+type v21UpdateListOp struct {
+	op *signinggroups.UpdateListOp
+}
+
+func (o *v21UpdateListOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2signinggroups.Service
+}
+
+func (s v2Service) UsersDelete(signingGroupID string, signingGroupUsers *model.SigningGroupUsers) UsersDeleteOp {
+	o := &v2UsersDeleteOp{}
+	var v2signingGroupUsers *v2model.SigningGroupUsers
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2signingGroupUsers, signingGroupUsers)
+	}
+	o.op = s.s.UsersDelete(signingGroupID, v2signingGroupUsers)
+	return o
+}
+
+type v2UsersDeleteOp struct {
+	op  *v2signinggroups.UsersDeleteOp
+	err error
+}
+
+func (o *v2UsersDeleteOp) Do(ctx context.Context) (*model.SigningGroupUsers, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupUsers
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UsersList(signingGroupID string) UsersListOp {
+	return &v2UsersListOp{op: s.s.UsersList(signingGroupID)}
+}
+
+type v2UsersListOp struct {
+	op  *v2signinggroups.UsersListOp
+	err error
+}
+
+func (o *v2UsersListOp) Do(ctx context.Context) (*model.SigningGroupUsers, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupUsers
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UsersUpdate(signingGroupID string, signingGroupUsers *model.SigningGroupUsers) UsersUpdateOp {
+	o := &v2UsersUpdateOp{}
+	var v2signingGroupUsers *v2model.SigningGroupUsers
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2signingGroupUsers, signingGroupUsers)
+	}
+	o.op = s.s.UsersUpdate(signingGroupID, v2signingGroupUsers)
+	return o
+}
+
+type v2UsersUpdateOp struct {
+	op  *v2signinggroups.UsersUpdateOp
+	err error
+}
+
+func (o *v2UsersUpdateOp) Do(ctx context.Context) (*model.SigningGroupUsers, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupUsers
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(signingGroupInformation *model.SigningGroupInformation) CreateOp {
+	o := &v2CreateOp{}
+	var v2signingGroupInformation *v2model.SigningGroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2signingGroupInformation, signingGroupInformation)
+	}
+	o.op = s.s.Create(v2signingGroupInformation)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2signinggroups.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Delete(signingGroupInformation *model.SigningGroupInformation) DeleteOp {
+	o := &v2DeleteOp{}
+	var v2signingGroupInformation *v2model.SigningGroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2signingGroupInformation, signingGroupInformation)
+	}
+	o.op = s.s.Delete(v2signingGroupInformation)
+	return o
+}
+
+type v2DeleteOp struct {
+	op  *v2signinggroups.DeleteOp
+	err error
+}
+
+func (o *v2DeleteOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Get(signingGroupID string) GetOp {
+	return &v2GetOp{op: s.s.Get(signingGroupID)}
+}
+
+type v2GetOp struct {
+	op  *v2signinggroups.GetOp
+	err error
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.SigningGroup, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroup
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2signinggroups.ListOp
+	err error
+}
+
+func (o *v2ListOp) GroupType(val string) ListOp {
+	o.op.GroupType(val)
+	return o
+}
+
+func (o *v2ListOp) IncludeUsers() ListOp {
+	o.op.IncludeUsers()
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Update(signingGroupID string, signingGroups *model.SigningGroup) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2signingGroups *v2model.SigningGroup
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2signingGroups, signingGroups)
+	}
+	o.op = s.s.Update(signingGroupID, v2signingGroups)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2signinggroups.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.SigningGroup, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroup
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateList(signingGroupInformation *model.SigningGroupInformation) UpdateListOp {
+	o := &v2UpdateListOp{}
+	var v2signingGroupInformation *v2model.SigningGroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2signingGroupInformation, signingGroupInformation)
+	}
+	o.op = s.s.UpdateList(v2signingGroupInformation)
+	return o
+}
+
+type v2UpdateListOp struct {
+	op  *v2signinggroups.UpdateListOp
+	err error
+}
+
+func (o *v2UpdateListOp) Do(ctx context.Context) (*model.SigningGroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.SigningGroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
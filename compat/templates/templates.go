@@ -0,0 +1,2445 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package templates defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 templates services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/templates"
+//	)
+//	...
+//	templatesService := templates.New(esignCredential, cfg.UseV2)
+package templates // import "github.com/jfcote87/esign/compat/templates"
+
+import (
+	"context"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/templates"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2templates "github.com/jfcote87/esign/v2/templates"
+)
+
+// Service contains the ops shared by the v2 and v2.1 templates services.
+type Service interface {
+	// BulkRecipientsDelete deletes the bulk recipient list on a template.
+	BulkRecipientsDelete(recipientID string, templateID string) BulkRecipientsDeleteOp
+	// BulkRecipientsList gets the bulk recipient file from a template.
+	BulkRecipientsList(recipientID string, templateID string) BulkRecipientsListOp
+	// BulkRecipientsUpdate adds or replaces the bulk recipients list in a template.
+	BulkRecipientsUpdate(recipientID string, templateID string, bulkRecipientsRequest *model.BulkRecipientsRequest) BulkRecipientsUpdateOp
+	// CustomFieldsCreate creates custom document fields in an existing template document.
+	CustomFieldsCreate(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsCreateOp
+	// CustomFieldsDelete deletes envelope custom fields in a template.
+	CustomFieldsDelete(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsDeleteOp
+	// CustomFieldsList gets the custom document fields from a template.
+	CustomFieldsList(templateID string) CustomFieldsListOp
+	// CustomFieldsUpdate updates envelope custom fields in a template.
+	CustomFieldsUpdate(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsUpdateOp
+	// DocumentFieldsCreate creates custom document fields in an existing template document.
+	DocumentFieldsCreate(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsCreateOp
+	// DocumentFieldsDelete deletes custom document fields from an existing template document.
+	DocumentFieldsDelete(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsDeleteOp
+	// DocumentFieldsList gets the custom document fields for a an existing template document.
+	DocumentFieldsList(documentID string, templateID string) DocumentFieldsListOp
+	// DocumentFieldsUpdate updates existing custom document fields in an existing template document.
+	DocumentFieldsUpdate(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsUpdateOp
+	// DocumentTabsGet returns tabs on the document.
+	DocumentTabsGet(documentID string, templateID string) DocumentTabsGetOp
+	// DocumentTabsGetByPage returns tabs on the specified page.
+	DocumentTabsGetByPage(documentID string, pageNumber string, templateID string) DocumentTabsGetByPageOp
+	// DocumentsDelete deletes documents from a template.
+	DocumentsDelete(templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsDeleteOp
+	// DocumentsGet gets PDF documents from a template.
+	DocumentsGet(documentID string, templateID string) DocumentsGetOp
+	// DocumentsList gets a list of documents associated with a template.
+	DocumentsList(templateID string) DocumentsListOp
+	// DocumentsUpdate adds a document to a template document.
+	DocumentsUpdate(documentID string, templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateOp
+	// DocumentsUpdateList adds documents to a template document.
+	DocumentsUpdateList(templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateListOp
+	// LocksCreate lock a template.
+	LocksCreate(templateID string, lockRequest *model.LockRequest) LocksCreateOp
+	// LocksDelete deletes a template lock.
+	LocksDelete(templateID string, lockRequest *model.LockRequest) LocksDeleteOp
+	// LocksGet gets template lock information.
+	LocksGet(templateID string) LocksGetOp
+	// LocksUpdate updates a template lock.
+	LocksUpdate(templateID string, lockRequest *model.LockRequest) LocksUpdateOp
+	// RecipientTabsCreate adds tabs for a recipient.
+	RecipientTabsCreate(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsCreateOp
+	// RecipientTabsDelete deletes the tabs associated with a recipient in a template.
+	RecipientTabsDelete(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsDeleteOp
+	// RecipientTabsList gets the tabs information for a signer or sign-in-person recipient in a template.
+	RecipientTabsList(recipientID string, templateID string) RecipientTabsListOp
+	// RecipientTabsUpdate updates the tabs for a recipient.
+	RecipientTabsUpdate(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsUpdateOp
+	// RecipientsCreate adds tabs for a recipient.
+	RecipientsCreate(templateID string, templateRecipients *model.TemplateRecipients) RecipientsCreateOp
+	// RecipientsDelete deletes the specified recipient file from a template.
+	RecipientsDelete(recipientID string, templateID string, templateRecipients *model.TemplateRecipients) RecipientsDeleteOp
+	// RecipientsDeleteList deletes recipients from a template.
+	RecipientsDeleteList(templateID string, templateRecipients *model.TemplateRecipients) RecipientsDeleteListOp
+	// RecipientsList gets recipient information from a template.
+	RecipientsList(templateID string) RecipientsListOp
+	// RecipientsUpdate updates recipients in a template.
+	RecipientsUpdate(templateID string, templateRecipients *model.TemplateRecipients) RecipientsUpdateOp
+	// ViewsCreateEdit provides a URL to start an edit view of the Template UI
+	ViewsCreateEdit(templateID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateEditOp
+	// Create creates a template.
+	Create(templates *model.EnvelopeTemplate, uploads ...*esign.UploadFile) CreateOp
+	// DeleteDocumentPage deletes a page from a document in an template.
+	DeleteDocumentPage(documentID string, pageNumber string, templateID string, pageRequest *model.PageRequest) DeleteDocumentPageOp
+	// DeleteGroupShare removes a member group's sharing permissions for a template.
+	DeleteGroupShare(templateID string, templatePart string, groups *model.GroupInformation) DeleteGroupShareOp
+	// Get gets a specific template associated with a specified account.
+	Get(templateID string) GetOp
+	// GetDocumentPageImage gets a page image from a template for display.
+	GetDocumentPageImage(documentID string, pageNumber string, templateID string) GetDocumentPageImageOp
+	// GetNotificationSettings gets template notification information.
+	GetNotificationSettings(templateID string) GetNotificationSettingsOp
+	// GetPageImages returns document page image(s) based on input.
+	GetPageImages(documentID string, templateID string) GetPageImagesOp
+	// List gets the definition of a template.
+	List() ListOp
+	// RotateDocumentPage rotates page image from a template for display.
+	RotateDocumentPage(documentID string, pageNumber string, templateID string, pageRequest *model.PageRequest) RotateDocumentPageOp
+	// Update updates an existing template.
+	Update(templateID string, templates *model.EnvelopeTemplate) UpdateOp
+	// UpdateGroupShare shares a template with a group
+	UpdateGroupShare(templateID string, templatePart string, groups *model.GroupInformation) UpdateGroupShareOp
+	// UpdateNotificationSettings updates the notification  structure for an existing template.
+	UpdateNotificationSettings(templateID string, templateNotificationRequest *model.TemplateNotificationRequest) UpdateNotificationSettingsOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2templates.New(cred)}
+	}
+	return v21Service{s: templates.New(cred)}
+}
+
+// BulkRecipientsDeleteOp is the version-neutral BulkRecipientsDelete op.
+type BulkRecipientsDeleteOp interface {
+	Do(ctx context.Context) (*model.BulkRecipientsUpdateResponse, error)
+}
+
+// BulkRecipientsListOp is the version-neutral BulkRecipientsList op.
+type BulkRecipientsListOp interface {
+	IncludeTabs() BulkRecipientsListOp
+	StartPosition(val int) BulkRecipientsListOp
+	Do(ctx context.Context) (*model.BulkRecipientsResponse, error)
+}
+
+// BulkRecipientsUpdateOp is the version-neutral BulkRecipientsUpdate op.
+type BulkRecipientsUpdateOp interface {
+	Do(ctx context.Context) (*model.BulkRecipientsSummaryResponse, error)
+}
+
+// CustomFieldsCreateOp is the version-neutral CustomFieldsCreate op.
+type CustomFieldsCreateOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsDeleteOp is the version-neutral CustomFieldsDelete op.
+type CustomFieldsDeleteOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsListOp is the version-neutral CustomFieldsList op.
+type CustomFieldsListOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// CustomFieldsUpdateOp is the version-neutral CustomFieldsUpdate op.
+type CustomFieldsUpdateOp interface {
+	Do(ctx context.Context) (*model.CustomFields, error)
+}
+
+// DocumentFieldsCreateOp is the version-neutral DocumentFieldsCreate op.
+type DocumentFieldsCreateOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentFieldsDeleteOp is the version-neutral DocumentFieldsDelete op.
+type DocumentFieldsDeleteOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentFieldsListOp is the version-neutral DocumentFieldsList op.
+type DocumentFieldsListOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentFieldsUpdateOp is the version-neutral DocumentFieldsUpdate op.
+type DocumentFieldsUpdateOp interface {
+	Do(ctx context.Context) (*model.DocumentFieldsInformation, error)
+}
+
+// DocumentTabsGetOp is the version-neutral DocumentTabsGet op.
+type DocumentTabsGetOp interface {
+	PageNumbers(val string) DocumentTabsGetOp
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// DocumentTabsGetByPageOp is the version-neutral DocumentTabsGetByPage op.
+type DocumentTabsGetByPageOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// DocumentsDeleteOp is the version-neutral DocumentsDelete op.
+type DocumentsDeleteOp interface {
+	Do(ctx context.Context) (*model.TemplateDocumentsResult, error)
+}
+
+// DocumentsGetOp is the version-neutral DocumentsGet op.
+type DocumentsGetOp interface {
+	Encrypt() DocumentsGetOp
+	ShowChanges() DocumentsGetOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// DocumentsListOp is the version-neutral DocumentsList op.
+type DocumentsListOp interface {
+	Do(ctx context.Context) (*model.TemplateDocumentsResult, error)
+}
+
+// DocumentsUpdateOp is the version-neutral DocumentsUpdate op.
+type DocumentsUpdateOp interface {
+	IsEnvelopeDefinition() DocumentsUpdateOp
+	Do(ctx context.Context) (*model.EnvelopeDocument, error)
+}
+
+// DocumentsUpdateListOp is the version-neutral DocumentsUpdateList op.
+type DocumentsUpdateListOp interface {
+	Do(ctx context.Context) (*model.TemplateDocumentsResult, error)
+}
+
+// LocksCreateOp is the version-neutral LocksCreate op.
+type LocksCreateOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// LocksDeleteOp is the version-neutral LocksDelete op.
+type LocksDeleteOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// LocksGetOp is the version-neutral LocksGet op.
+type LocksGetOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// LocksUpdateOp is the version-neutral LocksUpdate op.
+type LocksUpdateOp interface {
+	Do(ctx context.Context) (*model.LockInformation, error)
+}
+
+// RecipientTabsCreateOp is the version-neutral RecipientTabsCreate op.
+type RecipientTabsCreateOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientTabsDeleteOp is the version-neutral RecipientTabsDelete op.
+type RecipientTabsDeleteOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientTabsListOp is the version-neutral RecipientTabsList op.
+type RecipientTabsListOp interface {
+	IncludeAnchorTabLocations() RecipientTabsListOp
+	IncludeMetadata(val string) RecipientTabsListOp
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientTabsUpdateOp is the version-neutral RecipientTabsUpdate op.
+type RecipientTabsUpdateOp interface {
+	Do(ctx context.Context) (*model.Tabs, error)
+}
+
+// RecipientsCreateOp is the version-neutral RecipientsCreate op.
+type RecipientsCreateOp interface {
+	ResendEnvelope() RecipientsCreateOp
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsDeleteOp is the version-neutral RecipientsDelete op.
+type RecipientsDeleteOp interface {
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsDeleteListOp is the version-neutral RecipientsDeleteList op.
+type RecipientsDeleteListOp interface {
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsListOp is the version-neutral RecipientsList op.
+type RecipientsListOp interface {
+	IncludeAnchorTabLocations() RecipientsListOp
+	IncludeExtended() RecipientsListOp
+	IncludeTabs() RecipientsListOp
+	Do(ctx context.Context) (*model.Recipients, error)
+}
+
+// RecipientsUpdateOp is the version-neutral RecipientsUpdate op.
+type RecipientsUpdateOp interface {
+	ResendEnvelope() RecipientsUpdateOp
+	Do(ctx context.Context) (*model.RecipientsUpdateSummary, error)
+}
+
+// ViewsCreateEditOp is the version-neutral ViewsCreateEdit op.
+type ViewsCreateEditOp interface {
+	Do(ctx context.Context) (*model.ViewURL, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	Do(ctx context.Context) (*model.TemplateSummary, error)
+}
+
+// DeleteDocumentPageOp is the version-neutral DeleteDocumentPage op.
+type DeleteDocumentPageOp interface {
+	Do(ctx context.Context) error
+}
+
+// DeleteGroupShareOp is the version-neutral DeleteGroupShare op.
+type DeleteGroupShareOp interface {
+	Do(ctx context.Context) (*model.GroupInformation, error)
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	Include(val ...string) GetOp
+	Do(ctx context.Context) (*model.EnvelopeTemplate, error)
+}
+
+// GetDocumentPageImageOp is the version-neutral GetDocumentPageImage op.
+type GetDocumentPageImageOp interface {
+	Dpi(val int) GetDocumentPageImageOp
+	MaxHeight(val int) GetDocumentPageImageOp
+	MaxWidth(val int) GetDocumentPageImageOp
+	ShowChanges() GetDocumentPageImageOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// GetNotificationSettingsOp is the version-neutral GetNotificationSettings op.
+type GetNotificationSettingsOp interface {
+	Do(ctx context.Context) (*model.Notification, error)
+}
+
+// GetPageImagesOp is the version-neutral GetPageImages op.
+type GetPageImagesOp interface {
+	Count(val int) GetPageImagesOp
+	Dpi(val int) GetPageImagesOp
+	MaxHeight(val int) GetPageImagesOp
+	MaxWidth(val int) GetPageImagesOp
+	Nocache() GetPageImagesOp
+	ShowChanges() GetPageImagesOp
+	StartPosition(val int) GetPageImagesOp
+	Do(ctx context.Context) (*model.PageImages, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	Count(val int) ListOp
+	FolderIds(val ...string) ListOp
+	FromDate(val time.Time) ListOp
+	Include(val ...string) ListOp
+	ModifiedFromDate(val time.Time) ListOp
+	ModifiedToDate(val time.Time) ListOp
+	Order(val string) ListOp
+	OrderBy(val string) ListOp
+	SearchText(val string) ListOp
+	SharedByMe(val string) ListOp
+	StartPosition(val int) ListOp
+	ToDate(val time.Time) ListOp
+	UsedFromDate(val time.Time) ListOp
+	UsedToDate(val time.Time) ListOp
+	UserFilter(val string) ListOp
+	UserID(val string) ListOp
+	Do(ctx context.Context) (*model.EnvelopeTemplateResults, error)
+}
+
+// RotateDocumentPageOp is the version-neutral RotateDocumentPage op.
+type RotateDocumentPageOp interface {
+	Do(ctx context.Context) error
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	Do(ctx context.Context) (*model.TemplateUpdateSummary, error)
+}
+
+// UpdateGroupShareOp is the version-neutral UpdateGroupShare op.
+type UpdateGroupShareOp interface {
+	Do(ctx context.Context) (*model.GroupInformation, error)
+}
+
+// UpdateNotificationSettingsOp is the version-neutral UpdateNotificationSettings op.
+type UpdateNotificationSettingsOp interface {
+	Do(ctx context.Context) (*model.Notification, error)
+}
+
+type v21Service struct {
+	s *templates.Service
+}
+
+func (s v21Service) BulkRecipientsDelete(recipientID string, templateID string) BulkRecipientsDeleteOp {
+	return &v21BulkRecipientsDeleteOp{op: s.s.BulkRecipientsDelete(recipientID, templateID)}
+}
+
+type v21BulkRecipientsDeleteOp struct {
+	op *templates.BulkRecipientsDeleteOp
+}
+
+func (o *v21BulkRecipientsDeleteOp) Do(ctx context.Context) (*model.BulkRecipientsUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BulkRecipientsList(recipientID string, templateID string) BulkRecipientsListOp {
+	return &v21BulkRecipientsListOp{op: s.s.BulkRecipientsList(recipientID, templateID)}
+}
+
+type v21BulkRecipientsListOp struct {
+	op *templates.BulkRecipientsListOp
+}
+
+func (o *v21BulkRecipientsListOp) IncludeTabs() BulkRecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v21BulkRecipientsListOp) StartPosition(val int) BulkRecipientsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21BulkRecipientsListOp) Do(ctx context.Context) (*model.BulkRecipientsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) BulkRecipientsUpdate(recipientID string, templateID string, bulkRecipientsRequest *model.BulkRecipientsRequest) BulkRecipientsUpdateOp {
+	return &v21BulkRecipientsUpdateOp{op: s.s.BulkRecipientsUpdate(recipientID, templateID, bulkRecipientsRequest)}
+}
+
+type v21BulkRecipientsUpdateOp struct {
+	op *templates.BulkRecipientsUpdateOp
+}
+
+func (o *v21BulkRecipientsUpdateOp) Do(ctx context.Context) (*model.BulkRecipientsSummaryResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsCreate(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsCreateOp {
+	return &v21CustomFieldsCreateOp{op: s.s.CustomFieldsCreate(templateID, templateCustomFields)}
+}
+
+type v21CustomFieldsCreateOp struct {
+	op *templates.CustomFieldsCreateOp
+}
+
+func (o *v21CustomFieldsCreateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsDelete(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsDeleteOp {
+	return &v21CustomFieldsDeleteOp{op: s.s.CustomFieldsDelete(templateID, templateCustomFields)}
+}
+
+type v21CustomFieldsDeleteOp struct {
+	op *templates.CustomFieldsDeleteOp
+}
+
+func (o *v21CustomFieldsDeleteOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsList(templateID string) CustomFieldsListOp {
+	return &v21CustomFieldsListOp{op: s.s.CustomFieldsList(templateID)}
+}
+
+type v21CustomFieldsListOp struct {
+	op *templates.CustomFieldsListOp
+}
+
+func (o *v21CustomFieldsListOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomFieldsUpdate(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsUpdateOp {
+	return &v21CustomFieldsUpdateOp{op: s.s.CustomFieldsUpdate(templateID, templateCustomFields)}
+}
+
+type v21CustomFieldsUpdateOp struct {
+	op *templates.CustomFieldsUpdateOp
+}
+
+func (o *v21CustomFieldsUpdateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsCreate(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsCreateOp {
+	return &v21DocumentFieldsCreateOp{op: s.s.DocumentFieldsCreate(documentID, templateID, templateDocumentFields)}
+}
+
+type v21DocumentFieldsCreateOp struct {
+	op *templates.DocumentFieldsCreateOp
+}
+
+func (o *v21DocumentFieldsCreateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsDelete(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsDeleteOp {
+	return &v21DocumentFieldsDeleteOp{op: s.s.DocumentFieldsDelete(documentID, templateID, templateDocumentFields)}
+}
+
+type v21DocumentFieldsDeleteOp struct {
+	op *templates.DocumentFieldsDeleteOp
+}
+
+func (o *v21DocumentFieldsDeleteOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsList(documentID string, templateID string) DocumentFieldsListOp {
+	return &v21DocumentFieldsListOp{op: s.s.DocumentFieldsList(documentID, templateID)}
+}
+
+type v21DocumentFieldsListOp struct {
+	op *templates.DocumentFieldsListOp
+}
+
+func (o *v21DocumentFieldsListOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentFieldsUpdate(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsUpdateOp {
+	return &v21DocumentFieldsUpdateOp{op: s.s.DocumentFieldsUpdate(documentID, templateID, templateDocumentFields)}
+}
+
+type v21DocumentFieldsUpdateOp struct {
+	op *templates.DocumentFieldsUpdateOp
+}
+
+func (o *v21DocumentFieldsUpdateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentTabsGet(documentID string, templateID string) DocumentTabsGetOp {
+	return &v21DocumentTabsGetOp{op: s.s.DocumentTabsGet(documentID, templateID)}
+}
+
+type v21DocumentTabsGetOp struct {
+	op *templates.DocumentTabsGetOp
+}
+
+func (o *v21DocumentTabsGetOp) PageNumbers(val string) DocumentTabsGetOp {
+	o.op.PageNumbers(val)
+	return o
+}
+
+func (o *v21DocumentTabsGetOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentTabsGetByPage(documentID string, pageNumber string, templateID string) DocumentTabsGetByPageOp {
+	return &v21DocumentTabsGetByPageOp{op: s.s.DocumentTabsGetByPage(documentID, pageNumber, templateID)}
+}
+
+type v21DocumentTabsGetByPageOp struct {
+	op *templates.DocumentTabsGetByPageOp
+}
+
+func (o *v21DocumentTabsGetByPageOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsDelete(templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsDeleteOp {
+	return &v21DocumentsDeleteOp{op: s.s.DocumentsDelete(templateID, envelopeDefinition)}
+}
+
+type v21DocumentsDeleteOp struct {
+	op *templates.DocumentsDeleteOp
+}
+
+func (o *v21DocumentsDeleteOp) Do(ctx context.Context) (*model.TemplateDocumentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsGet(documentID string, templateID string) DocumentsGetOp {
+	return &v21DocumentsGetOp{op: s.s.DocumentsGet(documentID, templateID)}
+}
+
+type v21DocumentsGetOp struct {
+	op *templates.DocumentsGetOp
+}
+
+func (o *v21DocumentsGetOp) Encrypt() DocumentsGetOp {
+	o.op.Encrypt()
+	return o
+}
+
+func (o *v21DocumentsGetOp) ShowChanges() DocumentsGetOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v21DocumentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsList(templateID string) DocumentsListOp {
+	return &v21DocumentsListOp{op: s.s.DocumentsList(templateID)}
+}
+
+type v21DocumentsListOp struct {
+	op *templates.DocumentsListOp
+}
+
+func (o *v21DocumentsListOp) Do(ctx context.Context) (*model.TemplateDocumentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsUpdate(documentID string, templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateOp {
+	return &v21DocumentsUpdateOp{op: s.s.DocumentsUpdate(documentID, templateID, envelopeDefinition)}
+}
+
+type v21DocumentsUpdateOp struct {
+	op *templates.DocumentsUpdateOp
+}
+
+func (o *v21DocumentsUpdateOp) IsEnvelopeDefinition() DocumentsUpdateOp {
+	o.op.IsEnvelopeDefinition()
+	return o
+}
+
+func (o *v21DocumentsUpdateOp) Do(ctx context.Context) (*model.EnvelopeDocument, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentsUpdateList(templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateListOp {
+	return &v21DocumentsUpdateListOp{op: s.s.DocumentsUpdateList(templateID, envelopeDefinition)}
+}
+
+type v21DocumentsUpdateListOp struct {
+	op *templates.DocumentsUpdateListOp
+}
+
+func (o *v21DocumentsUpdateListOp) Do(ctx context.Context) (*model.TemplateDocumentsResult, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksCreate(templateID string, lockRequest *model.LockRequest) LocksCreateOp {
+	return &v21LocksCreateOp{op: s.s.LocksCreate(templateID, lockRequest)}
+}
+
+type v21LocksCreateOp struct {
+	op *templates.LocksCreateOp
+}
+
+func (o *v21LocksCreateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksDelete(templateID string, lockRequest *model.LockRequest) LocksDeleteOp {
+	return &v21LocksDeleteOp{op: s.s.LocksDelete(templateID, lockRequest)}
+}
+
+type v21LocksDeleteOp struct {
+	op *templates.LocksDeleteOp
+}
+
+func (o *v21LocksDeleteOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksGet(templateID string) LocksGetOp {
+	return &v21LocksGetOp{op: s.s.LocksGet(templateID)}
+}
+
+type v21LocksGetOp struct {
+	op *templates.LocksGetOp
+}
+
+func (o *v21LocksGetOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) LocksUpdate(templateID string, lockRequest *model.LockRequest) LocksUpdateOp {
+	return &v21LocksUpdateOp{op: s.s.LocksUpdate(templateID, lockRequest)}
+}
+
+type v21LocksUpdateOp struct {
+	op *templates.LocksUpdateOp
+}
+
+func (o *v21LocksUpdateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsCreate(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsCreateOp {
+	return &v21RecipientTabsCreateOp{op: s.s.RecipientTabsCreate(recipientID, templateID, templateTabs)}
+}
+
+type v21RecipientTabsCreateOp struct {
+	op *templates.RecipientTabsCreateOp
+}
+
+func (o *v21RecipientTabsCreateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsDelete(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsDeleteOp {
+	return &v21RecipientTabsDeleteOp{op: s.s.RecipientTabsDelete(recipientID, templateID, templateTabs)}
+}
+
+type v21RecipientTabsDeleteOp struct {
+	op *templates.RecipientTabsDeleteOp
+}
+
+func (o *v21RecipientTabsDeleteOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsList(recipientID string, templateID string) RecipientTabsListOp {
+	return &v21RecipientTabsListOp{op: s.s.RecipientTabsList(recipientID, templateID)}
+}
+
+type v21RecipientTabsListOp struct {
+	op *templates.RecipientTabsListOp
+}
+
+func (o *v21RecipientTabsListOp) IncludeAnchorTabLocations() RecipientTabsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v21RecipientTabsListOp) IncludeMetadata(val string) RecipientTabsListOp {
+	o.op.IncludeMetadata(val)
+	return o
+}
+
+func (o *v21RecipientTabsListOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientTabsUpdate(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsUpdateOp {
+	return &v21RecipientTabsUpdateOp{op: s.s.RecipientTabsUpdate(recipientID, templateID, templateTabs)}
+}
+
+type v21RecipientTabsUpdateOp struct {
+	op *templates.RecipientTabsUpdateOp
+}
+
+func (o *v21RecipientTabsUpdateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsCreate(templateID string, templateRecipients *model.TemplateRecipients) RecipientsCreateOp {
+	return &v21RecipientsCreateOp{op: s.s.RecipientsCreate(templateID, templateRecipients)}
+}
+
+type v21RecipientsCreateOp struct {
+	op *templates.RecipientsCreateOp
+}
+
+func (o *v21RecipientsCreateOp) ResendEnvelope() RecipientsCreateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v21RecipientsCreateOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsDelete(recipientID string, templateID string, templateRecipients *model.TemplateRecipients) RecipientsDeleteOp {
+	return &v21RecipientsDeleteOp{op: s.s.RecipientsDelete(recipientID, templateID, templateRecipients)}
+}
+
+type v21RecipientsDeleteOp struct {
+	op *templates.RecipientsDeleteOp
+}
+
+func (o *v21RecipientsDeleteOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsDeleteList(templateID string, templateRecipients *model.TemplateRecipients) RecipientsDeleteListOp {
+	return &v21RecipientsDeleteListOp{op: s.s.RecipientsDeleteList(templateID, templateRecipients)}
+}
+
+type v21RecipientsDeleteListOp struct {
+	op *templates.RecipientsDeleteListOp
+}
+
+func (o *v21RecipientsDeleteListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsList(templateID string) RecipientsListOp {
+	return &v21RecipientsListOp{op: s.s.RecipientsList(templateID)}
+}
+
+type v21RecipientsListOp struct {
+	op *templates.RecipientsListOp
+}
+
+func (o *v21RecipientsListOp) IncludeAnchorTabLocations() RecipientsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v21RecipientsListOp) IncludeExtended() RecipientsListOp {
+	o.op.IncludeExtended()
+	return o
+}
+
+func (o *v21RecipientsListOp) IncludeTabs() RecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v21RecipientsListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RecipientsUpdate(templateID string, templateRecipients *model.TemplateRecipients) RecipientsUpdateOp {
+	return &v21RecipientsUpdateOp{op: s.s.RecipientsUpdate(templateID, templateRecipients)}
+}
+
+type v21RecipientsUpdateOp struct {
+	op *templates.RecipientsUpdateOp
+}
+
+func (o *v21RecipientsUpdateOp) ResendEnvelope() RecipientsUpdateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v21RecipientsUpdateOp) Do(ctx context.Context) (*model.RecipientsUpdateSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ViewsCreateEdit(templateID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateEditOp {
+	return &v21ViewsCreateEditOp{op: s.s.ViewsCreateEdit(templateID, returnURLRequest)}
+}
+
+type v21ViewsCreateEditOp struct {
+	op *templates.ViewsCreateEditOp
+}
+
+func (o *v21ViewsCreateEditOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(templates *model.EnvelopeTemplate, uploads ...*esign.UploadFile) CreateOp {
+	return &v21CreateOp{op: s.s.Create(templates, uploads...)}
+}
+
+type v21CreateOp struct {
+	op *templates.CreateOp
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.TemplateSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DeleteDocumentPage(documentID string, pageNumber string, templateID string, pageRequest *model.PageRequest) DeleteDocumentPageOp {
+	return &v21DeleteDocumentPageOp{op: s.s.DeleteDocumentPage(documentID, pageNumber, templateID, pageRequest)}
+}
+
+type v21DeleteDocumentPageOp struct {
+	op *templates.DeleteDocumentPageOp
+}
+
+func (o *v21DeleteDocumentPageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DeleteGroupShare(templateID string, templatePart string, groups *model.GroupInformation) DeleteGroupShareOp {
+	return &v21DeleteGroupShareOp{op: s.s.DeleteGroupShare(templateID, templatePart, groups)}
+}
+
+type v21DeleteGroupShareOp struct {
+	op *templates.DeleteGroupShareOp
+}
+
+func (o *v21DeleteGroupShareOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(templateID string) GetOp {
+	return &v21GetOp{op: s.s.Get(templateID)}
+}
+
+type v21GetOp struct {
+	op *templates.GetOp
+}
+
+func (o *v21GetOp) Include(val ...string) GetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.EnvelopeTemplate, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetDocumentPageImage(documentID string, pageNumber string, templateID string) GetDocumentPageImageOp {
+	return &v21GetDocumentPageImageOp{op: s.s.GetDocumentPageImage(documentID, pageNumber, templateID)}
+}
+
+type v21GetDocumentPageImageOp struct {
+	op *templates.GetDocumentPageImageOp
+}
+
+func (o *v21GetDocumentPageImageOp) Dpi(val int) GetDocumentPageImageOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v21GetDocumentPageImageOp) MaxHeight(val int) GetDocumentPageImageOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v21GetDocumentPageImageOp) MaxWidth(val int) GetDocumentPageImageOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v21GetDocumentPageImageOp) ShowChanges() GetDocumentPageImageOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v21GetDocumentPageImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetNotificationSettings(templateID string) GetNotificationSettingsOp {
+	return &v21GetNotificationSettingsOp{op: s.s.GetNotificationSettings(templateID)}
+}
+
+type v21GetNotificationSettingsOp struct {
+	op *templates.GetNotificationSettingsOp
+}
+
+func (o *v21GetNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetPageImages(documentID string, templateID string) GetPageImagesOp {
+	return &v21GetPageImagesOp{op: s.s.GetPageImages(documentID, templateID)}
+}
+
+type v21GetPageImagesOp struct {
+	op *templates.GetPageImagesOp
+}
+
+func (o *v21GetPageImagesOp) Count(val int) GetPageImagesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) Dpi(val int) GetPageImagesOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) MaxHeight(val int) GetPageImagesOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) MaxWidth(val int) GetPageImagesOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) Nocache() GetPageImagesOp {
+	o.op.Nocache()
+	return o
+}
+
+func (o *v21GetPageImagesOp) ShowChanges() GetPageImagesOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v21GetPageImagesOp) StartPosition(val int) GetPageImagesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *templates.ListOp
+}
+
+func (o *v21ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListOp) FolderIds(val ...string) ListOp {
+	o.op.FolderIds(val...)
+	return o
+}
+
+func (o *v21ListOp) FromDate(val time.Time) ListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v21ListOp) Include(val ...string) ListOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v21ListOp) ModifiedFromDate(val time.Time) ListOp {
+	o.op.ModifiedFromDate(val)
+	return o
+}
+
+func (o *v21ListOp) ModifiedToDate(val time.Time) ListOp {
+	o.op.ModifiedToDate(val)
+	return o
+}
+
+func (o *v21ListOp) Order(val string) ListOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v21ListOp) OrderBy(val string) ListOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v21ListOp) SearchText(val string) ListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21ListOp) SharedByMe(val string) ListOp {
+	o.op.SharedByMe(val)
+	return o
+}
+
+func (o *v21ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListOp) ToDate(val time.Time) ListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v21ListOp) UsedFromDate(val time.Time) ListOp {
+	o.op.UsedFromDate(val)
+	return o
+}
+
+func (o *v21ListOp) UsedToDate(val time.Time) ListOp {
+	o.op.UsedToDate(val)
+	return o
+}
+
+func (o *v21ListOp) UserFilter(val string) ListOp {
+	o.op.UserFilter(val)
+	return o
+}
+
+func (o *v21ListOp) UserID(val string) ListOp {
+	o.op.UserID(val)
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.EnvelopeTemplateResults, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) RotateDocumentPage(documentID string, pageNumber string, templateID string, pageRequest *model.PageRequest) RotateDocumentPageOp {
+	return &v21RotateDocumentPageOp{op: s.s.RotateDocumentPage(documentID, pageNumber, templateID, pageRequest)}
+}
+
+type v21RotateDocumentPageOp struct {
+	op *templates.RotateDocumentPageOp
+}
+
+func (o *v21RotateDocumentPageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(templateID string, templates *model.EnvelopeTemplate) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(templateID, templates)}
+}
+
+type v21UpdateOp struct {
+	op *templates.UpdateOp
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.TemplateUpdateSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateGroupShare(templateID string, templatePart string, groups *model.GroupInformation) UpdateGroupShareOp {
+	return &v21UpdateGroupShareOp{op: s.s.UpdateGroupShare(templateID, templatePart, groups)}
+}
+
+type v21UpdateGroupShareOp struct {
+	op *templates.UpdateGroupShareOp
+}
+
+func (o *v21UpdateGroupShareOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateNotificationSettings(templateID string, templateNotificationRequest *model.TemplateNotificationRequest) UpdateNotificationSettingsOp {
+	return &v21UpdateNotificationSettingsOp{op: s.s.UpdateNotificationSettings(templateID, templateNotificationRequest)}
+}
+
+type v21UpdateNotificationSettingsOp struct {
+	op *templates.UpdateNotificationSettingsOp
+}
+
+func (o *v21UpdateNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2templates.Service
+}
+
+func (s v2Service) BulkRecipientsDelete(recipientID string, templateID string) BulkRecipientsDeleteOp {
+	return &v2BulkRecipientsDeleteOp{op: s.s.BulkRecipientsDelete(recipientID, templateID)}
+}
+
+type v2BulkRecipientsDeleteOp struct {
+	op  *v2templates.BulkRecipientsDeleteOp
+	err error
+}
+
+func (o *v2BulkRecipientsDeleteOp) Do(ctx context.Context) (*model.BulkRecipientsUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkRecipientsUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BulkRecipientsList(recipientID string, templateID string) BulkRecipientsListOp {
+	return &v2BulkRecipientsListOp{op: s.s.BulkRecipientsList(recipientID, templateID)}
+}
+
+type v2BulkRecipientsListOp struct {
+	op  *v2templates.BulkRecipientsListOp
+	err error
+}
+
+func (o *v2BulkRecipientsListOp) IncludeTabs() BulkRecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v2BulkRecipientsListOp) StartPosition(val int) BulkRecipientsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2BulkRecipientsListOp) Do(ctx context.Context) (*model.BulkRecipientsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkRecipientsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) BulkRecipientsUpdate(recipientID string, templateID string, bulkRecipientsRequest *model.BulkRecipientsRequest) BulkRecipientsUpdateOp {
+	o := &v2BulkRecipientsUpdateOp{}
+	var v2bulkRecipientsRequest *v2model.BulkRecipientsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2bulkRecipientsRequest, bulkRecipientsRequest)
+	}
+	o.op = s.s.BulkRecipientsUpdate(recipientID, templateID, v2bulkRecipientsRequest)
+	return o
+}
+
+type v2BulkRecipientsUpdateOp struct {
+	op  *v2templates.BulkRecipientsUpdateOp
+	err error
+}
+
+func (o *v2BulkRecipientsUpdateOp) Do(ctx context.Context) (*model.BulkRecipientsSummaryResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BulkRecipientsSummaryResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsCreate(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsCreateOp {
+	o := &v2CustomFieldsCreateOp{}
+	var v2templateCustomFields *v2model.TemplateCustomFields
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateCustomFields, templateCustomFields)
+	}
+	o.op = s.s.CustomFieldsCreate(templateID, v2templateCustomFields)
+	return o
+}
+
+type v2CustomFieldsCreateOp struct {
+	op  *v2templates.CustomFieldsCreateOp
+	err error
+}
+
+func (o *v2CustomFieldsCreateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsDelete(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsDeleteOp {
+	o := &v2CustomFieldsDeleteOp{}
+	var v2templateCustomFields *v2model.TemplateCustomFields
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateCustomFields, templateCustomFields)
+	}
+	o.op = s.s.CustomFieldsDelete(templateID, v2templateCustomFields)
+	return o
+}
+
+type v2CustomFieldsDeleteOp struct {
+	op  *v2templates.CustomFieldsDeleteOp
+	err error
+}
+
+func (o *v2CustomFieldsDeleteOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsList(templateID string) CustomFieldsListOp {
+	return &v2CustomFieldsListOp{op: s.s.CustomFieldsList(templateID)}
+}
+
+type v2CustomFieldsListOp struct {
+	op  *v2templates.CustomFieldsListOp
+	err error
+}
+
+func (o *v2CustomFieldsListOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomFieldsUpdate(templateID string, templateCustomFields *model.TemplateCustomFields) CustomFieldsUpdateOp {
+	o := &v2CustomFieldsUpdateOp{}
+	var v2templateCustomFields *v2model.TemplateCustomFields
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateCustomFields, templateCustomFields)
+	}
+	o.op = s.s.CustomFieldsUpdate(templateID, v2templateCustomFields)
+	return o
+}
+
+type v2CustomFieldsUpdateOp struct {
+	op  *v2templates.CustomFieldsUpdateOp
+	err error
+}
+
+func (o *v2CustomFieldsUpdateOp) Do(ctx context.Context) (*model.CustomFields, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomFields
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsCreate(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsCreateOp {
+	o := &v2DocumentFieldsCreateOp{}
+	var v2templateDocumentFields *v2model.DocumentFieldsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateDocumentFields, templateDocumentFields)
+	}
+	o.op = s.s.DocumentFieldsCreate(documentID, templateID, v2templateDocumentFields)
+	return o
+}
+
+type v2DocumentFieldsCreateOp struct {
+	op  *v2templates.DocumentFieldsCreateOp
+	err error
+}
+
+func (o *v2DocumentFieldsCreateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsDelete(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsDeleteOp {
+	o := &v2DocumentFieldsDeleteOp{}
+	var v2templateDocumentFields *v2model.DocumentFieldsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateDocumentFields, templateDocumentFields)
+	}
+	o.op = s.s.DocumentFieldsDelete(documentID, templateID, v2templateDocumentFields)
+	return o
+}
+
+type v2DocumentFieldsDeleteOp struct {
+	op  *v2templates.DocumentFieldsDeleteOp
+	err error
+}
+
+func (o *v2DocumentFieldsDeleteOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsList(documentID string, templateID string) DocumentFieldsListOp {
+	return &v2DocumentFieldsListOp{op: s.s.DocumentFieldsList(documentID, templateID)}
+}
+
+type v2DocumentFieldsListOp struct {
+	op  *v2templates.DocumentFieldsListOp
+	err error
+}
+
+func (o *v2DocumentFieldsListOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentFieldsUpdate(documentID string, templateID string, templateDocumentFields *model.DocumentFieldsInformation) DocumentFieldsUpdateOp {
+	o := &v2DocumentFieldsUpdateOp{}
+	var v2templateDocumentFields *v2model.DocumentFieldsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateDocumentFields, templateDocumentFields)
+	}
+	o.op = s.s.DocumentFieldsUpdate(documentID, templateID, v2templateDocumentFields)
+	return o
+}
+
+type v2DocumentFieldsUpdateOp struct {
+	op  *v2templates.DocumentFieldsUpdateOp
+	err error
+}
+
+func (o *v2DocumentFieldsUpdateOp) Do(ctx context.Context) (*model.DocumentFieldsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentFieldsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentTabsGet(documentID string, templateID string) DocumentTabsGetOp {
+	return &v2DocumentTabsGetOp{op: s.s.DocumentTabsGet(documentID, templateID)}
+}
+
+type v2DocumentTabsGetOp struct {
+	op  *v2templates.DocumentTabsGetOp
+	err error
+}
+
+func (o *v2DocumentTabsGetOp) PageNumbers(val string) DocumentTabsGetOp {
+	o.op.PageNumbers(val)
+	return o
+}
+
+func (o *v2DocumentTabsGetOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentTabsGetByPage(documentID string, pageNumber string, templateID string) DocumentTabsGetByPageOp {
+	return &v2DocumentTabsGetByPageOp{op: s.s.DocumentTabsGetByPage(documentID, pageNumber, templateID)}
+}
+
+type v2DocumentTabsGetByPageOp struct {
+	op  *v2templates.DocumentTabsGetByPageOp
+	err error
+}
+
+func (o *v2DocumentTabsGetByPageOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsDelete(templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsDeleteOp {
+	o := &v2DocumentsDeleteOp{}
+	var v2envelopeDefinition *v2model.EnvelopeDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDefinition, envelopeDefinition)
+	}
+	o.op = s.s.DocumentsDelete(templateID, v2envelopeDefinition)
+	return o
+}
+
+type v2DocumentsDeleteOp struct {
+	op  *v2templates.DocumentsDeleteOp
+	err error
+}
+
+func (o *v2DocumentsDeleteOp) Do(ctx context.Context) (*model.TemplateDocumentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateDocumentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsGet(documentID string, templateID string) DocumentsGetOp {
+	return &v2DocumentsGetOp{op: s.s.DocumentsGet(documentID, templateID)}
+}
+
+type v2DocumentsGetOp struct {
+	op *v2templates.DocumentsGetOp
+}
+
+func (o *v2DocumentsGetOp) Encrypt() DocumentsGetOp {
+	o.op.Encrypt()
+	return o
+}
+
+func (o *v2DocumentsGetOp) ShowChanges() DocumentsGetOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v2DocumentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) DocumentsList(templateID string) DocumentsListOp {
+	return &v2DocumentsListOp{op: s.s.DocumentsList(templateID)}
+}
+
+type v2DocumentsListOp struct {
+	op  *v2templates.DocumentsListOp
+	err error
+}
+
+func (o *v2DocumentsListOp) Do(ctx context.Context) (*model.TemplateDocumentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateDocumentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsUpdate(documentID string, templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateOp {
+	o := &v2DocumentsUpdateOp{}
+	var v2envelopeDefinition *v2model.EnvelopeDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDefinition, envelopeDefinition)
+	}
+	o.op = s.s.DocumentsUpdate(documentID, templateID, v2envelopeDefinition)
+	return o
+}
+
+type v2DocumentsUpdateOp struct {
+	op  *v2templates.DocumentsUpdateOp
+	err error
+}
+
+func (o *v2DocumentsUpdateOp) IsEnvelopeDefinition() DocumentsUpdateOp {
+	o.op.IsEnvelopeDefinition()
+	return o
+}
+
+func (o *v2DocumentsUpdateOp) Do(ctx context.Context) (*model.EnvelopeDocument, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeDocument
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DocumentsUpdateList(templateID string, envelopeDefinition *model.EnvelopeDefinition) DocumentsUpdateListOp {
+	o := &v2DocumentsUpdateListOp{}
+	var v2envelopeDefinition *v2model.EnvelopeDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2envelopeDefinition, envelopeDefinition)
+	}
+	o.op = s.s.DocumentsUpdateList(templateID, v2envelopeDefinition)
+	return o
+}
+
+type v2DocumentsUpdateListOp struct {
+	op  *v2templates.DocumentsUpdateListOp
+	err error
+}
+
+func (o *v2DocumentsUpdateListOp) Do(ctx context.Context) (*model.TemplateDocumentsResult, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateDocumentsResult
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksCreate(templateID string, lockRequest *model.LockRequest) LocksCreateOp {
+	o := &v2LocksCreateOp{}
+	var v2lockRequest *v2model.LockRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2lockRequest, lockRequest)
+	}
+	o.op = s.s.LocksCreate(templateID, v2lockRequest)
+	return o
+}
+
+type v2LocksCreateOp struct {
+	op  *v2templates.LocksCreateOp
+	err error
+}
+
+func (o *v2LocksCreateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksDelete(templateID string, lockRequest *model.LockRequest) LocksDeleteOp {
+	o := &v2LocksDeleteOp{}
+	var v2lockRequest *v2model.LockRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2lockRequest, lockRequest)
+	}
+	o.op = s.s.LocksDelete(templateID, v2lockRequest)
+	return o
+}
+
+type v2LocksDeleteOp struct {
+	op  *v2templates.LocksDeleteOp
+	err error
+}
+
+func (o *v2LocksDeleteOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksGet(templateID string) LocksGetOp {
+	return &v2LocksGetOp{op: s.s.LocksGet(templateID)}
+}
+
+type v2LocksGetOp struct {
+	op  *v2templates.LocksGetOp
+	err error
+}
+
+func (o *v2LocksGetOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) LocksUpdate(templateID string, lockRequest *model.LockRequest) LocksUpdateOp {
+	o := &v2LocksUpdateOp{}
+	var v2lockRequest *v2model.LockRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2lockRequest, lockRequest)
+	}
+	o.op = s.s.LocksUpdate(templateID, v2lockRequest)
+	return o
+}
+
+type v2LocksUpdateOp struct {
+	op  *v2templates.LocksUpdateOp
+	err error
+}
+
+func (o *v2LocksUpdateOp) Do(ctx context.Context) (*model.LockInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.LockInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsCreate(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsCreateOp {
+	o := &v2RecipientTabsCreateOp{}
+	var v2templateTabs *v2model.TemplateTabs
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateTabs, templateTabs)
+	}
+	o.op = s.s.RecipientTabsCreate(recipientID, templateID, v2templateTabs)
+	return o
+}
+
+type v2RecipientTabsCreateOp struct {
+	op  *v2templates.RecipientTabsCreateOp
+	err error
+}
+
+func (o *v2RecipientTabsCreateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsDelete(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsDeleteOp {
+	o := &v2RecipientTabsDeleteOp{}
+	var v2templateTabs *v2model.TemplateTabs
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateTabs, templateTabs)
+	}
+	o.op = s.s.RecipientTabsDelete(recipientID, templateID, v2templateTabs)
+	return o
+}
+
+type v2RecipientTabsDeleteOp struct {
+	op  *v2templates.RecipientTabsDeleteOp
+	err error
+}
+
+func (o *v2RecipientTabsDeleteOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsList(recipientID string, templateID string) RecipientTabsListOp {
+	return &v2RecipientTabsListOp{op: s.s.RecipientTabsList(recipientID, templateID)}
+}
+
+type v2RecipientTabsListOp struct {
+	op  *v2templates.RecipientTabsListOp
+	err error
+}
+
+func (o *v2RecipientTabsListOp) IncludeAnchorTabLocations() RecipientTabsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v2RecipientTabsListOp) IncludeMetadata(val string) RecipientTabsListOp {
+	o.op.IncludeMetadata(val)
+	return o
+}
+
+func (o *v2RecipientTabsListOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientTabsUpdate(recipientID string, templateID string, templateTabs *model.TemplateTabs) RecipientTabsUpdateOp {
+	o := &v2RecipientTabsUpdateOp{}
+	var v2templateTabs *v2model.TemplateTabs
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateTabs, templateTabs)
+	}
+	o.op = s.s.RecipientTabsUpdate(recipientID, templateID, v2templateTabs)
+	return o
+}
+
+type v2RecipientTabsUpdateOp struct {
+	op  *v2templates.RecipientTabsUpdateOp
+	err error
+}
+
+func (o *v2RecipientTabsUpdateOp) Do(ctx context.Context) (*model.Tabs, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Tabs
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsCreate(templateID string, templateRecipients *model.TemplateRecipients) RecipientsCreateOp {
+	o := &v2RecipientsCreateOp{}
+	var v2templateRecipients *v2model.TemplateRecipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateRecipients, templateRecipients)
+	}
+	o.op = s.s.RecipientsCreate(templateID, v2templateRecipients)
+	return o
+}
+
+type v2RecipientsCreateOp struct {
+	op  *v2templates.RecipientsCreateOp
+	err error
+}
+
+func (o *v2RecipientsCreateOp) ResendEnvelope() RecipientsCreateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v2RecipientsCreateOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsDelete(recipientID string, templateID string, templateRecipients *model.TemplateRecipients) RecipientsDeleteOp {
+	o := &v2RecipientsDeleteOp{}
+	var v2templateRecipients *v2model.TemplateRecipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateRecipients, templateRecipients)
+	}
+	o.op = s.s.RecipientsDelete(recipientID, templateID, v2templateRecipients)
+	return o
+}
+
+type v2RecipientsDeleteOp struct {
+	op  *v2templates.RecipientsDeleteOp
+	err error
+}
+
+func (o *v2RecipientsDeleteOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsDeleteList(templateID string, templateRecipients *model.TemplateRecipients) RecipientsDeleteListOp {
+	o := &v2RecipientsDeleteListOp{}
+	var v2templateRecipients *v2model.TemplateRecipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateRecipients, templateRecipients)
+	}
+	o.op = s.s.RecipientsDeleteList(templateID, v2templateRecipients)
+	return o
+}
+
+type v2RecipientsDeleteListOp struct {
+	op  *v2templates.RecipientsDeleteListOp
+	err error
+}
+
+func (o *v2RecipientsDeleteListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsList(templateID string) RecipientsListOp {
+	return &v2RecipientsListOp{op: s.s.RecipientsList(templateID)}
+}
+
+type v2RecipientsListOp struct {
+	op  *v2templates.RecipientsListOp
+	err error
+}
+
+func (o *v2RecipientsListOp) IncludeAnchorTabLocations() RecipientsListOp {
+	o.op.IncludeAnchorTabLocations()
+	return o
+}
+
+func (o *v2RecipientsListOp) IncludeExtended() RecipientsListOp {
+	o.op.IncludeExtended()
+	return o
+}
+
+func (o *v2RecipientsListOp) IncludeTabs() RecipientsListOp {
+	o.op.IncludeTabs()
+	return o
+}
+
+func (o *v2RecipientsListOp) Do(ctx context.Context) (*model.Recipients, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Recipients
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RecipientsUpdate(templateID string, templateRecipients *model.TemplateRecipients) RecipientsUpdateOp {
+	o := &v2RecipientsUpdateOp{}
+	var v2templateRecipients *v2model.TemplateRecipients
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateRecipients, templateRecipients)
+	}
+	o.op = s.s.RecipientsUpdate(templateID, v2templateRecipients)
+	return o
+}
+
+type v2RecipientsUpdateOp struct {
+	op  *v2templates.RecipientsUpdateOp
+	err error
+}
+
+func (o *v2RecipientsUpdateOp) ResendEnvelope() RecipientsUpdateOp {
+	o.op.ResendEnvelope()
+	return o
+}
+
+func (o *v2RecipientsUpdateOp) Do(ctx context.Context) (*model.RecipientsUpdateSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.RecipientsUpdateSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ViewsCreateEdit(templateID string, returnURLRequest *model.ReturnURLRequest) ViewsCreateEditOp {
+	o := &v2ViewsCreateEditOp{}
+	var v2returnURLRequest *v2model.ReturnURLRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2returnURLRequest, returnURLRequest)
+	}
+	o.op = s.s.ViewsCreateEdit(templateID, v2returnURLRequest)
+	return o
+}
+
+type v2ViewsCreateEditOp struct {
+	op  *v2templates.ViewsCreateEditOp
+	err error
+}
+
+func (o *v2ViewsCreateEditOp) Do(ctx context.Context) (*model.ViewURL, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ViewURL
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(templates *model.EnvelopeTemplate, uploads ...*esign.UploadFile) CreateOp {
+	o := &v2CreateOp{}
+	var v2templates *v2model.EnvelopeTemplate
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templates, templates)
+	}
+	o.op = s.s.Create(v2templates, uploads...)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2templates.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.TemplateSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DeleteDocumentPage(documentID string, pageNumber string, templateID string, pageRequest *model.PageRequest) DeleteDocumentPageOp {
+	o := &v2DeleteDocumentPageOp{}
+	var v2pageRequest *v2model.PageRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2pageRequest, pageRequest)
+	}
+	o.op = s.s.DeleteDocumentPage(documentID, pageNumber, templateID, v2pageRequest)
+	return o
+}
+
+type v2DeleteDocumentPageOp struct {
+	op  *v2templates.DeleteDocumentPageOp
+	err error
+}
+
+func (o *v2DeleteDocumentPageOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) DeleteGroupShare(templateID string, templatePart string, groups *model.GroupInformation) DeleteGroupShareOp {
+	o := &v2DeleteGroupShareOp{}
+	var v2groups *v2model.GroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2groups, groups)
+	}
+	o.op = s.s.DeleteGroupShare(templateID, templatePart, v2groups)
+	return o
+}
+
+type v2DeleteGroupShareOp struct {
+	op  *v2templates.DeleteGroupShareOp
+	err error
+}
+
+func (o *v2DeleteGroupShareOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.GroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Get(templateID string) GetOp {
+	return &v2GetOp{op: s.s.Get(templateID)}
+}
+
+type v2GetOp struct {
+	op  *v2templates.GetOp
+	err error
+}
+
+func (o *v2GetOp) Include(val ...string) GetOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.EnvelopeTemplate, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeTemplate
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetDocumentPageImage(documentID string, pageNumber string, templateID string) GetDocumentPageImageOp {
+	return &v2GetDocumentPageImageOp{op: s.s.GetDocumentPageImage(documentID, pageNumber, templateID)}
+}
+
+type v2GetDocumentPageImageOp struct {
+	op *v2templates.GetDocumentPageImageOp
+}
+
+func (o *v2GetDocumentPageImageOp) Dpi(val int) GetDocumentPageImageOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v2GetDocumentPageImageOp) MaxHeight(val int) GetDocumentPageImageOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v2GetDocumentPageImageOp) MaxWidth(val int) GetDocumentPageImageOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v2GetDocumentPageImageOp) ShowChanges() GetDocumentPageImageOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v2GetDocumentPageImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) GetNotificationSettings(templateID string) GetNotificationSettingsOp {
+	return &v2GetNotificationSettingsOp{op: s.s.GetNotificationSettings(templateID)}
+}
+
+type v2GetNotificationSettingsOp struct {
+	op  *v2templates.GetNotificationSettingsOp
+	err error
+}
+
+func (o *v2GetNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Notification
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetPageImages(documentID string, templateID string) GetPageImagesOp {
+	return &v2GetPageImagesOp{op: s.s.GetPageImages(documentID, templateID)}
+}
+
+type v2GetPageImagesOp struct {
+	op  *v2templates.GetPageImagesOp
+	err error
+}
+
+func (o *v2GetPageImagesOp) Count(val int) GetPageImagesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) Dpi(val int) GetPageImagesOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) MaxHeight(val int) GetPageImagesOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) MaxWidth(val int) GetPageImagesOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) Nocache() GetPageImagesOp {
+	o.op.Nocache()
+	return o
+}
+
+func (o *v2GetPageImagesOp) ShowChanges() GetPageImagesOp {
+	o.op.ShowChanges()
+	return o
+}
+
+func (o *v2GetPageImagesOp) StartPosition(val int) GetPageImagesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2GetPageImagesOp) Do(ctx context.Context) (*model.PageImages, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PageImages
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2templates.ListOp
+	err error
+}
+
+func (o *v2ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListOp) FolderIds(val ...string) ListOp {
+	o.op.FolderIds(val...)
+	return o
+}
+
+func (o *v2ListOp) FromDate(val time.Time) ListOp {
+	o.op.FromDate(val)
+	return o
+}
+
+func (o *v2ListOp) Include(val ...string) ListOp {
+	o.op.Include(val...)
+	return o
+}
+
+func (o *v2ListOp) ModifiedFromDate(val time.Time) ListOp {
+	o.op.ModifiedFromDate(val)
+	return o
+}
+
+func (o *v2ListOp) ModifiedToDate(val time.Time) ListOp {
+	o.op.ModifiedToDate(val)
+	return o
+}
+
+func (o *v2ListOp) Order(val string) ListOp {
+	o.op.Order(val)
+	return o
+}
+
+func (o *v2ListOp) OrderBy(val string) ListOp {
+	o.op.OrderBy(val)
+	return o
+}
+
+func (o *v2ListOp) SearchText(val string) ListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2ListOp) SharedByMe(val string) ListOp {
+	o.op.SharedByMe(val)
+	return o
+}
+
+func (o *v2ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListOp) ToDate(val time.Time) ListOp {
+	o.op.ToDate(val)
+	return o
+}
+
+func (o *v2ListOp) UsedFromDate(val time.Time) ListOp {
+	o.op.UsedFromDate(val)
+	return o
+}
+
+func (o *v2ListOp) UsedToDate(val time.Time) ListOp {
+	o.op.UsedToDate(val)
+	return o
+}
+
+func (o *v2ListOp) UserFilter(val string) ListOp {
+	o.op.UserFilter(val)
+	return o
+}
+
+func (o *v2ListOp) UserID(val string) ListOp {
+	o.op.UserID(val)
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.EnvelopeTemplateResults, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.EnvelopeTemplateResults
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) RotateDocumentPage(documentID string, pageNumber string, templateID string, pageRequest *model.PageRequest) RotateDocumentPageOp {
+	o := &v2RotateDocumentPageOp{}
+	var v2pageRequest *v2model.PageRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2pageRequest, pageRequest)
+	}
+	o.op = s.s.RotateDocumentPage(documentID, pageNumber, templateID, v2pageRequest)
+	return o
+}
+
+type v2RotateDocumentPageOp struct {
+	op  *v2templates.RotateDocumentPageOp
+	err error
+}
+
+func (o *v2RotateDocumentPageOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) Update(templateID string, templates *model.EnvelopeTemplate) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2templates *v2model.EnvelopeTemplate
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templates, templates)
+	}
+	o.op = s.s.Update(templateID, v2templates)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2templates.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.TemplateUpdateSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.TemplateUpdateSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateGroupShare(templateID string, templatePart string, groups *model.GroupInformation) UpdateGroupShareOp {
+	o := &v2UpdateGroupShareOp{}
+	var v2groups *v2model.GroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2groups, groups)
+	}
+	o.op = s.s.UpdateGroupShare(templateID, templatePart, v2groups)
+	return o
+}
+
+type v2UpdateGroupShareOp struct {
+	op  *v2templates.UpdateGroupShareOp
+	err error
+}
+
+func (o *v2UpdateGroupShareOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.GroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateNotificationSettings(templateID string, templateNotificationRequest *model.TemplateNotificationRequest) UpdateNotificationSettingsOp {
+	o := &v2UpdateNotificationSettingsOp{}
+	var v2templateNotificationRequest *v2model.TemplateNotificationRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2templateNotificationRequest, templateNotificationRequest)
+	}
+	o.op = s.s.UpdateNotificationSettings(templateID, v2templateNotificationRequest)
+	return o
+}
+
+type v2UpdateNotificationSettingsOp struct {
+	op  *v2templates.UpdateNotificationSettingsOp
+	err error
+}
+
+func (o *v2UpdateNotificationSettingsOp) Do(ctx context.Context) (*model.Notification, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Notification
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
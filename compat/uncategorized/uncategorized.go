@@ -0,0 +1,468 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package uncategorized defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 uncategorized services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Ops not available in both versions:
+//
+//	CommentsCreateEnvelopeComments
+//	EnvelopeDocumentTabsCreateDocumentTabs
+//	EnvelopeDocumentTabsDeleteDocumentTabs
+//	EnvelopeDocumentTabsUpdateDocumentTabs
+//	EnvelopePurgeConfigurationGetEnvelopePurgeConfiguration
+//	EnvelopePurgeConfigurationUpdateEnvelopePurgeConfiguration
+//	EnvelopeTransferRulesCreateEnvelopeTransferRules
+//	EnvelopeTransferRulesDeleteEnvelopeTransferRules
+//	EnvelopeTransferRulesGetEnvelopeTransferRules
+//	EnvelopeTransferRulesUpdateEnvelopeTransferRule
+//	EnvelopeTransferRulesUpdateEnvelopeTransferRules
+//	EnvelopeViewsCreateEnvelopeRecipientPreview
+//	EnvelopeViewsCreateTemplateRecipientPreview
+//	NotificationDefaultsGetNotificationDefaults
+//	NotificationDefaultsUpdateNotificationDefaults
+//	TemplateDocumentTabsCreateTemplateDocumentTabs
+//	TemplateDocumentTabsDeleteTemplateDocumentTabs
+//	TemplateDocumentTabsUpdateTemplateDocumentTabs
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/uncategorized"
+//	)
+//	...
+//	uncategorizedService := uncategorized.New(esignCredential, cfg.UseV2)
+package uncategorized // import "github.com/jfcote87/esign/compat/uncategorized"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/uncategorized"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2uncategorized "github.com/jfcote87/esign/v2/uncategorized"
+)
+
+// Service contains the ops shared by the v2 and v2.1 uncategorized services.
+type Service interface {
+	// CommentsGetis uncategorized and subject to change
+	CommentsGet(envelopeID string) CommentsGetOp
+	// DocumentResponsiveHTMLPreviewCreateis uncategorized and subject to change
+	DocumentResponsiveHTMLPreviewCreate(documentID string, envelopeID string, documentHTMLDefinition *model.DocumentHTMLDefinition) DocumentResponsiveHTMLPreviewCreateOp
+	// EnvelopeDocumentHTMLDefinitionsGetis uncategorized and subject to change
+	EnvelopeDocumentHTMLDefinitionsGet(documentID string, envelopeID string) EnvelopeDocumentHTMLDefinitionsGetOp
+	// EnvelopeHTMLDefinitionsListis uncategorized and subject to change
+	EnvelopeHTMLDefinitionsList(envelopeID string) EnvelopeHTMLDefinitionsListOp
+	// ResponsiveHTMLPreviewCreateis uncategorized and subject to change
+	ResponsiveHTMLPreviewCreate(envelopeID string, documentHTMLDefinition *model.DocumentHTMLDefinition) ResponsiveHTMLPreviewCreateOp
+	// TemplateDocumentHTMLDefinitionsListis uncategorized and subject to change
+	TemplateDocumentHTMLDefinitionsList(documentID string, templateID string) TemplateDocumentHTMLDefinitionsListOp
+	// TemplateDocumentResponsiveHTMLPreviewCreateis uncategorized and subject to change
+	TemplateDocumentResponsiveHTMLPreviewCreate(documentID string, templateID string, documentHTMLDefinition *model.DocumentHTMLDefinition) TemplateDocumentResponsiveHTMLPreviewCreateOp
+	// TemplateHTMLDefinitionsListis uncategorized and subject to change
+	TemplateHTMLDefinitionsList(templateID string) TemplateHTMLDefinitionsListOp
+	// TemplateResponsiveHTMLPreviewCreateis uncategorized and subject to change
+	TemplateResponsiveHTMLPreviewCreate(templateID string, documentHTMLDefinition *model.DocumentHTMLDefinition) TemplateResponsiveHTMLPreviewCreateOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2uncategorized.New(cred)}
+	}
+	return v21Service{s: uncategorized.New(cred)}
+}
+
+// CommentsGetOp is the version-neutral CommentsGet op.
+type CommentsGetOp interface {
+	Encoding(val string) CommentsGetOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// DocumentResponsiveHTMLPreviewCreateOp is the version-neutral DocumentResponsiveHTMLPreviewCreate op.
+type DocumentResponsiveHTMLPreviewCreateOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error)
+}
+
+// EnvelopeDocumentHTMLDefinitionsGetOp is the version-neutral EnvelopeDocumentHTMLDefinitionsGet op.
+type EnvelopeDocumentHTMLDefinitionsGetOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error)
+}
+
+// EnvelopeHTMLDefinitionsListOp is the version-neutral EnvelopeHTMLDefinitionsList op.
+type EnvelopeHTMLDefinitionsListOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error)
+}
+
+// ResponsiveHTMLPreviewCreateOp is the version-neutral ResponsiveHTMLPreviewCreate op.
+type ResponsiveHTMLPreviewCreateOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error)
+}
+
+// TemplateDocumentHTMLDefinitionsListOp is the version-neutral TemplateDocumentHTMLDefinitionsList op.
+type TemplateDocumentHTMLDefinitionsListOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error)
+}
+
+// TemplateDocumentResponsiveHTMLPreviewCreateOp is the version-neutral TemplateDocumentResponsiveHTMLPreviewCreate op.
+type TemplateDocumentResponsiveHTMLPreviewCreateOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error)
+}
+
+// TemplateHTMLDefinitionsListOp is the version-neutral TemplateHTMLDefinitionsList op.
+type TemplateHTMLDefinitionsListOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error)
+}
+
+// TemplateResponsiveHTMLPreviewCreateOp is the version-neutral TemplateResponsiveHTMLPreviewCreate op.
+type TemplateResponsiveHTMLPreviewCreateOp interface {
+	Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error)
+}
+
+type v21Service struct {
+	s *uncategorized.Service
+}
+
+func (s v21Service) CommentsGet(envelopeID string) CommentsGetOp {
+	return &v21CommentsGetOp{op: s.s.CommentsGet(envelopeID)}
+}
+
+type v21CommentsGetOp struct {
+	op *uncategorized.CommentsGetOp
+}
+
+func (o *v21CommentsGetOp) Encoding(val string) CommentsGetOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v21CommentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DocumentResponsiveHTMLPreviewCreate(documentID string, envelopeID string, documentHTMLDefinition *model.DocumentHTMLDefinition) DocumentResponsiveHTMLPreviewCreateOp {
+	return &v21DocumentResponsiveHTMLPreviewCreateOp{op: s.s.DocumentResponsiveHTMLPreviewCreate(documentID, envelopeID, documentHTMLDefinition)}
+}
+
+type v21DocumentResponsiveHTMLPreviewCreateOp struct {
+	op *uncategorized.DocumentResponsiveHTMLPreviewCreateOp
+}
+
+func (o *v21DocumentResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EnvelopeDocumentHTMLDefinitionsGet(documentID string, envelopeID string) EnvelopeDocumentHTMLDefinitionsGetOp {
+	return &v21EnvelopeDocumentHTMLDefinitionsGetOp{op: s.s.EnvelopeDocumentHTMLDefinitionsGet(documentID, envelopeID)}
+}
+
+type v21EnvelopeDocumentHTMLDefinitionsGetOp struct {
+	op *uncategorized.EnvelopeDocumentHTMLDefinitionsGetOp
+}
+
+func (o *v21EnvelopeDocumentHTMLDefinitionsGetOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) EnvelopeHTMLDefinitionsList(envelopeID string) EnvelopeHTMLDefinitionsListOp {
+	return &v21EnvelopeHTMLDefinitionsListOp{op: s.s.EnvelopeHTMLDefinitionsList(envelopeID)}
+}
+
+type v21EnvelopeHTMLDefinitionsListOp struct {
+	op *uncategorized.EnvelopeHTMLDefinitionsListOp
+}
+
+func (o *v21EnvelopeHTMLDefinitionsListOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ResponsiveHTMLPreviewCreate(envelopeID string, documentHTMLDefinition *model.DocumentHTMLDefinition) ResponsiveHTMLPreviewCreateOp {
+	return &v21ResponsiveHTMLPreviewCreateOp{op: s.s.ResponsiveHTMLPreviewCreate(envelopeID, documentHTMLDefinition)}
+}
+
+type v21ResponsiveHTMLPreviewCreateOp struct {
+	op *uncategorized.ResponsiveHTMLPreviewCreateOp
+}
+
+func (o *v21ResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateDocumentHTMLDefinitionsList(documentID string, templateID string) TemplateDocumentHTMLDefinitionsListOp {
+	return &v21TemplateDocumentHTMLDefinitionsListOp{op: s.s.TemplateDocumentHTMLDefinitionsList(documentID, templateID)}
+}
+
+type v21TemplateDocumentHTMLDefinitionsListOp struct {
+	op *uncategorized.TemplateDocumentHTMLDefinitionsListOp
+}
+
+func (o *v21TemplateDocumentHTMLDefinitionsListOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateDocumentResponsiveHTMLPreviewCreate(documentID string, templateID string, documentHTMLDefinition *model.DocumentHTMLDefinition) TemplateDocumentResponsiveHTMLPreviewCreateOp {
+	return &v21TemplateDocumentResponsiveHTMLPreviewCreateOp{op: s.s.TemplateDocumentResponsiveHTMLPreviewCreate(documentID, templateID, documentHTMLDefinition)}
+}
+
+type v21TemplateDocumentResponsiveHTMLPreviewCreateOp struct {
+	op *uncategorized.TemplateDocumentResponsiveHTMLPreviewCreateOp
+}
+
+func (o *v21TemplateDocumentResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateHTMLDefinitionsList(templateID string) TemplateHTMLDefinitionsListOp {
+	return &v21TemplateHTMLDefinitionsListOp{op: s.s.TemplateHTMLDefinitionsList(templateID)}
+}
+
+type v21TemplateHTMLDefinitionsListOp struct {
+	op *uncategorized.TemplateHTMLDefinitionsListOp
+}
+
+func (o *v21TemplateHTMLDefinitionsListOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) TemplateResponsiveHTMLPreviewCreate(templateID string, documentHTMLDefinition *model.DocumentHTMLDefinition) TemplateResponsiveHTMLPreviewCreateOp {
+	return &v21TemplateResponsiveHTMLPreviewCreateOp{op: s.s.TemplateResponsiveHTMLPreviewCreate(templateID, documentHTMLDefinition)}
+}
+
+type v21TemplateResponsiveHTMLPreviewCreateOp struct {
+	op *uncategorized.TemplateResponsiveHTMLPreviewCreateOp
+}
+
+func (o *v21TemplateResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2uncategorized.Service
+}
+
+func (s v2Service) CommentsGet(envelopeID string) CommentsGetOp {
+	return &v2CommentsGetOp{op: s.s.CommentsGet(envelopeID)}
+}
+
+type v2CommentsGetOp struct {
+	op *v2uncategorized.CommentsGetOp
+}
+
+func (o *v2CommentsGetOp) Encoding(val string) CommentsGetOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v2CommentsGetOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) DocumentResponsiveHTMLPreviewCreate(documentID string, envelopeID string, documentHTMLDefinition *model.DocumentHTMLDefinition) DocumentResponsiveHTMLPreviewCreateOp {
+	o := &v2DocumentResponsiveHTMLPreviewCreateOp{}
+	var v2documentHTMLDefinition *v2model.DocumentHTMLDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2documentHTMLDefinition, documentHTMLDefinition)
+	}
+	o.op = s.s.DocumentResponsiveHTMLPreviewCreate(documentID, envelopeID, v2documentHTMLDefinition)
+	return o
+}
+
+type v2DocumentResponsiveHTMLPreviewCreateOp struct {
+	op  *v2uncategorized.DocumentResponsiveHTMLPreviewCreateOp
+	err error
+}
+
+func (o *v2DocumentResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitions
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EnvelopeDocumentHTMLDefinitionsGet(documentID string, envelopeID string) EnvelopeDocumentHTMLDefinitionsGetOp {
+	return &v2EnvelopeDocumentHTMLDefinitionsGetOp{op: s.s.EnvelopeDocumentHTMLDefinitionsGet(documentID, envelopeID)}
+}
+
+type v2EnvelopeDocumentHTMLDefinitionsGetOp struct {
+	op  *v2uncategorized.EnvelopeDocumentHTMLDefinitionsGetOp
+	err error
+}
+
+func (o *v2EnvelopeDocumentHTMLDefinitionsGetOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitionOriginals
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) EnvelopeHTMLDefinitionsList(envelopeID string) EnvelopeHTMLDefinitionsListOp {
+	return &v2EnvelopeHTMLDefinitionsListOp{op: s.s.EnvelopeHTMLDefinitionsList(envelopeID)}
+}
+
+type v2EnvelopeHTMLDefinitionsListOp struct {
+	op  *v2uncategorized.EnvelopeHTMLDefinitionsListOp
+	err error
+}
+
+func (o *v2EnvelopeHTMLDefinitionsListOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitionOriginals
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ResponsiveHTMLPreviewCreate(envelopeID string, documentHTMLDefinition *model.DocumentHTMLDefinition) ResponsiveHTMLPreviewCreateOp {
+	o := &v2ResponsiveHTMLPreviewCreateOp{}
+	var v2documentHTMLDefinition *v2model.DocumentHTMLDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2documentHTMLDefinition, documentHTMLDefinition)
+	}
+	o.op = s.s.ResponsiveHTMLPreviewCreate(envelopeID, v2documentHTMLDefinition)
+	return o
+}
+
+type v2ResponsiveHTMLPreviewCreateOp struct {
+	op  *v2uncategorized.ResponsiveHTMLPreviewCreateOp
+	err error
+}
+
+func (o *v2ResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitions
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplateDocumentHTMLDefinitionsList(documentID string, templateID string) TemplateDocumentHTMLDefinitionsListOp {
+	return &v2TemplateDocumentHTMLDefinitionsListOp{op: s.s.TemplateDocumentHTMLDefinitionsList(documentID, templateID)}
+}
+
+type v2TemplateDocumentHTMLDefinitionsListOp struct {
+	op  *v2uncategorized.TemplateDocumentHTMLDefinitionsListOp
+	err error
+}
+
+func (o *v2TemplateDocumentHTMLDefinitionsListOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitionOriginals
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplateDocumentResponsiveHTMLPreviewCreate(documentID string, templateID string, documentHTMLDefinition *model.DocumentHTMLDefinition) TemplateDocumentResponsiveHTMLPreviewCreateOp {
+	o := &v2TemplateDocumentResponsiveHTMLPreviewCreateOp{}
+	var v2documentHTMLDefinition *v2model.DocumentHTMLDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2documentHTMLDefinition, documentHTMLDefinition)
+	}
+	o.op = s.s.TemplateDocumentResponsiveHTMLPreviewCreate(documentID, templateID, v2documentHTMLDefinition)
+	return o
+}
+
+type v2TemplateDocumentResponsiveHTMLPreviewCreateOp struct {
+	op  *v2uncategorized.TemplateDocumentResponsiveHTMLPreviewCreateOp
+	err error
+}
+
+func (o *v2TemplateDocumentResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitions
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplateHTMLDefinitionsList(templateID string) TemplateHTMLDefinitionsListOp {
+	return &v2TemplateHTMLDefinitionsListOp{op: s.s.TemplateHTMLDefinitionsList(templateID)}
+}
+
+type v2TemplateHTMLDefinitionsListOp struct {
+	op  *v2uncategorized.TemplateHTMLDefinitionsListOp
+	err error
+}
+
+func (o *v2TemplateHTMLDefinitionsListOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitionOriginals, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitionOriginals
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) TemplateResponsiveHTMLPreviewCreate(templateID string, documentHTMLDefinition *model.DocumentHTMLDefinition) TemplateResponsiveHTMLPreviewCreateOp {
+	o := &v2TemplateResponsiveHTMLPreviewCreateOp{}
+	var v2documentHTMLDefinition *v2model.DocumentHTMLDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2documentHTMLDefinition, documentHTMLDefinition)
+	}
+	o.op = s.s.TemplateResponsiveHTMLPreviewCreate(templateID, v2documentHTMLDefinition)
+	return o
+}
+
+type v2TemplateResponsiveHTMLPreviewCreateOp struct {
+	op  *v2uncategorized.TemplateResponsiveHTMLPreviewCreateOp
+	err error
+}
+
+func (o *v2TemplateResponsiveHTMLPreviewCreateOp) Do(ctx context.Context) (*model.DocumentHTMLDefinitions, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.DocumentHTMLDefinitions
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
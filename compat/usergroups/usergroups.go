@@ -0,0 +1,571 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package usergroups defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 usergroups services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/usergroups"
+//	)
+//	...
+//	usergroupsService := usergroups.New(esignCredential, cfg.UseV2)
+package usergroups // import "github.com/jfcote87/esign/compat/usergroups"
+
+import (
+	"context"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/usergroups"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2usergroups "github.com/jfcote87/esign/v2/usergroups"
+)
+
+// Service contains the ops shared by the v2 and v2.1 usergroups services.
+type Service interface {
+	// GroupBrandsDelete deletes brand information from the requested group.
+	GroupBrandsDelete(groupID string, brandsRequest *model.BrandsRequest) GroupBrandsDeleteOp
+	// GroupBrandsGet gets group brand ID Information.
+	GroupBrandsGet(groupID string) GroupBrandsGetOp
+	// GroupBrandsUpdate adds group brand ID information to a group.
+	GroupBrandsUpdate(groupID string, brandsRequest *model.BrandsRequest) GroupBrandsUpdateOp
+	// GroupUsersDelete deletes one or more users from a group
+	GroupUsersDelete(groupID string, userInfoList *model.UserInfoList) GroupUsersDeleteOp
+	// GroupUsersList gets a list of users in a group.
+	GroupUsersList(groupID string) GroupUsersListOp
+	// GroupUsersUpdate adds one or more users to an existing group.
+	GroupUsersUpdate(groupID string, userInfoList *model.UserInfoList) GroupUsersUpdateOp
+	// GroupsCreate creates one or more groups for the account.
+	GroupsCreate(groups *model.GroupInformation) GroupsCreateOp
+	// GroupsDelete deletes an existing user group.
+	GroupsDelete(groups *model.GroupInformation) GroupsDeleteOp
+	// GroupsList gets information about groups associated with the account.
+	GroupsList() GroupsListOp
+	// GroupsUpdate updates the group information for a group.
+	GroupsUpdate(groups *model.GroupInformation) GroupsUpdateOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2usergroups.New(cred)}
+	}
+	return v21Service{s: usergroups.New(cred)}
+}
+
+// GroupBrandsDeleteOp is the version-neutral GroupBrandsDelete op.
+type GroupBrandsDeleteOp interface {
+	Do(ctx context.Context) (*model.BrandsResponse, error)
+}
+
+// GroupBrandsGetOp is the version-neutral GroupBrandsGet op.
+type GroupBrandsGetOp interface {
+	Do(ctx context.Context) (*model.BrandsResponse, error)
+}
+
+// GroupBrandsUpdateOp is the version-neutral GroupBrandsUpdate op.
+type GroupBrandsUpdateOp interface {
+	Do(ctx context.Context) (*model.BrandsResponse, error)
+}
+
+// GroupUsersDeleteOp is the version-neutral GroupUsersDelete op.
+type GroupUsersDeleteOp interface {
+	Do(ctx context.Context) (*model.UsersResponse, error)
+}
+
+// GroupUsersListOp is the version-neutral GroupUsersList op.
+type GroupUsersListOp interface {
+	Count(val int) GroupUsersListOp
+	StartPosition(val int) GroupUsersListOp
+	Do(ctx context.Context) (*model.UsersResponse, error)
+}
+
+// GroupUsersUpdateOp is the version-neutral GroupUsersUpdate op.
+type GroupUsersUpdateOp interface {
+	Do(ctx context.Context) (*model.UsersResponse, error)
+}
+
+// GroupsCreateOp is the version-neutral GroupsCreate op.
+type GroupsCreateOp interface {
+	Do(ctx context.Context) (*model.GroupInformation, error)
+}
+
+// GroupsDeleteOp is the version-neutral GroupsDelete op.
+type GroupsDeleteOp interface {
+	Do(ctx context.Context) (*model.GroupInformation, error)
+}
+
+// GroupsListOp is the version-neutral GroupsList op.
+type GroupsListOp interface {
+	Count(val int) GroupsListOp
+	GroupType(val string) GroupsListOp
+	SearchText(val string) GroupsListOp
+	StartPosition(val int) GroupsListOp
+	Do(ctx context.Context) (*model.GroupInformation, error)
+}
+
+// GroupsUpdateOp is the version-neutral GroupsUpdate op.
+type GroupsUpdateOp interface {
+	Do(ctx context.Context) (*model.GroupInformation, error)
+}
+
+type v21Service struct {
+	s *usergroups.Service
+}
+
+func (s v21Service) GroupBrandsDelete(groupID string, brandsRequest *model.BrandsRequest) GroupBrandsDeleteOp {
+	return &v21GroupBrandsDeleteOp{op: s.s.GroupBrandsDelete(groupID, brandsRequest)}
+}
+
+type v21GroupBrandsDeleteOp struct {
+	op *usergroups.GroupBrandsDeleteOp
+}
+
+func (o *v21GroupBrandsDeleteOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupBrandsGet(groupID string) GroupBrandsGetOp {
+	return &v21GroupBrandsGetOp{op: s.s.GroupBrandsGet(groupID)}
+}
+
+type v21GroupBrandsGetOp struct {
+	op *usergroups.GroupBrandsGetOp
+}
+
+func (o *v21GroupBrandsGetOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupBrandsUpdate(groupID string, brandsRequest *model.BrandsRequest) GroupBrandsUpdateOp {
+	return &v21GroupBrandsUpdateOp{op: s.s.GroupBrandsUpdate(groupID, brandsRequest)}
+}
+
+type v21GroupBrandsUpdateOp struct {
+	op *usergroups.GroupBrandsUpdateOp
+}
+
+func (o *v21GroupBrandsUpdateOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupUsersDelete(groupID string, userInfoList *model.UserInfoList) GroupUsersDeleteOp {
+	return &v21GroupUsersDeleteOp{op: s.s.GroupUsersDelete(groupID, userInfoList)}
+}
+
+type v21GroupUsersDeleteOp struct {
+	op *usergroups.GroupUsersDeleteOp
+}
+
+func (o *v21GroupUsersDeleteOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupUsersList(groupID string) GroupUsersListOp {
+	return &v21GroupUsersListOp{op: s.s.GroupUsersList(groupID)}
+}
+
+type v21GroupUsersListOp struct {
+	op *usergroups.GroupUsersListOp
+}
+
+func (o *v21GroupUsersListOp) Count(val int) GroupUsersListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21GroupUsersListOp) StartPosition(val int) GroupUsersListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21GroupUsersListOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupUsersUpdate(groupID string, userInfoList *model.UserInfoList) GroupUsersUpdateOp {
+	return &v21GroupUsersUpdateOp{op: s.s.GroupUsersUpdate(groupID, userInfoList)}
+}
+
+type v21GroupUsersUpdateOp struct {
+	op *usergroups.GroupUsersUpdateOp
+}
+
+func (o *v21GroupUsersUpdateOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupsCreate(groups *model.GroupInformation) GroupsCreateOp {
+	return &v21GroupsCreateOp{op: s.s.GroupsCreate(groups)}
+}
+
+type v21GroupsCreateOp struct {
+	op *usergroups.GroupsCreateOp
+}
+
+func (o *v21GroupsCreateOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupsDelete(groups *model.GroupInformation) GroupsDeleteOp {
+	return &v21GroupsDeleteOp{op: s.s.GroupsDelete(groups)}
+}
+
+type v21GroupsDeleteOp struct {
+	op *usergroups.GroupsDeleteOp
+}
+
+func (o *v21GroupsDeleteOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupsList() GroupsListOp {
+	return &v21GroupsListOp{op: s.s.GroupsList()}
+}
+
+type v21GroupsListOp struct {
+	op *usergroups.GroupsListOp
+}
+
+func (o *v21GroupsListOp) Count(val int) GroupsListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21GroupsListOp) GroupType(val string) GroupsListOp {
+	o.op.GroupType(val)
+	return o
+}
+
+func (o *v21GroupsListOp) SearchText(val string) GroupsListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v21GroupsListOp) StartPosition(val int) GroupsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21GroupsListOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GroupsUpdate(groups *model.GroupInformation) GroupsUpdateOp {
+	return &v21GroupsUpdateOp{op: s.s.GroupsUpdate(groups)}
+}
+
+type v21GroupsUpdateOp struct {
+	op *usergroups.GroupsUpdateOp
+}
+
+func (o *v21GroupsUpdateOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2usergroups.Service
+}
+
+func (s v2Service) GroupBrandsDelete(groupID string, brandsRequest *model.BrandsRequest) GroupBrandsDeleteOp {
+	o := &v2GroupBrandsDeleteOp{}
+	var v2brandsRequest *v2model.BrandsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2brandsRequest, brandsRequest)
+	}
+	o.op = s.s.GroupBrandsDelete(groupID, v2brandsRequest)
+	return o
+}
+
+type v2GroupBrandsDeleteOp struct {
+	op  *v2usergroups.GroupBrandsDeleteOp
+	err error
+}
+
+func (o *v2GroupBrandsDeleteOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupBrandsGet(groupID string) GroupBrandsGetOp {
+	return &v2GroupBrandsGetOp{op: s.s.GroupBrandsGet(groupID)}
+}
+
+type v2GroupBrandsGetOp struct {
+	op  *v2usergroups.GroupBrandsGetOp
+	err error
+}
+
+func (o *v2GroupBrandsGetOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupBrandsUpdate(groupID string, brandsRequest *model.BrandsRequest) GroupBrandsUpdateOp {
+	o := &v2GroupBrandsUpdateOp{}
+	var v2brandsRequest *v2model.BrandsRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2brandsRequest, brandsRequest)
+	}
+	o.op = s.s.GroupBrandsUpdate(groupID, v2brandsRequest)
+	return o
+}
+
+type v2GroupBrandsUpdateOp struct {
+	op  *v2usergroups.GroupBrandsUpdateOp
+	err error
+}
+
+func (o *v2GroupBrandsUpdateOp) Do(ctx context.Context) (*model.BrandsResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.BrandsResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupUsersDelete(groupID string, userInfoList *model.UserInfoList) GroupUsersDeleteOp {
+	o := &v2GroupUsersDeleteOp{}
+	var v2userInfoList *v2model.UserInfoList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userInfoList, userInfoList)
+	}
+	o.op = s.s.GroupUsersDelete(groupID, v2userInfoList)
+	return o
+}
+
+type v2GroupUsersDeleteOp struct {
+	op  *v2usergroups.GroupUsersDeleteOp
+	err error
+}
+
+func (o *v2GroupUsersDeleteOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UsersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupUsersList(groupID string) GroupUsersListOp {
+	return &v2GroupUsersListOp{op: s.s.GroupUsersList(groupID)}
+}
+
+type v2GroupUsersListOp struct {
+	op  *v2usergroups.GroupUsersListOp
+	err error
+}
+
+func (o *v2GroupUsersListOp) Count(val int) GroupUsersListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2GroupUsersListOp) StartPosition(val int) GroupUsersListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2GroupUsersListOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UsersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupUsersUpdate(groupID string, userInfoList *model.UserInfoList) GroupUsersUpdateOp {
+	o := &v2GroupUsersUpdateOp{}
+	var v2userInfoList *v2model.UserInfoList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userInfoList, userInfoList)
+	}
+	o.op = s.s.GroupUsersUpdate(groupID, v2userInfoList)
+	return o
+}
+
+type v2GroupUsersUpdateOp struct {
+	op  *v2usergroups.GroupUsersUpdateOp
+	err error
+}
+
+func (o *v2GroupUsersUpdateOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UsersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupsCreate(groups *model.GroupInformation) GroupsCreateOp {
+	o := &v2GroupsCreateOp{}
+	var v2groups *v2model.GroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2groups, groups)
+	}
+	o.op = s.s.GroupsCreate(v2groups)
+	return o
+}
+
+type v2GroupsCreateOp struct {
+	op  *v2usergroups.GroupsCreateOp
+	err error
+}
+
+func (o *v2GroupsCreateOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.GroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupsDelete(groups *model.GroupInformation) GroupsDeleteOp {
+	o := &v2GroupsDeleteOp{}
+	var v2groups *v2model.GroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2groups, groups)
+	}
+	o.op = s.s.GroupsDelete(v2groups)
+	return o
+}
+
+type v2GroupsDeleteOp struct {
+	op  *v2usergroups.GroupsDeleteOp
+	err error
+}
+
+func (o *v2GroupsDeleteOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.GroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupsList() GroupsListOp {
+	return &v2GroupsListOp{op: s.s.GroupsList()}
+}
+
+type v2GroupsListOp struct {
+	op  *v2usergroups.GroupsListOp
+	err error
+}
+
+func (o *v2GroupsListOp) Count(val int) GroupsListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2GroupsListOp) GroupType(val string) GroupsListOp {
+	o.op.GroupType(val)
+	return o
+}
+
+func (o *v2GroupsListOp) SearchText(val string) GroupsListOp {
+	o.op.SearchText(val)
+	return o
+}
+
+func (o *v2GroupsListOp) StartPosition(val int) GroupsListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2GroupsListOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.GroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GroupsUpdate(groups *model.GroupInformation) GroupsUpdateOp {
+	o := &v2GroupsUpdateOp{}
+	var v2groups *v2model.GroupInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2groups, groups)
+	}
+	o.op = s.s.GroupsUpdate(v2groups)
+	return o
+}
+
+type v2GroupsUpdateOp struct {
+	op  *v2usergroups.GroupsUpdateOp
+	err error
+}
+
+func (o *v2GroupsUpdateOp) Do(ctx context.Context) (*model.GroupInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.GroupInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -0,0 +1,1493 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package users defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 users services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/users"
+//	)
+//	...
+//	usersService := users.New(esignCredential, cfg.UseV2)
+package users // import "github.com/jfcote87/esign/compat/users"
+
+import (
+	"context"
+	"io"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/users"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2users "github.com/jfcote87/esign/v2/users"
+)
+
+// Service contains the ops shared by the v2 and v2.1 users services.
+type Service interface {
+	// ContactsCreate imports multiple new contacts into the contacts collection from CSV, JSON, or XML (based on content type).
+	ContactsCreate(contactModRequest *model.ContactModRequest) ContactsCreateOp
+	// ContactsDelete replaces a particular contact associated with an account for the DocuSign service.
+	ContactsDelete(contactID string) ContactsDeleteOp
+	// ContactsDeleteList delete contacts associated with an account for the DocuSign service.
+	ContactsDeleteList(contactModRequest *model.ContactModRequest) ContactsDeleteListOp
+	// ContactsGet gets a particular contact associated with the user's account.
+	ContactsGet(contactID string) ContactsGetOp
+	// ContactsUpdate replaces contacts associated with an account for the DocuSign service.
+	ContactsUpdate(contactModRequest *model.ContactModRequest) ContactsUpdateOp
+	// CustomSettingsDelete deletes custom user settings for a specified user.
+	CustomSettingsDelete(userID string, userCustomSettings *model.CustomSettingsInformation) CustomSettingsDeleteOp
+	// CustomSettingsList retrieves the custom user settings for a specified user.
+	CustomSettingsList(userID string) CustomSettingsListOp
+	// CustomSettingsUpdate adds or updates custom user settings for the specified user.
+	CustomSettingsUpdate(userID string, userCustomSettings *model.CustomSettingsInformation) CustomSettingsUpdateOp
+	// ProfilesGet retrieves the user profile for a specified user.
+	ProfilesGet(userID string) ProfilesGetOp
+	// ProfilesUpdate updates the user profile information for the specified user.
+	ProfilesUpdate(userID string, userProfiles *model.UserProfile) ProfilesUpdateOp
+	// SignaturesCreate adds user Signature and initials images to a Signature.
+	SignaturesCreate(userID string, userSignaturesInformation *model.UserSignaturesInformation, uploads ...*esign.UploadFile) SignaturesCreateOp
+	// SignaturesDelete removes removes signature information for the specified user.
+	SignaturesDelete(signatureID string, userID string) SignaturesDeleteOp
+	// SignaturesDeleteImage deletes the user initials image or the  user signature image for the specified user.
+	SignaturesDeleteImage(imageType string, signatureID string, userID string) SignaturesDeleteImageOp
+	// SignaturesGet gets the user signature information for the specified user.
+	SignaturesGet(signatureID string, userID string) SignaturesGetOp
+	// SignaturesGetImage retrieves the user initials image or the  user signature image for the specified user.
+	SignaturesGetImage(imageType string, signatureID string, userID string) SignaturesGetImageOp
+	// SignaturesList retrieves a list of user signature definitions for a specified user.
+	SignaturesList(userID string) SignaturesListOp
+	// SignaturesUpdate updates the user signature for a specified user.
+	SignaturesUpdate(signatureID string, userID string, userSignatureDefinition *model.UserSignatureDefinition) SignaturesUpdateOp
+	// SignaturesUpdateImage updates the user signature image or user initials image for the specified user.
+	SignaturesUpdateImage(imageType string, signatureID string, userID string, media io.Reader, mimeType string) SignaturesUpdateImageOp
+	// SignaturesUpdateList adds/updates a user signature.
+	SignaturesUpdateList(userID string, userSignaturesInformation *model.UserSignaturesInformation) SignaturesUpdateListOp
+	// Create adds new users to the specified account.
+	Create(newUsersDefinition *model.NewUsersDefinition) CreateOp
+	// Delete removes users account privileges.
+	Delete(userInfoList *model.UserInfoList) DeleteOp
+	// DeleteProfileImage deletes the user profile image for the specified user.
+	DeleteProfileImage(userID string) DeleteProfileImageOp
+	// Get gets the user information for a specified user.
+	Get(userID string) GetOp
+	// GetProfileImage retrieves the user profile image for the specified user.
+	GetProfileImage(userID string) GetProfileImageOp
+	// GetSettings gets the user account settings for a specified user.
+	GetSettings(userID string) GetSettingsOp
+	// List retrieves the list of users for the specified account.
+	List() ListOp
+	// Update updates user information for the specified user.
+	Update(userID string, users *model.UserInformation) UpdateOp
+	// UpdateList change one or more user in the specified account.
+	UpdateList(userInformationList *model.UserInformationList) UpdateListOp
+	// UpdateProfileImage updates the user profile image for a specified user.
+	UpdateProfileImage(userID string, media io.Reader, mimeType string) UpdateProfileImageOp
+	// UpdateSettings updates the user account settings for a specified user.
+	UpdateSettings(userID string, userSettingsInformation *model.UserSettingsInformation) UpdateSettingsOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2users.New(cred)}
+	}
+	return v21Service{s: users.New(cred)}
+}
+
+// ContactsCreateOp is the version-neutral ContactsCreate op.
+type ContactsCreateOp interface {
+	Do(ctx context.Context) (*model.ContactUpdateResponse, error)
+}
+
+// ContactsDeleteOp is the version-neutral ContactsDelete op.
+type ContactsDeleteOp interface {
+	Do(ctx context.Context) (*model.ContactUpdateResponse, error)
+}
+
+// ContactsDeleteListOp is the version-neutral ContactsDeleteList op.
+type ContactsDeleteListOp interface {
+	Do(ctx context.Context) (*model.ContactUpdateResponse, error)
+}
+
+// ContactsGetOp is the version-neutral ContactsGet op.
+type ContactsGetOp interface {
+	Do(ctx context.Context) (*model.ContactGetResponse, error)
+}
+
+// ContactsUpdateOp is the version-neutral ContactsUpdate op.
+type ContactsUpdateOp interface {
+	Do(ctx context.Context) (*model.ContactUpdateResponse, error)
+}
+
+// CustomSettingsDeleteOp is the version-neutral CustomSettingsDelete op.
+type CustomSettingsDeleteOp interface {
+	Do(ctx context.Context) (*model.CustomSettingsInformation, error)
+}
+
+// CustomSettingsListOp is the version-neutral CustomSettingsList op.
+type CustomSettingsListOp interface {
+	Do(ctx context.Context) (*model.CustomSettingsInformation, error)
+}
+
+// CustomSettingsUpdateOp is the version-neutral CustomSettingsUpdate op.
+type CustomSettingsUpdateOp interface {
+	Do(ctx context.Context) (*model.CustomSettingsInformation, error)
+}
+
+// ProfilesGetOp is the version-neutral ProfilesGet op.
+type ProfilesGetOp interface {
+	Do(ctx context.Context) (*model.UserProfile, error)
+}
+
+// ProfilesUpdateOp is the version-neutral ProfilesUpdate op.
+type ProfilesUpdateOp interface {
+	Do(ctx context.Context) error
+}
+
+// SignaturesCreateOp is the version-neutral SignaturesCreate op.
+type SignaturesCreateOp interface {
+	Do(ctx context.Context) (*model.UserSignaturesInformation, error)
+}
+
+// SignaturesDeleteOp is the version-neutral SignaturesDelete op.
+type SignaturesDeleteOp interface {
+	Do(ctx context.Context) error
+}
+
+// SignaturesDeleteImageOp is the version-neutral SignaturesDeleteImage op.
+type SignaturesDeleteImageOp interface {
+	Do(ctx context.Context) (*model.UserSignature, error)
+}
+
+// SignaturesGetOp is the version-neutral SignaturesGet op.
+type SignaturesGetOp interface {
+	Do(ctx context.Context) (*model.UserSignature, error)
+}
+
+// SignaturesGetImageOp is the version-neutral SignaturesGetImage op.
+type SignaturesGetImageOp interface {
+	IncludeChrome() SignaturesGetImageOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// SignaturesListOp is the version-neutral SignaturesList op.
+type SignaturesListOp interface {
+	StampType(val string) SignaturesListOp
+	Do(ctx context.Context) (*model.UserSignaturesInformation, error)
+}
+
+// SignaturesUpdateOp is the version-neutral SignaturesUpdate op.
+type SignaturesUpdateOp interface {
+	CloseExistingSignature() SignaturesUpdateOp
+	Do(ctx context.Context) (*model.UserSignature, error)
+}
+
+// SignaturesUpdateImageOp is the version-neutral SignaturesUpdateImage op.
+type SignaturesUpdateImageOp interface {
+	Do(ctx context.Context) (*model.UserSignature, error)
+}
+
+// SignaturesUpdateListOp is the version-neutral SignaturesUpdateList op.
+type SignaturesUpdateListOp interface {
+	Do(ctx context.Context) (*model.UserSignaturesInformation, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	Do(ctx context.Context) (*model.NewUsersSummary, error)
+}
+
+// DeleteOp is the version-neutral Delete op.
+type DeleteOp interface {
+	Delete(val string) DeleteOp
+	Do(ctx context.Context) (*model.UsersResponse, error)
+}
+
+// DeleteProfileImageOp is the version-neutral DeleteProfileImage op.
+type DeleteProfileImageOp interface {
+	Do(ctx context.Context) error
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	AdditionalInfo() GetOp
+	Email(val string) GetOp
+	Do(ctx context.Context) (*model.UserInformation, error)
+}
+
+// GetProfileImageOp is the version-neutral GetProfileImage op.
+type GetProfileImageOp interface {
+	Encoding(val string) GetProfileImageOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// GetSettingsOp is the version-neutral GetSettings op.
+type GetSettingsOp interface {
+	Do(ctx context.Context) (*model.UserSettingsInformation, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	AdditionalInfo() ListOp
+	Count(val int) ListOp
+	Email(val string) ListOp
+	EmailSubstring(val string) ListOp
+	GroupID(val string) ListOp
+	LoginStatus(val string) ListOp
+	NotGroupID(val string) ListOp
+	StartPosition(val int) ListOp
+	Status(val ...string) ListOp
+	UserNameSubstring(val string) ListOp
+	Do(ctx context.Context) (*model.UserInformationList, error)
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	Do(ctx context.Context) (*model.UserInformation, error)
+}
+
+// UpdateListOp is the version-neutral UpdateList op.
+type UpdateListOp interface {
+	Do(ctx context.Context) (*model.UserInformationList, error)
+}
+
+// UpdateProfileImageOp is the version-neutral UpdateProfileImage op.
+type UpdateProfileImageOp interface {
+	Do(ctx context.Context) error
+}
+
+// UpdateSettingsOp is the version-neutral UpdateSettings op.
+type UpdateSettingsOp interface {
+	Do(ctx context.Context) error
+}
+
+type v21Service struct {
+	s *users.Service
+}
+
+func (s v21Service) ContactsCreate(contactModRequest *model.ContactModRequest) ContactsCreateOp {
+	return &v21ContactsCreateOp{op: s.s.ContactsCreate(contactModRequest)}
+}
+
+type v21ContactsCreateOp struct {
+	op *users.ContactsCreateOp
+}
+
+func (o *v21ContactsCreateOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ContactsDelete(contactID string) ContactsDeleteOp {
+	return &v21ContactsDeleteOp{op: s.s.ContactsDelete(contactID)}
+}
+
+type v21ContactsDeleteOp struct {
+	op *users.ContactsDeleteOp
+}
+
+func (o *v21ContactsDeleteOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ContactsDeleteList(contactModRequest *model.ContactModRequest) ContactsDeleteListOp {
+	return &v21ContactsDeleteListOp{op: s.s.ContactsDeleteList(contactModRequest)}
+}
+
+type v21ContactsDeleteListOp struct {
+	op *users.ContactsDeleteListOp
+}
+
+func (o *v21ContactsDeleteListOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ContactsGet(contactID string) ContactsGetOp {
+	return &v21ContactsGetOp{op: s.s.ContactsGet(contactID)}
+}
+
+type v21ContactsGetOp struct {
+	op *users.ContactsGetOp
+}
+
+func (o *v21ContactsGetOp) Do(ctx context.Context) (*model.ContactGetResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ContactsUpdate(contactModRequest *model.ContactModRequest) ContactsUpdateOp {
+	return &v21ContactsUpdateOp{op: s.s.ContactsUpdate(contactModRequest)}
+}
+
+type v21ContactsUpdateOp struct {
+	op *users.ContactsUpdateOp
+}
+
+func (o *v21ContactsUpdateOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomSettingsDelete(userID string, userCustomSettings *model.CustomSettingsInformation) CustomSettingsDeleteOp {
+	return &v21CustomSettingsDeleteOp{op: s.s.CustomSettingsDelete(userID, userCustomSettings)}
+}
+
+type v21CustomSettingsDeleteOp struct {
+	op *users.CustomSettingsDeleteOp
+}
+
+func (o *v21CustomSettingsDeleteOp) Do(ctx context.Context) (*model.CustomSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomSettingsList(userID string) CustomSettingsListOp {
+	return &v21CustomSettingsListOp{op: s.s.CustomSettingsList(userID)}
+}
+
+type v21CustomSettingsListOp struct {
+	op *users.CustomSettingsListOp
+}
+
+func (o *v21CustomSettingsListOp) Do(ctx context.Context) (*model.CustomSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) CustomSettingsUpdate(userID string, userCustomSettings *model.CustomSettingsInformation) CustomSettingsUpdateOp {
+	return &v21CustomSettingsUpdateOp{op: s.s.CustomSettingsUpdate(userID, userCustomSettings)}
+}
+
+type v21CustomSettingsUpdateOp struct {
+	op *users.CustomSettingsUpdateOp
+}
+
+func (o *v21CustomSettingsUpdateOp) Do(ctx context.Context) (*model.CustomSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProfilesGet(userID string) ProfilesGetOp {
+	return &v21ProfilesGetOp{op: s.s.ProfilesGet(userID)}
+}
+
+type v21ProfilesGetOp struct {
+	op *users.ProfilesGetOp
+}
+
+func (o *v21ProfilesGetOp) Do(ctx context.Context) (*model.UserProfile, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ProfilesUpdate(userID string, userProfiles *model.UserProfile) ProfilesUpdateOp {
+	return &v21ProfilesUpdateOp{op: s.s.ProfilesUpdate(userID, userProfiles)}
+}
+
+type v21ProfilesUpdateOp struct {
+	op *users.ProfilesUpdateOp
+}
+
+func (o *v21ProfilesUpdateOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesCreate(userID string, userSignaturesInformation *model.UserSignaturesInformation, uploads ...*esign.UploadFile) SignaturesCreateOp {
+	return &v21SignaturesCreateOp{op: s.s.SignaturesCreate(userID, userSignaturesInformation, uploads...)}
+}
+
+type v21SignaturesCreateOp struct {
+	op *users.SignaturesCreateOp
+}
+
+func (o *v21SignaturesCreateOp) Do(ctx context.Context) (*model.UserSignaturesInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesDelete(signatureID string, userID string) SignaturesDeleteOp {
+	return &v21SignaturesDeleteOp{op: s.s.SignaturesDelete(signatureID, userID)}
+}
+
+type v21SignaturesDeleteOp struct {
+	op *users.SignaturesDeleteOp
+}
+
+func (o *v21SignaturesDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesDeleteImage(imageType string, signatureID string, userID string) SignaturesDeleteImageOp {
+	return &v21SignaturesDeleteImageOp{op: s.s.SignaturesDeleteImage(imageType, signatureID, userID)}
+}
+
+type v21SignaturesDeleteImageOp struct {
+	op *users.SignaturesDeleteImageOp
+}
+
+func (o *v21SignaturesDeleteImageOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesGet(signatureID string, userID string) SignaturesGetOp {
+	return &v21SignaturesGetOp{op: s.s.SignaturesGet(signatureID, userID)}
+}
+
+type v21SignaturesGetOp struct {
+	op *users.SignaturesGetOp
+}
+
+func (o *v21SignaturesGetOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesGetImage(imageType string, signatureID string, userID string) SignaturesGetImageOp {
+	return &v21SignaturesGetImageOp{op: s.s.SignaturesGetImage(imageType, signatureID, userID)}
+}
+
+type v21SignaturesGetImageOp struct {
+	op *users.SignaturesGetImageOp
+}
+
+func (o *v21SignaturesGetImageOp) IncludeChrome() SignaturesGetImageOp {
+	o.op.IncludeChrome()
+	return o
+}
+
+func (o *v21SignaturesGetImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesList(userID string) SignaturesListOp {
+	return &v21SignaturesListOp{op: s.s.SignaturesList(userID)}
+}
+
+type v21SignaturesListOp struct {
+	op *users.SignaturesListOp
+}
+
+func (o *v21SignaturesListOp) StampType(val string) SignaturesListOp {
+	o.op.StampType(val)
+	return o
+}
+
+func (o *v21SignaturesListOp) Do(ctx context.Context) (*model.UserSignaturesInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesUpdate(signatureID string, userID string, userSignatureDefinition *model.UserSignatureDefinition) SignaturesUpdateOp {
+	return &v21SignaturesUpdateOp{op: s.s.SignaturesUpdate(signatureID, userID, userSignatureDefinition)}
+}
+
+type v21SignaturesUpdateOp struct {
+	op *users.SignaturesUpdateOp
+}
+
+func (o *v21SignaturesUpdateOp) CloseExistingSignature() SignaturesUpdateOp {
+	o.op.CloseExistingSignature()
+	return o
+}
+
+func (o *v21SignaturesUpdateOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesUpdateImage(imageType string, signatureID string, userID string, media io.Reader, mimeType string) SignaturesUpdateImageOp {
+	return &v21SignaturesUpdateImageOp{op: s.s.SignaturesUpdateImage(imageType, signatureID, userID, media, mimeType)}
+}
+
+type v21SignaturesUpdateImageOp struct {
+	op *users.SignaturesUpdateImageOp
+}
+
+func (o *v21SignaturesUpdateImageOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) SignaturesUpdateList(userID string, userSignaturesInformation *model.UserSignaturesInformation) SignaturesUpdateListOp {
+	return &v21SignaturesUpdateListOp{op: s.s.SignaturesUpdateList(userID, userSignaturesInformation)}
+}
+
+type v21SignaturesUpdateListOp struct {
+	op *users.SignaturesUpdateListOp
+}
+
+func (o *v21SignaturesUpdateListOp) Do(ctx context.Context) (*model.UserSignaturesInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(newUsersDefinition *model.NewUsersDefinition) CreateOp {
+	return &v21CreateOp{op: s.s.Create(newUsersDefinition)}
+}
+
+type v21CreateOp struct {
+	op *users.CreateOp
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.NewUsersSummary, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Delete(userInfoList *model.UserInfoList) DeleteOp {
+	return &v21DeleteOp{op: s.s.Delete(userInfoList)}
+}
+
+type v21DeleteOp struct {
+	op *users.DeleteOp
+}
+
+func (o *v21DeleteOp) Delete(val string) DeleteOp {
+	o.op.Delete(val)
+	return o
+}
+
+func (o *v21DeleteOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) DeleteProfileImage(userID string) DeleteProfileImageOp {
+	return &v21DeleteProfileImageOp{op: s.s.DeleteProfileImage(userID)}
+}
+
+type v21DeleteProfileImageOp struct {
+	op *users.DeleteProfileImageOp
+}
+
+func (o *v21DeleteProfileImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(userID string) GetOp {
+	return &v21GetOp{op: s.s.Get(userID)}
+}
+
+type v21GetOp struct {
+	op *users.GetOp
+}
+
+func (o *v21GetOp) AdditionalInfo() GetOp {
+	o.op.AdditionalInfo()
+	return o
+}
+
+func (o *v21GetOp) Email(val string) GetOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.UserInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetProfileImage(userID string) GetProfileImageOp {
+	return &v21GetProfileImageOp{op: s.s.GetProfileImage(userID)}
+}
+
+type v21GetProfileImageOp struct {
+	op *users.GetProfileImageOp
+}
+
+func (o *v21GetProfileImageOp) Encoding(val string) GetProfileImageOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v21GetProfileImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) GetSettings(userID string) GetSettingsOp {
+	return &v21GetSettingsOp{op: s.s.GetSettings(userID)}
+}
+
+type v21GetSettingsOp struct {
+	op *users.GetSettingsOp
+}
+
+func (o *v21GetSettingsOp) Do(ctx context.Context) (*model.UserSettingsInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *users.ListOp
+}
+
+func (o *v21ListOp) AdditionalInfo() ListOp {
+	o.op.AdditionalInfo()
+	return o
+}
+
+func (o *v21ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ListOp) Email(val string) ListOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v21ListOp) EmailSubstring(val string) ListOp {
+	o.op.EmailSubstring(val)
+	return o
+}
+
+func (o *v21ListOp) GroupID(val string) ListOp {
+	o.op.GroupID(val)
+	return o
+}
+
+func (o *v21ListOp) LoginStatus(val string) ListOp {
+	o.op.LoginStatus(val)
+	return o
+}
+
+func (o *v21ListOp) NotGroupID(val string) ListOp {
+	o.op.NotGroupID(val)
+	return o
+}
+
+func (o *v21ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ListOp) Status(val ...string) ListOp {
+	o.op.Status(val...)
+	return o
+}
+
+func (o *v21ListOp) UserNameSubstring(val string) ListOp {
+	o.op.UserNameSubstring(val)
+	return o
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.UserInformationList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(userID string, users *model.UserInformation) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(userID, users)}
+}
+
+type v21UpdateOp struct {
+	op *users.UpdateOp
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.UserInformation, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateList(userInformationList *model.UserInformationList) UpdateListOp {
+	return &v21UpdateListOp{op: s.s.UpdateList(userInformationList)}
+}
+
+type v21UpdateListOp struct {
+	op *users.UpdateListOp
+}
+
+func (o *v21UpdateListOp) Do(ctx context.Context) (*model.UserInformationList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateProfileImage(userID string, media io.Reader, mimeType string) UpdateProfileImageOp {
+	return &v21UpdateProfileImageOp{op: s.s.UpdateProfileImage(userID, media, mimeType)}
+}
+
+type v21UpdateProfileImageOp struct {
+	op *users.UpdateProfileImageOp
+}
+
+func (o *v21UpdateProfileImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) UpdateSettings(userID string, userSettingsInformation *model.UserSettingsInformation) UpdateSettingsOp {
+	return &v21UpdateSettingsOp{op: s.s.UpdateSettings(userID, userSettingsInformation)}
+}
+
+type v21UpdateSettingsOp struct {
+	op *users.UpdateSettingsOp
+}
+
+func (o *v21UpdateSettingsOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2users.Service
+}
+
+func (s v2Service) ContactsCreate(contactModRequest *model.ContactModRequest) ContactsCreateOp {
+	o := &v2ContactsCreateOp{}
+	var v2contactModRequest *v2model.ContactModRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2contactModRequest, contactModRequest)
+	}
+	o.op = s.s.ContactsCreate(v2contactModRequest)
+	return o
+}
+
+type v2ContactsCreateOp struct {
+	op  *v2users.ContactsCreateOp
+	err error
+}
+
+func (o *v2ContactsCreateOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ContactUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ContactsDelete(contactID string) ContactsDeleteOp {
+	return &v2ContactsDeleteOp{op: s.s.ContactsDelete(contactID)}
+}
+
+type v2ContactsDeleteOp struct {
+	op  *v2users.ContactsDeleteOp
+	err error
+}
+
+func (o *v2ContactsDeleteOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ContactUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ContactsDeleteList(contactModRequest *model.ContactModRequest) ContactsDeleteListOp {
+	o := &v2ContactsDeleteListOp{}
+	var v2contactModRequest *v2model.ContactModRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2contactModRequest, contactModRequest)
+	}
+	o.op = s.s.ContactsDeleteList(v2contactModRequest)
+	return o
+}
+
+type v2ContactsDeleteListOp struct {
+	op  *v2users.ContactsDeleteListOp
+	err error
+}
+
+func (o *v2ContactsDeleteListOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ContactUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ContactsGet(contactID string) ContactsGetOp {
+	return &v2ContactsGetOp{op: s.s.ContactsGet(contactID)}
+}
+
+type v2ContactsGetOp struct {
+	op  *v2users.ContactsGetOp
+	err error
+}
+
+func (o *v2ContactsGetOp) Do(ctx context.Context) (*model.ContactGetResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ContactGetResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ContactsUpdate(contactModRequest *model.ContactModRequest) ContactsUpdateOp {
+	o := &v2ContactsUpdateOp{}
+	var v2contactModRequest *v2model.ContactModRequest
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2contactModRequest, contactModRequest)
+	}
+	o.op = s.s.ContactsUpdate(v2contactModRequest)
+	return o
+}
+
+type v2ContactsUpdateOp struct {
+	op  *v2users.ContactsUpdateOp
+	err error
+}
+
+func (o *v2ContactsUpdateOp) Do(ctx context.Context) (*model.ContactUpdateResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.ContactUpdateResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomSettingsDelete(userID string, userCustomSettings *model.CustomSettingsInformation) CustomSettingsDeleteOp {
+	o := &v2CustomSettingsDeleteOp{}
+	var v2userCustomSettings *v2model.CustomSettingsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userCustomSettings, userCustomSettings)
+	}
+	o.op = s.s.CustomSettingsDelete(userID, v2userCustomSettings)
+	return o
+}
+
+type v2CustomSettingsDeleteOp struct {
+	op  *v2users.CustomSettingsDeleteOp
+	err error
+}
+
+func (o *v2CustomSettingsDeleteOp) Do(ctx context.Context) (*model.CustomSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomSettingsList(userID string) CustomSettingsListOp {
+	return &v2CustomSettingsListOp{op: s.s.CustomSettingsList(userID)}
+}
+
+type v2CustomSettingsListOp struct {
+	op  *v2users.CustomSettingsListOp
+	err error
+}
+
+func (o *v2CustomSettingsListOp) Do(ctx context.Context) (*model.CustomSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) CustomSettingsUpdate(userID string, userCustomSettings *model.CustomSettingsInformation) CustomSettingsUpdateOp {
+	o := &v2CustomSettingsUpdateOp{}
+	var v2userCustomSettings *v2model.CustomSettingsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userCustomSettings, userCustomSettings)
+	}
+	o.op = s.s.CustomSettingsUpdate(userID, v2userCustomSettings)
+	return o
+}
+
+type v2CustomSettingsUpdateOp struct {
+	op  *v2users.CustomSettingsUpdateOp
+	err error
+}
+
+func (o *v2CustomSettingsUpdateOp) Do(ctx context.Context) (*model.CustomSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.CustomSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProfilesGet(userID string) ProfilesGetOp {
+	return &v2ProfilesGetOp{op: s.s.ProfilesGet(userID)}
+}
+
+type v2ProfilesGetOp struct {
+	op  *v2users.ProfilesGetOp
+	err error
+}
+
+func (o *v2ProfilesGetOp) Do(ctx context.Context) (*model.UserProfile, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserProfile
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ProfilesUpdate(userID string, userProfiles *model.UserProfile) ProfilesUpdateOp {
+	o := &v2ProfilesUpdateOp{}
+	var v2userProfiles *v2model.UserProfile
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userProfiles, userProfiles)
+	}
+	o.op = s.s.ProfilesUpdate(userID, v2userProfiles)
+	return o
+}
+
+type v2ProfilesUpdateOp struct {
+	op  *v2users.ProfilesUpdateOp
+	err error
+}
+
+func (o *v2ProfilesUpdateOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) SignaturesCreate(userID string, userSignaturesInformation *model.UserSignaturesInformation, uploads ...*esign.UploadFile) SignaturesCreateOp {
+	o := &v2SignaturesCreateOp{}
+	var v2userSignaturesInformation *v2model.UserSignaturesInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userSignaturesInformation, userSignaturesInformation)
+	}
+	o.op = s.s.SignaturesCreate(userID, v2userSignaturesInformation, uploads...)
+	return o
+}
+
+type v2SignaturesCreateOp struct {
+	op  *v2users.SignaturesCreateOp
+	err error
+}
+
+func (o *v2SignaturesCreateOp) Do(ctx context.Context) (*model.UserSignaturesInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignaturesInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignaturesDelete(signatureID string, userID string) SignaturesDeleteOp {
+	return &v2SignaturesDeleteOp{op: s.s.SignaturesDelete(signatureID, userID)}
+}
+
+type v2SignaturesDeleteOp struct {
+	op *v2users.SignaturesDeleteOp
+}
+
+func (o *v2SignaturesDeleteOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) SignaturesDeleteImage(imageType string, signatureID string, userID string) SignaturesDeleteImageOp {
+	return &v2SignaturesDeleteImageOp{op: s.s.SignaturesDeleteImage(imageType, signatureID, userID)}
+}
+
+type v2SignaturesDeleteImageOp struct {
+	op  *v2users.SignaturesDeleteImageOp
+	err error
+}
+
+func (o *v2SignaturesDeleteImageOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignature
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignaturesGet(signatureID string, userID string) SignaturesGetOp {
+	return &v2SignaturesGetOp{op: s.s.SignaturesGet(signatureID, userID)}
+}
+
+type v2SignaturesGetOp struct {
+	op  *v2users.SignaturesGetOp
+	err error
+}
+
+func (o *v2SignaturesGetOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignature
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignaturesGetImage(imageType string, signatureID string, userID string) SignaturesGetImageOp {
+	return &v2SignaturesGetImageOp{op: s.s.SignaturesGetImage(imageType, signatureID, userID)}
+}
+
+type v2SignaturesGetImageOp struct {
+	op *v2users.SignaturesGetImageOp
+}
+
+func (o *v2SignaturesGetImageOp) IncludeChrome() SignaturesGetImageOp {
+	o.op.IncludeChrome()
+	return o
+}
+
+func (o *v2SignaturesGetImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) SignaturesList(userID string) SignaturesListOp {
+	return &v2SignaturesListOp{op: s.s.SignaturesList(userID)}
+}
+
+type v2SignaturesListOp struct {
+	op  *v2users.SignaturesListOp
+	err error
+}
+
+func (o *v2SignaturesListOp) StampType(val string) SignaturesListOp {
+	o.op.StampType(val)
+	return o
+}
+
+func (o *v2SignaturesListOp) Do(ctx context.Context) (*model.UserSignaturesInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignaturesInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignaturesUpdate(signatureID string, userID string, userSignatureDefinition *model.UserSignatureDefinition) SignaturesUpdateOp {
+	o := &v2SignaturesUpdateOp{}
+	var v2userSignatureDefinition *v2model.UserSignatureDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userSignatureDefinition, userSignatureDefinition)
+	}
+	o.op = s.s.SignaturesUpdate(signatureID, userID, v2userSignatureDefinition)
+	return o
+}
+
+type v2SignaturesUpdateOp struct {
+	op  *v2users.SignaturesUpdateOp
+	err error
+}
+
+func (o *v2SignaturesUpdateOp) CloseExistingSignature() SignaturesUpdateOp {
+	o.op.CloseExistingSignature()
+	return o
+}
+
+func (o *v2SignaturesUpdateOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignature
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignaturesUpdateImage(imageType string, signatureID string, userID string, media io.Reader, mimeType string) SignaturesUpdateImageOp {
+	return &v2SignaturesUpdateImageOp{op: s.s.SignaturesUpdateImage(imageType, signatureID, userID, media, mimeType)}
+}
+
+type v2SignaturesUpdateImageOp struct {
+	op  *v2users.SignaturesUpdateImageOp
+	err error
+}
+
+func (o *v2SignaturesUpdateImageOp) Do(ctx context.Context) (*model.UserSignature, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignature
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) SignaturesUpdateList(userID string, userSignaturesInformation *model.UserSignaturesInformation) SignaturesUpdateListOp {
+	o := &v2SignaturesUpdateListOp{}
+	var v2userSignaturesInformation *v2model.UserSignaturesInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userSignaturesInformation, userSignaturesInformation)
+	}
+	o.op = s.s.SignaturesUpdateList(userID, v2userSignaturesInformation)
+	return o
+}
+
+type v2SignaturesUpdateListOp struct {
+	op  *v2users.SignaturesUpdateListOp
+	err error
+}
+
+func (o *v2SignaturesUpdateListOp) Do(ctx context.Context) (*model.UserSignaturesInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSignaturesInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(newUsersDefinition *model.NewUsersDefinition) CreateOp {
+	o := &v2CreateOp{}
+	var v2newUsersDefinition *v2model.NewUsersDefinition
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2newUsersDefinition, newUsersDefinition)
+	}
+	o.op = s.s.Create(v2newUsersDefinition)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2users.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.NewUsersSummary, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.NewUsersSummary
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Delete(userInfoList *model.UserInfoList) DeleteOp {
+	o := &v2DeleteOp{}
+	var v2userInfoList *v2model.UserInfoList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userInfoList, userInfoList)
+	}
+	o.op = s.s.Delete(v2userInfoList)
+	return o
+}
+
+type v2DeleteOp struct {
+	op  *v2users.DeleteOp
+	err error
+}
+
+func (o *v2DeleteOp) Delete(val string) DeleteOp {
+	o.op.Delete(val)
+	return o
+}
+
+func (o *v2DeleteOp) Do(ctx context.Context) (*model.UsersResponse, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UsersResponse
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) DeleteProfileImage(userID string) DeleteProfileImageOp {
+	return &v2DeleteProfileImageOp{op: s.s.DeleteProfileImage(userID)}
+}
+
+type v2DeleteProfileImageOp struct {
+	op *v2users.DeleteProfileImageOp
+}
+
+func (o *v2DeleteProfileImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) Get(userID string) GetOp {
+	return &v2GetOp{op: s.s.Get(userID)}
+}
+
+type v2GetOp struct {
+	op  *v2users.GetOp
+	err error
+}
+
+func (o *v2GetOp) AdditionalInfo() GetOp {
+	o.op.AdditionalInfo()
+	return o
+}
+
+func (o *v2GetOp) Email(val string) GetOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.UserInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) GetProfileImage(userID string) GetProfileImageOp {
+	return &v2GetProfileImageOp{op: s.s.GetProfileImage(userID)}
+}
+
+type v2GetProfileImageOp struct {
+	op *v2users.GetProfileImageOp
+}
+
+func (o *v2GetProfileImageOp) Encoding(val string) GetProfileImageOp {
+	o.op.Encoding(val)
+	return o
+}
+
+func (o *v2GetProfileImageOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) GetSettings(userID string) GetSettingsOp {
+	return &v2GetSettingsOp{op: s.s.GetSettings(userID)}
+}
+
+type v2GetSettingsOp struct {
+	op  *v2users.GetSettingsOp
+	err error
+}
+
+func (o *v2GetSettingsOp) Do(ctx context.Context) (*model.UserSettingsInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserSettingsInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2users.ListOp
+	err error
+}
+
+func (o *v2ListOp) AdditionalInfo() ListOp {
+	o.op.AdditionalInfo()
+	return o
+}
+
+func (o *v2ListOp) Count(val int) ListOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ListOp) Email(val string) ListOp {
+	o.op.Email(val)
+	return o
+}
+
+func (o *v2ListOp) EmailSubstring(val string) ListOp {
+	o.op.EmailSubstring(val)
+	return o
+}
+
+func (o *v2ListOp) GroupID(val string) ListOp {
+	o.op.GroupID(val)
+	return o
+}
+
+func (o *v2ListOp) LoginStatus(val string) ListOp {
+	o.op.LoginStatus(val)
+	return o
+}
+
+func (o *v2ListOp) NotGroupID(val string) ListOp {
+	o.op.NotGroupID(val)
+	return o
+}
+
+func (o *v2ListOp) StartPosition(val int) ListOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ListOp) Status(val ...string) ListOp {
+	o.op.Status(val...)
+	return o
+}
+
+func (o *v2ListOp) UserNameSubstring(val string) ListOp {
+	o.op.UserNameSubstring(val)
+	return o
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.UserInformationList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserInformationList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Update(userID string, users *model.UserInformation) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2users *v2model.UserInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2users, users)
+	}
+	o.op = s.s.Update(userID, v2users)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2users.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.UserInformation, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserInformation
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateList(userInformationList *model.UserInformationList) UpdateListOp {
+	o := &v2UpdateListOp{}
+	var v2userInformationList *v2model.UserInformationList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userInformationList, userInformationList)
+	}
+	o.op = s.s.UpdateList(v2userInformationList)
+	return o
+}
+
+type v2UpdateListOp struct {
+	op  *v2users.UpdateListOp
+	err error
+}
+
+func (o *v2UpdateListOp) Do(ctx context.Context) (*model.UserInformationList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.UserInformationList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) UpdateProfileImage(userID string, media io.Reader, mimeType string) UpdateProfileImageOp {
+	return &v2UpdateProfileImageOp{op: s.s.UpdateProfileImage(userID, media, mimeType)}
+}
+
+type v2UpdateProfileImageOp struct {
+	op *v2users.UpdateProfileImageOp
+}
+
+func (o *v2UpdateProfileImageOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) UpdateSettings(userID string, userSettingsInformation *model.UserSettingsInformation) UpdateSettingsOp {
+	o := &v2UpdateSettingsOp{}
+	var v2userSettingsInformation *v2model.UserSettingsInformation
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2userSettingsInformation, userSettingsInformation)
+	}
+	o.op = s.s.UpdateSettings(userID, v2userSettingsInformation)
+	return o
+}
+
+type v2UpdateSettingsOp struct {
+	op  *v2users.UpdateSettingsOp
+	err error
+}
+
+func (o *v2UpdateSettingsOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
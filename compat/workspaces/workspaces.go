@@ -0,0 +1,661 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Code generated by gen-esign; DO NOT EDIT.
+
+// Package workspaces defines version-neutral interfaces for the
+// operations shared by the v2 and v2.1 workspaces services.  Values
+// use the v2.1 model.  The v2 implementation converts model parameters
+// and results with compat.Convert.  A parameter value without a v2 field
+// causes the op to return a *compat.DroppedFieldsError; v2 result values
+// without a v2.1 field are discarded.
+//
+// Usage example:
+//
+//	import (
+//	    "github.com/jfcote87/esign/compat/workspaces"
+//	)
+//	...
+//	workspacesService := workspaces.New(esignCredential, cfg.UseV2)
+package workspaces // import "github.com/jfcote87/esign/compat/workspaces"
+
+import (
+	"context"
+	"io"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/compat"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/esign/v2.1/workspaces"
+	v2model "github.com/jfcote87/esign/v2/model"
+	v2workspaces "github.com/jfcote87/esign/v2/workspaces"
+)
+
+// Service contains the ops shared by the v2 and v2.1 workspaces services.
+type Service interface {
+	// ItemsCreateFIle creates a workspace file.
+	ItemsCreateFIle(folderID string, workspaceID string, media io.Reader, mimeType string) ItemsCreateFIleOp
+	// ItemsDeleteFolderItems deletes workspace one or more specific files/folders from the given folder or root.
+	ItemsDeleteFolderItems(folderID string, workspaceID string, workspaceItemList *model.WorkspaceItemList) ItemsDeleteFolderItemsOp
+	// ItemsGetFile get Workspace File
+	ItemsGetFile(fileID string, folderID string, workspaceID string) ItemsGetFileOp
+	// ItemsListFilePages list File Pages
+	ItemsListFilePages(fileID string, folderID string, workspaceID string) ItemsListFilePagesOp
+	// ItemsListFolderItems list Workspace Folder Contents
+	ItemsListFolderItems(folderID string, workspaceID string) ItemsListFolderItemsOp
+	// ItemsUpdateFile update Workspace File Metadata
+	ItemsUpdateFile(fileID string, folderID string, workspaceID string, media io.Reader, mimeType string) ItemsUpdateFileOp
+	// Create create a Workspace
+	Create(workspaces *model.Workspace) CreateOp
+	// Delete delete Workspace
+	Delete(workspaceID string) DeleteOp
+	// Get get Workspace
+	Get(workspaceID string) GetOp
+	// List list Workspaces
+	List() ListOp
+	// Update update Workspace
+	Update(workspaceID string, workspaces *model.Workspace) UpdateOp
+}
+
+// New returns the v2 service if useV2 is set; otherwise the v2.1 service.
+func New(cred esign.Credential, useV2 bool) Service {
+	if useV2 {
+		return v2Service{s: v2workspaces.New(cred)}
+	}
+	return v21Service{s: workspaces.New(cred)}
+}
+
+// ItemsCreateFIleOp is the version-neutral ItemsCreateFIle op.
+type ItemsCreateFIleOp interface {
+	Do(ctx context.Context) (*model.WorkspaceItem, error)
+}
+
+// ItemsDeleteFolderItemsOp is the version-neutral ItemsDeleteFolderItems op.
+type ItemsDeleteFolderItemsOp interface {
+	Do(ctx context.Context) error
+}
+
+// ItemsGetFileOp is the version-neutral ItemsGetFile op.
+type ItemsGetFileOp interface {
+	IsDownload() ItemsGetFileOp
+	PdfVersion() ItemsGetFileOp
+	Do(ctx context.Context) (*esign.Download, error)
+}
+
+// ItemsListFilePagesOp is the version-neutral ItemsListFilePages op.
+type ItemsListFilePagesOp interface {
+	Count(val int) ItemsListFilePagesOp
+	Dpi(val int) ItemsListFilePagesOp
+	MaxHeight(val int) ItemsListFilePagesOp
+	MaxWidth(val int) ItemsListFilePagesOp
+	StartPosition(val int) ItemsListFilePagesOp
+	Do(ctx context.Context) (*model.PageImages, error)
+}
+
+// ItemsListFolderItemsOp is the version-neutral ItemsListFolderItems op.
+type ItemsListFolderItemsOp interface {
+	Count(val int) ItemsListFolderItemsOp
+	IncludeFiles() ItemsListFolderItemsOp
+	IncludeSubFolders() ItemsListFolderItemsOp
+	IncludeThumbnails() ItemsListFolderItemsOp
+	IncludeUserDetail() ItemsListFolderItemsOp
+	StartPosition(val int) ItemsListFolderItemsOp
+	WorkspaceUserID(val string) ItemsListFolderItemsOp
+	Do(ctx context.Context) (*model.WorkspaceFolderContents, error)
+}
+
+// ItemsUpdateFileOp is the version-neutral ItemsUpdateFile op.
+type ItemsUpdateFileOp interface {
+	Do(ctx context.Context) (*model.WorkspaceItem, error)
+}
+
+// CreateOp is the version-neutral Create op.
+type CreateOp interface {
+	Do(ctx context.Context) (*model.Workspace, error)
+}
+
+// DeleteOp is the version-neutral Delete op.
+type DeleteOp interface {
+	Do(ctx context.Context) (*model.Workspace, error)
+}
+
+// GetOp is the version-neutral Get op.
+type GetOp interface {
+	Do(ctx context.Context) (*model.Workspace, error)
+}
+
+// ListOp is the version-neutral List op.
+type ListOp interface {
+	Do(ctx context.Context) (*model.WorkspaceList, error)
+}
+
+// UpdateOp is the version-neutral Update op.
+type UpdateOp interface {
+	Do(ctx context.Context) (*model.Workspace, error)
+}
+
+type v21Service struct {
+	s *workspaces.Service
+}
+
+func (s v21Service) ItemsCreateFIle(folderID string, workspaceID string, media io.Reader, mimeType string) ItemsCreateFIleOp {
+	return &v21ItemsCreateFIleOp{op: s.s.ItemsCreateFIle(folderID, workspaceID, media, mimeType)}
+}
+
+type v21ItemsCreateFIleOp struct {
+	op *workspaces.ItemsCreateFIleOp
+}
+
+func (o *v21ItemsCreateFIleOp) Do(ctx context.Context) (*model.WorkspaceItem, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ItemsDeleteFolderItems(folderID string, workspaceID string, workspaceItemList *model.WorkspaceItemList) ItemsDeleteFolderItemsOp {
+	return &v21ItemsDeleteFolderItemsOp{op: s.s.ItemsDeleteFolderItems(folderID, workspaceID, workspaceItemList)}
+}
+
+type v21ItemsDeleteFolderItemsOp struct {
+	op *workspaces.ItemsDeleteFolderItemsOp
+}
+
+func (o *v21ItemsDeleteFolderItemsOp) Do(ctx context.Context) error {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ItemsGetFile(fileID string, folderID string, workspaceID string) ItemsGetFileOp {
+	return &v21ItemsGetFileOp{op: s.s.ItemsGetFile(fileID, folderID, workspaceID)}
+}
+
+type v21ItemsGetFileOp struct {
+	op *workspaces.ItemsGetFileOp
+}
+
+func (o *v21ItemsGetFileOp) IsDownload() ItemsGetFileOp {
+	o.op.IsDownload()
+	return o
+}
+
+func (o *v21ItemsGetFileOp) PdfVersion() ItemsGetFileOp {
+	o.op.PdfVersion()
+	return o
+}
+
+func (o *v21ItemsGetFileOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ItemsListFilePages(fileID string, folderID string, workspaceID string) ItemsListFilePagesOp {
+	return &v21ItemsListFilePagesOp{op: s.s.ItemsListFilePages(fileID, folderID, workspaceID)}
+}
+
+type v21ItemsListFilePagesOp struct {
+	op *workspaces.ItemsListFilePagesOp
+}
+
+func (o *v21ItemsListFilePagesOp) Count(val int) ItemsListFilePagesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ItemsListFilePagesOp) Dpi(val int) ItemsListFilePagesOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v21ItemsListFilePagesOp) MaxHeight(val int) ItemsListFilePagesOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v21ItemsListFilePagesOp) MaxWidth(val int) ItemsListFilePagesOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v21ItemsListFilePagesOp) StartPosition(val int) ItemsListFilePagesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ItemsListFilePagesOp) Do(ctx context.Context) (*model.PageImages, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ItemsListFolderItems(folderID string, workspaceID string) ItemsListFolderItemsOp {
+	return &v21ItemsListFolderItemsOp{op: s.s.ItemsListFolderItems(folderID, workspaceID)}
+}
+
+type v21ItemsListFolderItemsOp struct {
+	op *workspaces.ItemsListFolderItemsOp
+}
+
+func (o *v21ItemsListFolderItemsOp) Count(val int) ItemsListFolderItemsOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) IncludeFiles() ItemsListFolderItemsOp {
+	o.op.IncludeFiles()
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) IncludeSubFolders() ItemsListFolderItemsOp {
+	o.op.IncludeSubFolders()
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) IncludeThumbnails() ItemsListFolderItemsOp {
+	o.op.IncludeThumbnails()
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) IncludeUserDetail() ItemsListFolderItemsOp {
+	o.op.IncludeUserDetail()
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) StartPosition(val int) ItemsListFolderItemsOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) WorkspaceUserID(val string) ItemsListFolderItemsOp {
+	o.op.WorkspaceUserID(val)
+	return o
+}
+
+func (o *v21ItemsListFolderItemsOp) Do(ctx context.Context) (*model.WorkspaceFolderContents, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) ItemsUpdateFile(fileID string, folderID string, workspaceID string, media io.Reader, mimeType string) ItemsUpdateFileOp {
+	return &v21ItemsUpdateFileOp{op: s.s.ItemsUpdateFile(fileID, folderID, workspaceID, media, mimeType)}
+}
+
+type v21ItemsUpdateFileOp struct {
+	op *workspaces.ItemsUpdateFileOp
+}
+
+func (o *v21ItemsUpdateFileOp) Do(ctx context.Context) (*model.WorkspaceItem, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Create(workspaces *model.Workspace) CreateOp {
+	return &v21CreateOp{op: s.s.Create(workspaces)}
+}
+
+type v21CreateOp struct {
+	op *workspaces.CreateOp
+}
+
+func (o *v21CreateOp) Do(ctx context.Context) (*model.Workspace, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Delete(workspaceID string) DeleteOp {
+	return &v21DeleteOp{op: s.s.Delete(workspaceID)}
+}
+
+type v21DeleteOp struct {
+	op *workspaces.DeleteOp
+}
+
+func (o *v21DeleteOp) Do(ctx context.Context) (*model.Workspace, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Get(workspaceID string) GetOp {
+	return &v21GetOp{op: s.s.Get(workspaceID)}
+}
+
+type v21GetOp struct {
+	op *workspaces.GetOp
+}
+
+func (o *v21GetOp) Do(ctx context.Context) (*model.Workspace, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) List() ListOp {
+	return &v21ListOp{op: s.s.List()}
+}
+
+type v21ListOp struct {
+	op *workspaces.ListOp
+}
+
+func (o *v21ListOp) Do(ctx context.Context) (*model.WorkspaceList, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v21Service) Update(workspaceID string, workspaces *model.Workspace) UpdateOp {
+	return &v21UpdateOp{op: s.s.Update(workspaceID, workspaces)}
+}
+
+type v21UpdateOp struct {
+	op *workspaces.UpdateOp
+}
+
+func (o *v21UpdateOp) Do(ctx context.Context) (*model.Workspace, error) {
+	return o.op.Do(ctx)
+}
+
+type v2Service struct {
+	s *v2workspaces.Service
+}
+
+func (s v2Service) ItemsCreateFIle(folderID string, workspaceID string, media io.Reader, mimeType string) ItemsCreateFIleOp {
+	return &v2ItemsCreateFIleOp{op: s.s.ItemsCreateFIle(folderID, workspaceID, media, mimeType)}
+}
+
+type v2ItemsCreateFIleOp struct {
+	op  *v2workspaces.ItemsCreateFIleOp
+	err error
+}
+
+func (o *v2ItemsCreateFIleOp) Do(ctx context.Context) (*model.WorkspaceItem, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.WorkspaceItem
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ItemsDeleteFolderItems(folderID string, workspaceID string, workspaceItemList *model.WorkspaceItemList) ItemsDeleteFolderItemsOp {
+	o := &v2ItemsDeleteFolderItemsOp{}
+	var v2workspaceItemList *v2model.WorkspaceItemList
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2workspaceItemList, workspaceItemList)
+	}
+	o.op = s.s.ItemsDeleteFolderItems(folderID, workspaceID, v2workspaceItemList)
+	return o
+}
+
+type v2ItemsDeleteFolderItemsOp struct {
+	op  *v2workspaces.ItemsDeleteFolderItemsOp
+	err error
+}
+
+func (o *v2ItemsDeleteFolderItemsOp) Do(ctx context.Context) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) ItemsGetFile(fileID string, folderID string, workspaceID string) ItemsGetFileOp {
+	return &v2ItemsGetFileOp{op: s.s.ItemsGetFile(fileID, folderID, workspaceID)}
+}
+
+type v2ItemsGetFileOp struct {
+	op *v2workspaces.ItemsGetFileOp
+}
+
+func (o *v2ItemsGetFileOp) IsDownload() ItemsGetFileOp {
+	o.op.IsDownload()
+	return o
+}
+
+func (o *v2ItemsGetFileOp) PdfVersion() ItemsGetFileOp {
+	o.op.PdfVersion()
+	return o
+}
+
+func (o *v2ItemsGetFileOp) Do(ctx context.Context) (*esign.Download, error) {
+	return o.op.Do(ctx)
+}
+
+func (s v2Service) ItemsListFilePages(fileID string, folderID string, workspaceID string) ItemsListFilePagesOp {
+	return &v2ItemsListFilePagesOp{op: s.s.ItemsListFilePages(fileID, folderID, workspaceID)}
+}
+
+type v2ItemsListFilePagesOp struct {
+	op  *v2workspaces.ItemsListFilePagesOp
+	err error
+}
+
+func (o *v2ItemsListFilePagesOp) Count(val int) ItemsListFilePagesOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ItemsListFilePagesOp) Dpi(val int) ItemsListFilePagesOp {
+	o.op.Dpi(val)
+	return o
+}
+
+func (o *v2ItemsListFilePagesOp) MaxHeight(val int) ItemsListFilePagesOp {
+	o.op.MaxHeight(val)
+	return o
+}
+
+func (o *v2ItemsListFilePagesOp) MaxWidth(val int) ItemsListFilePagesOp {
+	o.op.MaxWidth(val)
+	return o
+}
+
+func (o *v2ItemsListFilePagesOp) StartPosition(val int) ItemsListFilePagesOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ItemsListFilePagesOp) Do(ctx context.Context) (*model.PageImages, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.PageImages
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ItemsListFolderItems(folderID string, workspaceID string) ItemsListFolderItemsOp {
+	return &v2ItemsListFolderItemsOp{op: s.s.ItemsListFolderItems(folderID, workspaceID)}
+}
+
+type v2ItemsListFolderItemsOp struct {
+	op  *v2workspaces.ItemsListFolderItemsOp
+	err error
+}
+
+func (o *v2ItemsListFolderItemsOp) Count(val int) ItemsListFolderItemsOp {
+	o.op.Count(val)
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) IncludeFiles() ItemsListFolderItemsOp {
+	o.op.IncludeFiles()
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) IncludeSubFolders() ItemsListFolderItemsOp {
+	o.op.IncludeSubFolders()
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) IncludeThumbnails() ItemsListFolderItemsOp {
+	o.op.IncludeThumbnails()
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) IncludeUserDetail() ItemsListFolderItemsOp {
+	o.op.IncludeUserDetail()
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) StartPosition(val int) ItemsListFolderItemsOp {
+	o.op.StartPosition(val)
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) WorkspaceUserID(val string) ItemsListFolderItemsOp {
+	o.op.WorkspaceUserID(val)
+	return o
+}
+
+func (o *v2ItemsListFolderItemsOp) Do(ctx context.Context) (*model.WorkspaceFolderContents, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.WorkspaceFolderContents
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) ItemsUpdateFile(fileID string, folderID string, workspaceID string, media io.Reader, mimeType string) ItemsUpdateFileOp {
+	return &v2ItemsUpdateFileOp{op: s.s.ItemsUpdateFile(fileID, folderID, workspaceID, media, mimeType)}
+}
+
+type v2ItemsUpdateFileOp struct {
+	op  *v2workspaces.ItemsUpdateFileOp
+	err error
+}
+
+func (o *v2ItemsUpdateFileOp) Do(ctx context.Context) (*model.WorkspaceItem, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.WorkspaceItem
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Create(workspaces *model.Workspace) CreateOp {
+	o := &v2CreateOp{}
+	var v2workspaces *v2model.Workspace
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2workspaces, workspaces)
+	}
+	o.op = s.s.Create(v2workspaces)
+	return o
+}
+
+type v2CreateOp struct {
+	op  *v2workspaces.CreateOp
+	err error
+}
+
+func (o *v2CreateOp) Do(ctx context.Context) (*model.Workspace, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Workspace
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Delete(workspaceID string) DeleteOp {
+	return &v2DeleteOp{op: s.s.Delete(workspaceID)}
+}
+
+type v2DeleteOp struct {
+	op  *v2workspaces.DeleteOp
+	err error
+}
+
+func (o *v2DeleteOp) Do(ctx context.Context) (*model.Workspace, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Workspace
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Get(workspaceID string) GetOp {
+	return &v2GetOp{op: s.s.Get(workspaceID)}
+}
+
+type v2GetOp struct {
+	op  *v2workspaces.GetOp
+	err error
+}
+
+func (o *v2GetOp) Do(ctx context.Context) (*model.Workspace, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Workspace
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) List() ListOp {
+	return &v2ListOp{op: s.s.List()}
+}
+
+type v2ListOp struct {
+	op  *v2workspaces.ListOp
+	err error
+}
+
+func (o *v2ListOp) Do(ctx context.Context) (*model.WorkspaceList, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.WorkspaceList
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
+
+func (s v2Service) Update(workspaceID string, workspaces *model.Workspace) UpdateOp {
+	o := &v2UpdateOp{}
+	var v2workspaces *v2model.Workspace
+	if o.err == nil {
+		o.err = compat.ConvertAll(&v2workspaces, workspaces)
+	}
+	o.op = s.s.Update(workspaceID, v2workspaces)
+	return o
+}
+
+type v2UpdateOp struct {
+	op  *v2workspaces.UpdateOp
+	err error
+}
+
+func (o *v2UpdateOp) Do(ctx context.Context) (*model.Workspace, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+	res, err := o.op.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result *model.Workspace
+	_, err = compat.Convert(&result, res)
+	return result, err
+}
@@ -11,6 +11,7 @@
 package esign
 
 import (
+	"strconv"
 	"strings"
 	"time"
 )
@@ -20,8 +21,17 @@ import (
 type DSTime string
 
 // Time converts a DSTime value into a time.time.  On error or
-// unknown format, the zero value of time.Time is returned.
+// unknown format, the zero value of time.Time is returned.  Values
+// without a time zone are treated as UTC.
 func (d *DSTime) Time() time.Time {
+	return d.TimeIn(time.UTC)
+}
+
+// TimeIn converts a DSTime value into a time.time interpreting values
+// without a time zone, such as the times of Connect xml messages, in
+// loc.  On error or unknown format, the zero value of time.Time is
+// returned.
+func (d *DSTime) TimeIn(loc *time.Location) time.Time {
 	var tm time.Time
 	if d == nil {
 		return tm
@@ -31,7 +41,7 @@ func (d *DSTime) Time() time.Time {
 		tm, _ = time.Parse(time.RFC3339Nano, s)
 		return tm
 	}
-	tm, _ = time.Parse("2006-01-02T15:04:05.999999999", s)
+	tm, _ = time.ParseInLocation("2006-01-02T15:04:05.999999999", s, loc)
 	return tm
 }
 
@@ -39,6 +49,26 @@ func (d *DSTime) Time() time.Time {
 type ConnectData struct {
 	EnvelopeStatus EnvelopeStatusXML `xml:"EnvelopeStatus" json:"envelopeStatus,omitempty"`
 	DocumentPdfs   []DocumentPdfXML  `xml:"DocumentPDFs>DocumentPDF" json:"documentPdfs,omitempty"`
+	// TimeZone and TimeZoneOffset (hours from UTC) describe the account's
+	// time zone.  They are sent when the configuration includes time zone
+	// information.
+	TimeZone       string `xml:"TimeZone" json:"timeZone,omitempty"`
+	TimeZoneOffset string `xml:"TimeZoneOffset" json:"timeZoneOffset,omitempty"`
+}
+
+// Location returns the time zone of the message's times.  Connect sends
+// times in the account's time zone without an offset.  If the message
+// has no valid TimeZoneOffset, UTC is returned.
+func (cd *ConnectData) Location() *time.Location {
+	hours, err := strconv.ParseFloat(strings.TrimSpace(cd.TimeZoneOffset), 64)
+	if err != nil || hours == 0 {
+		return time.UTC
+	}
+	name := cd.TimeZone
+	if name == "" {
+		name = "UTC" + cd.TimeZoneOffset
+	}
+	return time.FixedZone(name, int(hours*3600))
 }
 
 // EnvelopeStatusXML contains envelope information.
@@ -7,6 +7,7 @@ package esign_test
 import (
 	"bytes"
 	"encoding/xml"
+	"io/ioutil"
 	"os"
 	"testing"
 	"time"
@@ -41,3 +42,47 @@ func TestXML(t *testing.T) {
 	}
 	return
 }
+
+func TestDecodeConnectEvent(t *testing.T) {
+	for _, tt := range []struct {
+		file       string
+		event      string
+		status     string
+		recipients int
+		recipient  string
+	}{
+		{"testdata/connect.xml", "envelope-completed", "Completed", 2, ""},
+		{"testdata/connect.json", "recipient-completed", "sent", 2, "bob.smith@example.com"},
+	} {
+		b, err := ioutil.ReadFile(tt.file)
+		if err != nil {
+			t.Fatalf("read %s: %v", tt.file, err)
+		}
+		ev, err := esign.DecodeConnectEvent(b)
+		if err != nil {
+			t.Errorf("%s: decode %v", tt.file, err)
+			continue
+		}
+		if ev.Event != tt.event || ev.Status != tt.status || ev.EnvelopeID != "8e0069bb-6193-46b0-b616-3914477b13a5" {
+			t.Errorf("%s: expected %s %s; got %s %s %s", tt.file, tt.event, tt.status, ev.Event, ev.Status, ev.EnvelopeID)
+		}
+		if len(ev.Recipients) != tt.recipients || ev.GeneratedAt.IsZero() || ev.Sent.IsZero() {
+			t.Errorf("%s: expected %d recipients and timestamps; got %#v", tt.file, tt.recipients, ev)
+		}
+		if tt.recipient == "" {
+			if ev.Recipient != nil || ev.XML == nil || ev.Envelope == nil || ev.Envelope.EnvelopeID != ev.EnvelopeID {
+				t.Errorf("%s: expected envelope level xml event; got %#v", tt.file, ev)
+			}
+			continue
+		}
+		if ev.Recipient == nil || ev.Recipient.Email != tt.recipient || ev.Recipient.Delivered.IsZero() {
+			t.Errorf("%s: expected recipient %s; got %#v", tt.file, tt.recipient, ev.Recipient)
+		}
+		if ev.JSON == nil || ev.Envelope == nil || ev.Envelope.EmailSubject != "Please sign" || ev.AccountID == "" {
+			t.Errorf("%s: expected json message and envelope summary; got %#v", tt.file, ev)
+		}
+	}
+	if _, err := esign.DecodeConnectEvent([]byte("  ")); err == nil {
+		t.Errorf("expected error for empty message; got success")
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package connectconfig manages an account's Connect configurations from a
+// declarative json spec.  MakePlan compares the spec with the account's
+// configurations listing field level changes, and Apply creates, updates
+// and deletes configurations to match the spec.
+//
+// Specs must be json.  YAML specs are not supported; convert them to json
+// (e.g. with yq -o json) before loading.
+//
+//	f, _ := os.Open("connect.json")
+//	spec, err := connectconfig.LoadSpec(f)
+//	plan, err := connectconfig.MakePlan(ctx, cred, spec)
+//	fmt.Print(plan)
+//	if confirmed {
+//		err = plan.Apply(ctx)
+//	}
+package connectconfig // import "github.com/jfcote87/esign/connectconfig"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/connect"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Spec is the desired set of Connect configurations.
+type Spec struct {
+	Configurations []Config `json:"configurations"`
+	// Prune deletes configurations not listed in the spec.
+	Prune bool `json:"prune,omitempty"`
+}
+
+// Config is the desired state of a Connect configuration.  Configurations
+// are matched by Name.
+type Config struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Disabled turns off publishing of envelope events.
+	Disabled        bool     `json:"disabled,omitempty"`
+	EnvelopeEvents  []string `json:"envelopeEvents,omitempty"`
+	RecipientEvents []string `json:"recipientEvents,omitempty"`
+
+	IncludeDocuments               bool `json:"includeDocuments,omitempty"`
+	IncludeCertificateOfCompletion bool `json:"includeCertificateOfCompletion,omitempty"`
+	IncludeDocumentFields          bool `json:"includeDocumentFields,omitempty"`
+	IncludeEnvelopeVoidReason      bool `json:"includeEnvelopeVoidReason,omitempty"`
+	IncludeTimeZoneInformation     bool `json:"includeTimeZoneInformation,omitempty"`
+	IncludeHMAC                    bool `json:"includeHMAC,omitempty"`
+	SignMessageWithX509Certificate bool `json:"signMessageWithX509Certificate,omitempty"`
+	RequiresAcknowledgement        bool `json:"requiresAcknowledgement,omitempty"`
+	EnableLog                      bool `json:"enableLog,omitempty"`
+
+	// AllUsers publishes events for all users.  Otherwise only
+	// events of UserIDs are published.
+	AllUsers bool     `json:"allUsers,omitempty"`
+	UserIDs  []string `json:"userIds,omitempty"`
+
+	// Fields sets additional model.ConnectCustomConfiguration fields
+	// using their json names (e.g. "useSoapInterface").  Fields set by
+	// other Config values may not be listed.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// sensitive fields are write only and never compared.
+var sensitive = map[string]bool{
+	"password":               true,
+	"salesforceAccessToken":  true,
+	"salesforceRefreshToken": true,
+}
+
+// LoadSpec decodes a json spec.  Unknown fields, including Fields keys that
+// are not model.ConnectCustomConfiguration fields, are rejected to catch
+// misspelled settings.
+func LoadSpec(r io.Reader) (*Spec, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var spec Spec
+	if err := dec.Decode(&spec); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for _, c := range spec.Configurations {
+		if c.Name == "" || c.URL == "" {
+			return nil, fmt.Errorf("configuration %q must have a name and url", c.Name)
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("configuration %q is listed more than once", c.Name)
+		}
+		names[c.Name] = true
+		if err := c.checkFields(); err != nil {
+			return nil, err
+		}
+	}
+	return &spec, nil
+}
+
+// checkFields verifies that each Fields key is a configuration field not
+// already set by the Config.
+func (c *Config) checkFields() error {
+	declared := (&Config{}).fields()
+	for _, k := range sortedKeys(c.Fields) {
+		if _, ok := declared[k]; ok || k == "connectId" {
+			return fmt.Errorf("configuration %q: fields may not set %q", c.Name, k)
+		}
+		if !configFields[k] {
+			return fmt.Errorf("configuration %q: unknown field %q", c.Name, k)
+		}
+	}
+	return nil
+}
+
+// configFields contains the json names of the
+// model.ConnectCustomConfiguration fields.
+var configFields = func() map[string]bool {
+	m := make(map[string]bool)
+	t := reflect.TypeOf(model.ConnectCustomConfiguration{})
+	for i := 0; i < t.NumField(); i++ {
+		if nm := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]; nm != "" && nm != "-" {
+			m[nm] = true
+		}
+	}
+	return m
+}()
+
+// fields returns the api fields declared by the config.
+func (c *Config) fields() map[string]interface{} {
+	m := map[string]interface{}{
+		"name":                           c.Name,
+		"urlToPublishTo":                 c.URL,
+		"configurationType":              "custom",
+		"allowEnvelopePublish":           !c.Disabled,
+		"envelopeEvents":                 stringList(c.EnvelopeEvents),
+		"recipientEvents":                stringList(c.RecipientEvents),
+		"includeDocuments":               c.IncludeDocuments,
+		"includeCertificateOfCompletion": c.IncludeCertificateOfCompletion,
+		"includeDocumentFields":          c.IncludeDocumentFields,
+		"includeEnvelopeVoidReason":      c.IncludeEnvelopeVoidReason,
+		"includeTimeZoneInformation":     c.IncludeTimeZoneInformation,
+		"includeHMAC":                    c.IncludeHMAC,
+		"signMessageWithX509Certificate": c.SignMessageWithX509Certificate,
+		"requiresAcknowledgement":        c.RequiresAcknowledgement,
+		"enableLog":                      c.EnableLog,
+		"allUsers":                       c.AllUsers,
+	}
+	if !c.AllUsers {
+		m["userIds"] = stringList(c.UserIDs)
+	}
+	for k, v := range c.Fields {
+		m[k] = v
+	}
+	return m
+}
+
+func stringList(s []string) []interface{} {
+	l := make([]interface{}, 0, len(s))
+	for _, v := range s {
+		l = append(l, v)
+	}
+	return l
+}
+
+// Plan actions
+const (
+	Create = "create"
+	Update = "update"
+	Delete = "delete"
+)
+
+// Change is a field level difference.  Old and New are normalized
+// values.
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// Step is a change to a single configuration.
+type Step struct {
+	Action    string   `json:"action"`
+	Name      string   `json:"name"`
+	ConnectID string   `json:"connectId,omitempty"`
+	Changes   []Change `json:"changes,omitempty"`
+
+	payload map[string]interface{}
+}
+
+// Plan lists the steps needed to make the account's configurations
+// match a spec.
+type Plan struct {
+	Steps []Step `json:"steps"`
+	// Unchanged lists the names of configurations matching the spec.
+	Unchanged []string `json:"unchanged,omitempty"`
+
+	cred esign.Credential
+}
+
+// MakePlan compares spec with the configurations of cred's account.
+func MakePlan(ctx context.Context, cred esign.Credential, spec *Spec) (*Plan, error) {
+	res, err := connect.New(cred).ConfigurationsList().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]map[string]interface{})
+	for _, cfg := range res.Configurations {
+		if _, ok := current[cfg.Name]; ok {
+			return nil, fmt.Errorf("account has more than one configuration named %q", cfg.Name)
+		}
+		m, err := toMap(cfg)
+		if err != nil {
+			return nil, err
+		}
+		current[cfg.Name] = m
+	}
+
+	plan := &Plan{cred: cred}
+	for _, c := range spec.Configurations {
+		desired := c.fields()
+		cur, ok := current[c.Name]
+		delete(current, c.Name)
+		if !ok {
+			step := Step{Action: Create, Name: c.Name, payload: desired}
+			for _, k := range sortedKeys(desired) {
+				if v := display(k, desired[k]); v != "" {
+					step.Changes = append(step.Changes, Change{Field: k, New: v})
+				}
+			}
+			plan.Steps = append(plan.Steps, step)
+			continue
+		}
+		step := Step{Action: Update, Name: c.Name, ConnectID: str(cur["connectId"]), payload: cur}
+		for _, k := range sortedKeys(desired) {
+			newVal := desired[k]
+			if !sensitive[k] {
+				oldVal := cur[k]
+				if _, isBool := newVal.(bool); isBool && oldVal == nil {
+					oldVal = false
+				}
+				if o, n := normalize(oldVal), normalize(newVal); o != n {
+					step.Changes = append(step.Changes, Change{Field: k, Old: o, New: n})
+				}
+			}
+			cur[k] = newVal
+		}
+		if len(step.Changes) == 0 {
+			plan.Unchanged = append(plan.Unchanged, c.Name)
+			continue
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+	if spec.Prune {
+		var names []string
+		for name := range current {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			plan.Steps = append(plan.Steps, Step{Action: Delete, Name: name, ConnectID: str(current[name]["connectId"])})
+		}
+	}
+	return plan, nil
+}
+
+// HasChanges returns true if the plan contains steps.
+func (p *Plan) HasChanges() bool {
+	return len(p.Steps) > 0
+}
+
+// Apply performs the plan's steps in order stopping at the first error.
+func (p *Plan) Apply(ctx context.Context) error {
+	sv := connect.New(p.cred)
+	for _, step := range p.Steps {
+		var err error
+		switch step.Action {
+		case Create:
+			op := sv.ConfigurationsCreate(nil)
+			op.Payload = step.payload
+			_, err = op.Do(ctx)
+		case Update:
+			op := sv.ConfigurationsUpdate(nil)
+			op.Payload = step.payload
+			_, err = op.Do(ctx)
+		case Delete:
+			err = sv.ConfigurationsDelete(step.ConnectID).Do(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("%s %q: %v", step.Action, step.Name, err)
+		}
+	}
+	return nil
+}
+
+// String displays the plan marking creates with +, updates with ~ and
+// deletes with -.  Each step is followed by its field changes in the
+// form field: "old" => "new".
+func (p *Plan) String() string {
+	var buf bytes.Buffer
+	for _, step := range p.Steps {
+		switch step.Action {
+		case Create:
+			fmt.Fprintf(&buf, "+ create %q\n", step.Name)
+		case Update:
+			fmt.Fprintf(&buf, "~ update %q (connectId %s)\n", step.Name, step.ConnectID)
+		case Delete:
+			fmt.Fprintf(&buf, "- delete %q (connectId %s)\n", step.Name, step.ConnectID)
+		}
+		for _, c := range step.Changes {
+			if step.Action == Create {
+				fmt.Fprintf(&buf, "    %s: %q\n", c.Field, c.New)
+				continue
+			}
+			fmt.Fprintf(&buf, "    %s: %q => %q\n", c.Field, c.Old, c.New)
+		}
+	}
+	if len(p.Steps) == 0 {
+		buf.WriteString("no changes\n")
+	}
+	return buf.String()
+}
+
+func toMap(cfg model.ConnectCustomConfiguration) (map[string]interface{}, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	return m, json.Unmarshal(b, &m)
+}
+
+// normalize converts a json value to a comparable string.  Booleans are
+// lower case and lists are sorted.
+func normalize(v interface{}) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		return fmt.Sprintf("%v", x)
+	case string:
+		if lx := strings.ToLower(x); lx == "true" || lx == "false" {
+			return lx
+		}
+		return x
+	case []interface{}:
+		l := make([]string, 0, len(x))
+		for _, item := range x {
+			l = append(l, normalize(item))
+		}
+		sort.Strings(l)
+		return strings.Join(l, ",")
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// display returns the normalized value or a mask for sensitive fields.
+func display(k string, v interface{}) string {
+	s := normalize(v)
+	if sensitive[k] && s != "" {
+		return "(sensitive)"
+	}
+	return s
+}
+
+func str(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package connectconfig_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/connectconfig"
+	"github.com/jfcote87/testutils"
+)
+
+const userInfo = `{"sub": "USER", "accounts": [
+	{"account_id": "ACCOUNT1", "is_default": true, "base_uri": "https://gotest.docusign.net"}]}`
+
+const spec = `{
+	"prune": true,
+	"configurations": [
+		{"name": "orders", "url": "https://example.com/orders", "envelopeEvents": ["completed", "sent"], "allUsers": true},
+		{"name": "billing", "url": "https://example.com/billing", "envelopeEvents": ["completed"], "includeHMAC": true, "allUsers": true,
+			"fields": {"password": "secret"}},
+		{"name": "archive", "url": "https://example.com/archive", "includeDocuments": true, "userIds": ["U1"]}
+	]
+}`
+
+const configurations = `{"configurations": [
+	{"connectId": "1", "name": "orders", "urlToPublishTo": "https://example.com/orders", "configurationType": "custom",
+		"allowEnvelopePublish": "true", "allUsers": "true", "envelopeEvents": ["sent", "completed"], "includeHMAC": "false"},
+	{"connectId": "2", "name": "billing", "urlToPublishTo": "https://example.com/billing", "configurationType": "custom",
+		"allowEnvelopePublish": "True", "allUsers": "true", "envelopeEvents": ["sent"], "soapNamespace": "keep"},
+	{"connectId": "3", "name": "legacy", "urlToPublishTo": "https://example.com/legacy"}
+]}`
+
+func TestPlan(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	s, err := connectconfig.LoadSpec(strings.NewReader(spec))
+	if err != nil {
+		t.Fatalf("LoadSpec: %v", err)
+	}
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfo), nil),
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/connect",
+		Method:   "GET",
+		Response: testutils.MakeResponse(200, []byte(configurations), nil),
+	})
+	plan, err := connectconfig.MakePlan(ctx, cred, s)
+	if err != nil {
+		t.Fatalf("MakePlan: %v", err)
+	}
+	if len(plan.Unchanged) != 1 || plan.Unchanged[0] != "orders" || len(plan.Steps) != 3 {
+		t.Fatalf("expected orders unchanged and 3 steps; got %s", plan)
+	}
+	update := plan.Steps[0]
+	if update.Action != connectconfig.Update || update.ConnectID != "2" || len(update.Changes) != 2 ||
+		update.Changes[0] != (connectconfig.Change{Field: "envelopeEvents", Old: "sent", New: "completed"}) ||
+		update.Changes[1] != (connectconfig.Change{Field: "includeHMAC", Old: "false", New: "true"}) {
+		t.Errorf("unexpected update %#v", update)
+	}
+	if plan.Steps[1].Action != connectconfig.Create || plan.Steps[2].Action != connectconfig.Delete || plan.Steps[2].ConnectID != "3" {
+		t.Errorf("expected create and delete; got %s", plan)
+	}
+	if out := plan.String(); !strings.Contains(out, `~ update "billing" (connectId 2)`) ||
+		!strings.Contains(out, `    envelopeEvents: "sent" => "completed"`) || strings.Contains(out, "secret") {
+		t.Errorf("unexpected plan display:\n%s", out)
+	}
+
+	var updatePayload map[string]interface{}
+	testTransport.Add(&testutils.RequestTester{
+		Path:   "/restapi/v2.1/accounts/ACCOUNT1/connect",
+		Method: "PUT",
+		ResponseFunc: func(r *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(r.Body)
+			json.Unmarshal(b, &updatePayload)
+			return testutils.MakeResponse(200, []byte(`{}`), nil), nil
+		},
+	}, &testutils.RequestTester{
+		Path:     "/restapi/v2.1/accounts/ACCOUNT1/connect",
+		Method:   "POST",
+		Response: testutils.MakeResponse(201, []byte(`{"connectId":"4"}`), nil),
+	}, &testutils.RequestTester{
+		Path:   "/restapi/v2.1/accounts/ACCOUNT1/connect/3",
+		Method: "DELETE",
+	})
+	if err = plan.Apply(ctx); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if updatePayload["connectId"] != "2" || updatePayload["soapNamespace"] != "keep" ||
+		updatePayload["includeHMAC"] != true || updatePayload["password"] != "secret" {
+		t.Errorf("expected merged update payload; got %v", updatePayload)
+	}
+
+	for _, bad := range []string{
+		`{"configurations":[{"name":"a","url":"u","includeDocs":true}]}`,
+		`{"configurations":[{"name":"a","url":"u","fields":{"useSoapInterfase":"true"}}]}`,
+		`{"configurations":[{"name":"a","url":"u","fields":{"urlToPublishTo":"v"}}]}`,
+		`{"configurations":[{"name":"a","url":"u","fields":{"connectId":"2"}}]}`,
+	} {
+		if _, err = connectconfig.LoadSpec(strings.NewReader(bad)); err == nil {
+			t.Errorf("%s: expected invalid field error; got success", bad)
+		}
+	}
+}
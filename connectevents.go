@@ -0,0 +1,260 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConnectMark is the last Connect event applied for an envelope or
+// recipient.
+type ConnectMark struct {
+	Status string `json:"status"`
+	// Generated is the time a Connect message was generated.  Changes
+	// polled from the api have no generated time.
+	Generated time.Time `json:"generated"`
+	// Changed is the time an envelope entered Status, if known.  It
+	// matches a polled change to the Connect message of the same change.
+	Changed time.Time `json:"changed"`
+}
+
+// statusRank orders envelope and recipient statuses.  Terminal statuses
+// share the highest rank.
+var statusRank = map[string]int{
+	"created":              1,
+	"sent":                 2,
+	"faxpending":           2,
+	"autoresponded":        3,
+	"authenticationfailed": 3,
+	"delivered":            3,
+	"signed":               4,
+	"completed":            5,
+	"declined":             5,
+	"voided":               5,
+}
+
+// Allows returns true if next is newer than m.  A later status is always
+// newer, an earlier status never is, and events with the same (or an
+// unknown) status are newer only if generated after m.  When either mark
+// has no generated time (i.e. a polled change), the marks are compared by
+// Changed.
+func (m *ConnectMark) Allows(next *ConnectMark) bool {
+	if m == nil {
+		return true
+	}
+	r0, r1 := statusRank[strings.ToLower(m.Status)], statusRank[strings.ToLower(next.Status)]
+	if r0 > 0 && r1 > 0 && r0 != r1 {
+		return r1 > r0
+	}
+	switch {
+	case !m.Generated.IsZero() && !next.Generated.IsZero():
+		return next.Generated.After(m.Generated)
+	case !m.Changed.IsZero() && !next.Changed.IsZero():
+		return next.Changed.After(m.Changed)
+	}
+	return next.latest().After(m.latest())
+}
+
+// latest returns the generated time or, for a polled change, the
+// changed time.
+func (m *ConnectMark) latest() time.Time {
+	if m.Generated.IsZero() {
+		return m.Changed
+	}
+	return m.Generated
+}
+
+// EnvelopeMark returns the mark of an envelope event.  Generated is the
+// event's GeneratedAt, and Changed is the time the envelope entered the
+// event's status (e.g. Completed for envelope-completed), if known.
+func (ev *ConnectEvent) EnvelopeMark() *ConnectMark {
+	status := strings.TrimPrefix(ev.Event, "envelope-")
+	var tm time.Time
+	switch strings.ToLower(status) {
+	case "created":
+		tm = ev.Created
+	case "sent":
+		tm = ev.Sent
+	case "delivered":
+		tm = ev.Delivered
+	case "completed":
+		tm = ev.Completed
+	case "declined":
+		tm = ev.Declined
+	case "voided":
+		tm = ev.Voided
+	}
+	return &ConnectMark{Status: status, Generated: ev.GeneratedAt, Changed: tm}
+}
+
+// ConnectEventStore saves the last event applied for each envelope and
+// recipient.  Keys are the envelope id for envelope events and
+// envelope id/recipient id for recipient events.
+type ConnectEventStore interface {
+	// LoadMark returns the mark saved for key or nil if none exists.
+	LoadMark(ctx context.Context, key string) (*ConnectMark, error)
+	SaveMark(ctx context.Context, key string, m *ConnectMark) error
+}
+
+// MemoryEventStore is a ConnectEventStore for a single process.  The zero
+// value is ready to use.
+type MemoryEventStore struct {
+	mu    sync.Mutex
+	marks map[string]ConnectMark
+}
+
+// LoadMark returns the mark saved for key.
+func (s *MemoryEventStore) LoadMark(ctx context.Context, key string) (*ConnectMark, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m, ok := s.marks[key]; ok {
+		return &m, nil
+	}
+	return nil, nil
+}
+
+// SaveMark saves m for key.
+func (s *MemoryEventStore) SaveMark(ctx context.Context, key string, m *ConnectMark) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.marks == nil {
+		s.marks = make(map[string]ConnectMark)
+	}
+	s.marks[key] = *m
+	return nil
+}
+
+// FileEventStore is a ConnectEventStore saving each mark as a json file in
+// Dir.  Marks are written to a temporary file and renamed so that a
+// crash does not leave a partial mark.  Old marks may be pruned by
+// deleting files by modification time.
+type FileEventStore struct {
+	Dir string
+}
+
+func (s FileEventStore) path(key string) string {
+	return filepath.Join(s.Dir, base64.RawURLEncoding.EncodeToString([]byte(key))+".json")
+}
+
+// LoadMark reads the mark saved for key.
+func (s FileEventStore) LoadMark(ctx context.Context, key string) (*ConnectMark, error) {
+	b, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m ConnectMark
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// SaveMark writes m for key.
+func (s FileEventStore) SaveMark(ctx context.Context, key string, m *ConnectMark) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(s.Dir, ".mark")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), s.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// keyLocks serializes processing of events for a key.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks key returning the unlock func.
+func (kl *keyLocks) lock(key string) func() {
+	kl.mu.Lock()
+	if kl.locks == nil {
+		kl.locks = make(map[string]*keyLock)
+	}
+	l, ok := kl.locks[key]
+	if !ok {
+		l = &keyLock{}
+		kl.locks[key] = l
+	}
+	l.refs++
+	kl.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		kl.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(kl.locks, key)
+		}
+		kl.mu.Unlock()
+	}
+}
+
+// connectMarks holds the marks of a message's new events.
+type connectMarks struct {
+	store ConnectEventStore
+	keys  []string
+	marks []*ConnectMark
+}
+
+// isNew returns true if the event is newer than the key's saved mark.
+// Events without a key are always new.  A message matching a polled change
+// replaces the change's mark so that later messages of the same status are
+// compared by generated time.
+func (cm *connectMarks) isNew(ctx context.Context, key string, m *ConnectMark) (bool, error) {
+	if cm.store == nil || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") || key == "" {
+		return true, nil
+	}
+	prev, err := cm.store.LoadMark(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	isNew := prev.Allows(m)
+	if isNew || (prev.Generated.IsZero() && !m.Generated.IsZero() && strings.EqualFold(prev.Status, m.Status)) {
+		cm.keys = append(cm.keys, key)
+		cm.marks = append(cm.marks, m)
+	}
+	return isNew, nil
+}
+
+// save saves the new events' marks.
+func (cm *connectMarks) save(ctx context.Context) error {
+	for i, key := range cm.keys {
+		if err := cm.store.SaveMark(ctx, key, cm.marks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+)
+
+func TestConnectMarkAllows(t *testing.T) {
+	tm := time.Date(2020, 5, 11, 13, 44, 0, 0, time.UTC)
+	completed := &esign.ConnectMark{Status: "Completed", Generated: tm}
+	for _, tt := range []struct {
+		next   *esign.ConnectMark
+		allows bool
+	}{
+		{&esign.ConnectMark{Status: "Completed", Generated: tm}, false},                  // duplicate
+		{&esign.ConnectMark{Status: "delivered", Generated: tm.Add(time.Minute)}, false}, // stale status
+		{&esign.ConnectMark{Status: "completed", Generated: tm.Add(time.Minute)}, true},
+		{&esign.ConnectMark{Status: "corrected", Generated: tm.Add(-time.Minute)}, false},
+		{&esign.ConnectMark{Status: "corrected", Generated: tm.Add(time.Minute)}, true},
+	} {
+		if completed.Allows(tt.next) != tt.allows {
+			t.Errorf("%v: expected %v", tt.next, tt.allows)
+		}
+	}
+	// a polled change has no generated time and matches a message of the
+	// same change by Changed
+	sent := &esign.ConnectMark{Status: "sent", Generated: tm.Add(time.Second), Changed: tm}
+	polled := &esign.ConnectMark{Status: "sent", Changed: tm}
+	for _, tt := range []struct {
+		prev, next *esign.ConnectMark
+		allows     bool
+	}{
+		{sent, polled, false},
+		{polled, sent, false},
+		{sent, &esign.ConnectMark{Status: "sent", Generated: tm.Add(time.Hour), Changed: tm}, true},
+		{polled, &esign.ConnectMark{Status: "sent", Changed: tm.Add(time.Hour)}, true},
+	} {
+		if tt.prev.Allows(tt.next) != tt.allows {
+			t.Errorf("%v allows %v: expected %v", tt.prev, tt.next, tt.allows)
+		}
+	}
+	var none *esign.ConnectMark
+	if !none.Allows(completed) {
+		t.Errorf("expected nil mark to allow all events")
+	}
+}
+
+func TestConnectEventStores(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "marks")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	for _, store := range []esign.ConnectEventStore{&esign.MemoryEventStore{}, esign.FileEventStore{Dir: dir}} {
+		m, err := store.LoadMark(ctx, "ENVID/1")
+		if err != nil || m != nil {
+			t.Errorf("%T: expected no mark; got %v %v", store, m, err)
+		}
+		mark := &esign.ConnectMark{Status: "sent", Generated: time.Now().UTC().Truncate(time.Second)}
+		if err = store.SaveMark(ctx, "ENVID/1", mark); err != nil {
+			t.Errorf("%T: save %v", store, err)
+		}
+		if m, err = store.LoadMark(ctx, "ENVID/1"); err != nil || m == nil || *m != *mark {
+			t.Errorf("%T: expected %v; got %v %v", store, mark, m, err)
+		}
+	}
+}
+
+func TestConnectHandlerEvents(t *testing.T) {
+	xmlBody, err := ioutil.ReadFile("testdata/connect.xml")
+	if err != nil {
+		t.Fatalf("read connect.xml: %v", err)
+	}
+	jsonBody, err := ioutil.ReadFile("testdata/connect.json")
+	if err != nil {
+		t.Fatalf("read connect.json: %v", err)
+	}
+	var events, recipients int
+	var fail bool
+	h := &esign.ConnectHandler{
+		InsecureSkipVerify: true,
+		Events:             &esign.MemoryEventStore{},
+		OnEvent: func(ctx context.Context, ev *esign.ConnectEvent) error {
+			if fail {
+				return errors.New("callback failed")
+			}
+			events++
+			return nil
+		},
+		OnRecipient: func(ctx context.Context, ev *esign.RecipientEvent) error {
+			recipients++
+			return nil
+		},
+	}
+	post := func(b []byte) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("POST", "/connect", bytes.NewReader(b)))
+		return rec.Code
+	}
+
+	// failed callbacks are not recorded so the retry is processed
+	fail = true
+	if code := post(xmlBody); code != 500 {
+		t.Errorf("expected 500; got %d", code)
+	}
+	fail = false
+	if code := post(xmlBody); code != 200 || events != 1 || recipients != 2 {
+		t.Errorf("expected new event; got %d %d %d", code, events, recipients)
+	}
+	if code := post(xmlBody); code != 200 || events != 1 || recipients != 2 {
+		t.Errorf("expected duplicate dropped; got %d %d %d", code, events, recipients)
+	}
+
+	// recipient-delivered arriving after recipient-completed
+	if code := post(jsonBody); code != 200 || events != 2 {
+		t.Errorf("expected recipient event; got %d %d", code, events)
+	}
+	delivered := bytes.Replace(jsonBody, []byte(`"recipient-completed"`), []byte(`"recipient-delivered"`), 1)
+	delivered = bytes.Replace(delivered, []byte("2020-05-11T13:44:45.2979179Z"), []byte("2020-05-11T13:45:45Z"), 1)
+	if code := post(delivered); code != 200 || events != 2 {
+		t.Errorf("expected stale event dropped; got %d %d", code, events)
+	}
+}
+
+// sentMessage returns an xml message for an envelope in sent status.
+func sentMessage(envelopeID, generated string) []byte {
+	return []byte(`<?xml version="1.0" encoding="utf-8"?>
+<DocuSignEnvelopeInformation xmlns="http://www.docusign.net/API/3.0">
+	<EnvelopeStatus>
+		<TimeGenerated>` + generated + `</TimeGenerated>
+		<EnvelopeID>` + envelopeID + `</EnvelopeID>
+		<Status>Sent</Status>
+		<Sent>2020-05-11T10:00:00Z</Sent>
+	</EnvelopeStatus>
+</DocuSignEnvelopeInformation>`)
+}
+
+func TestConnectHandlerEvents_sameStatus(t *testing.T) {
+	ctx := context.Background()
+	store := &esign.MemoryEventStore{}
+	var events, envelopes int
+	h := &esign.ConnectHandler{
+		InsecureSkipVerify: true,
+		Events:             store,
+		OnEvent: func(ctx context.Context, ev *esign.ConnectEvent) error {
+			events++
+			return nil
+		},
+		OnEnvelope: func(ctx context.Context, ev *esign.EnvelopeEvent) error {
+			envelopes++
+			return nil
+		},
+	}
+	post := func(b []byte) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("POST", "/connect", bytes.NewReader(b)))
+		return rec.Code
+	}
+
+	// a later message for an envelope still in sent status is processed
+	if code := post(sentMessage("ENV1", "2020-05-11T10:00:01Z")); code != 200 || events != 1 || envelopes != 1 {
+		t.Fatalf("expected first message; got %d %d %d", code, events, envelopes)
+	}
+	if code := post(sentMessage("ENV1", "2020-05-11T11:00:00Z")); code != 200 || events != 2 || envelopes != 2 {
+		t.Errorf("expected newer message of the same status; got %d %d %d", code, events, envelopes)
+	}
+	// dedupe is based on TimeGenerated; replays are suppressed
+	for _, generated := range []string{"2020-05-11T10:00:01Z", "2020-05-11T11:00:00Z"} {
+		if code := post(sentMessage("ENV1", generated)); code != 200 || events != 2 || envelopes != 2 {
+			t.Errorf("expected replay generated at %s dropped; got %d %d %d", generated, code, events, envelopes)
+		}
+	}
+
+	// a message matching a polled change is dropped, but later messages
+	// are processed
+	polled := &esign.ConnectMark{Status: "sent", Changed: time.Date(2020, 5, 11, 10, 0, 0, 0, time.UTC)}
+	if err := store.SaveMark(ctx, "ENV2", polled); err != nil {
+		t.Fatalf("SaveMark: %v", err)
+	}
+	if code := post(sentMessage("ENV2", "2020-05-11T10:00:01Z")); code != 200 || events != 2 {
+		t.Errorf("expected message of polled change dropped; got %d %d", code, events)
+	}
+	if code := post(sentMessage("ENV2", "2020-05-11T11:00:00Z")); code != 200 || events != 3 {
+		t.Errorf("expected newer message after polled change; got %d %d", code, events)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidSignature is returned by VerifyConnectSignature when no
+// X-DocuSign-Signature header matches a secret.
+var ErrInvalidSignature = errors.New("connect message signature invalid")
+
+// DefaultConnectMaxBodySize is the default size limit of a Connect message.
+// Messages that include document pdfs may require a larger limit.
+const DefaultConnectMaxBodySize = 10 << 20
+
+// EnvelopeEvent is passed to ConnectHandler.OnEnvelope.
+type EnvelopeEvent struct {
+	// Status is the envelope status (e.g. Sent, Delivered, Completed,
+	// Declined, Voided).
+	Status string
+	Data   *ConnectData
+}
+
+// RecipientEvent is passed to ConnectHandler.OnRecipient.
+type RecipientEvent struct {
+	// Status is the recipient status (e.g. Sent, Delivered, Completed,
+	// Declined, AuthenticationFailed, AutoResponded).
+	Status    string
+	Recipient *RecipientStatusXML
+	Data      *ConnectData
+}
+
+// ConnectHandler receives DocuSign Connect xml and json messages.  A
+// message's HMAC signature is verified before it is decoded and passed to
+// the callbacks.  The handler responds 200 only after all callbacks succeed
+// so that DocuSign retries failed messages.
+//
+//	http.Handle("/docusign/connect", &esign.ConnectHandler{
+//		Secrets: []string{os.Getenv("CONNECT_HMAC_KEY")},
+//		OnEvent: saveEnvelopeStatus,
+//	})
+type ConnectHandler struct {
+	// Secrets are the HMAC keys of the Connect configuration.  A message
+	// is accepted if any X-DocuSign-Signature-N header matches any secret,
+	// allowing keys to be rotated.
+	Secrets []string
+	// InsecureSkipVerify accepts unsigned messages when Secrets is empty.
+	// Otherwise an empty Secrets rejects all messages.
+	InsecureSkipVerify bool
+	// MaxBodySize limits the size of a message.  If 0,
+	// DefaultConnectMaxBodySize is used.
+	MaxBodySize int64
+	// OnEvent, if not nil, is called with each message in either format.
+	OnEvent func(context.Context, *ConnectEvent) error
+	// OnEnvelope, if not nil, is called once for each xml message.
+	OnEnvelope func(context.Context, *EnvelopeEvent) error
+	// OnRecipient, if not nil, is called for each recipient in an xml
+	// message.  A message contains every recipient's current status, so
+	// OnRecipient may be called repeatedly for the same recipient status.
+	OnRecipient func(context.Context, *RecipientEvent) error
+	// OnError, if not nil, is called with the reason a message was rejected.
+	OnError func(*http.Request, error)
+	// Events, if not nil, records the events passed to the callbacks.
+	// Retried (duplicate) events and events older than a recorded event for
+	// the same envelope or recipient are dropped.
+	Events ConnectEventStore
+
+	locks keyLocks
+}
+
+// ServeHTTP verifies, decodes and dispatches a Connect message.
+func (h *ConnectHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("connect message method %s", r.Method))
+		return
+	}
+	maxSize := h.MaxBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultConnectMaxBodySize
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxSize+1))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if int64(len(body)) > maxSize {
+		h.fail(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("connect message exceeds %d bytes", maxSize))
+		return
+	}
+	if len(h.Secrets) > 0 || !h.InsecureSkipVerify {
+		if err = VerifyConnectSignature(r.Header, body, h.Secrets...); err != nil {
+			h.fail(w, r, http.StatusUnauthorized, err)
+			return
+		}
+	}
+	ev, err := DecodeConnectEvent(body)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err = h.dispatch(r.Context(), ev); err != nil {
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch calls the callbacks stopping at the first error.  If Events is
+// set, duplicate and stale events are dropped and the marks of new events
+// are saved after the callbacks succeed.
+func (h *ConnectHandler) dispatch(ctx context.Context, ev *ConnectEvent) error {
+	cm := &connectMarks{store: h.Events}
+	if h.Events != nil {
+		defer h.locks.lock(ev.EnvelopeID)()
+	}
+	key, mark := ev.EnvelopeID, ev.EnvelopeMark()
+	if ev.Recipient != nil && strings.HasPrefix(ev.Event, "recipient-") {
+		key, mark = ev.EnvelopeID+"/"+ev.Recipient.ID, &ConnectMark{Status: strings.TrimPrefix(ev.Event, "recipient-"), Generated: ev.GeneratedAt}
+	}
+	isNew, err := cm.isNew(ctx, key, mark)
+	if err != nil {
+		return err
+	}
+	cd := ev.XML
+	if isNew && h.OnEvent != nil {
+		if err = h.OnEvent(ctx, ev); err != nil {
+			return err
+		}
+	}
+	if isNew && cd != nil && h.OnEnvelope != nil {
+		if err = h.OnEnvelope(ctx, &EnvelopeEvent{Status: cd.EnvelopeStatus.Status, Data: cd}); err != nil {
+			return err
+		}
+	}
+	if cd != nil && h.OnRecipient != nil {
+		for i := range cd.EnvelopeStatus.RecipientStatuses {
+			rs := &cd.EnvelopeStatus.RecipientStatuses[i]
+			id := rs.RecipientID
+			if id == "" {
+				id = rs.Email
+			}
+			if isNew, err = cm.isNew(ctx, ev.EnvelopeID+"/"+id, &ConnectMark{Status: rs.Status, Generated: ev.GeneratedAt}); err != nil {
+				return err
+			}
+			if !isNew {
+				continue
+			}
+			if err = h.OnRecipient(ctx, &RecipientEvent{Status: rs.Status, Recipient: rs, Data: cd}); err != nil {
+				return err
+			}
+		}
+	}
+	return cm.save(ctx)
+}
+
+func (h *ConnectHandler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+// VerifyConnectSignature checks the X-DocuSign-Signature-1..N headers
+// against the base64 encoded HMAC-SHA256 of body for each secret.
+// ErrInvalidSignature is returned if no signature matches.
+func VerifyConnectSignature(hdr http.Header, body []byte, secrets ...string) error {
+	var sigs [][]byte
+	for i := 1; ; i++ {
+		v := hdr.Get("X-DocuSign-Signature-" + strconv.Itoa(i))
+		if v == "" {
+			break
+		}
+		if sig, err := base64.StdEncoding.DecodeString(v); err == nil {
+			sigs = append(sigs, sig)
+		}
+	}
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		sum := mac.Sum(nil)
+		for _, sig := range sigs {
+			if hmac.Equal(sig, sum) {
+				return nil
+			}
+		}
+	}
+	return ErrInvalidSignature
+}
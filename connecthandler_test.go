@@ -0,0 +1,108 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfcote87/esign"
+)
+
+func TestConnectHandler(t *testing.T) {
+	body, err := ioutil.ReadFile("testdata/connect.xml")
+	if err != nil {
+		t.Fatalf("read connect.xml: %v", err)
+	}
+	sign := func(secret string, b []byte) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(b)
+		return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	var envelopes, recipients int
+	var failCallback bool
+	var lastErr error
+	h := &esign.ConnectHandler{
+		Secrets: []string{"NEWKEY", "OLDKEY"},
+		OnEnvelope: func(ctx context.Context, ev *esign.EnvelopeEvent) error {
+			if failCallback {
+				return errors.New("database unavailable")
+			}
+			if ev.Data.EnvelopeStatus.EnvelopeID == "" || ev.Status != ev.Data.EnvelopeStatus.Status {
+				t.Errorf("expected envelope status; got %#v", ev)
+			}
+			envelopes++
+			return nil
+		},
+		OnRecipient: func(ctx context.Context, ev *esign.RecipientEvent) error {
+			if ev.Recipient == nil || ev.Status != "Completed" {
+				t.Errorf("expected completed recipient; got %#v", ev)
+			}
+			recipients++
+			return nil
+		},
+		OnError: func(r *http.Request, err error) {
+			lastErr = err
+		},
+	}
+	post := func(b []byte, sigs ...string) int {
+		r := httptest.NewRequest("POST", "/connect", bytes.NewReader(b))
+		for i, s := range sigs {
+			r.Header.Set("X-DocuSign-Signature-"+string(rune('1'+i)), s)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		return rec.Code
+	}
+
+	// second header signed with rotated key
+	if code := post(body, sign("UNKNOWN", body), sign("OLDKEY", body)); code != 200 || envelopes != 1 || recipients != 2 {
+		t.Errorf("expected 200 with 1 envelope and 2 recipients; got %d %d %d", code, envelopes, recipients)
+	}
+	if code := post(body); code != http.StatusUnauthorized || lastErr != esign.ErrInvalidSignature {
+		t.Errorf("expected 401 for unsigned message; got %d %v", code, lastErr)
+	}
+	if code := post(append(body, ' '), sign("NEWKEY", body)); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 for altered body; got %d", code)
+	}
+	failCallback = true
+	if code := post(body, sign("NEWKEY", body)); code != http.StatusInternalServerError || recipients != 2 {
+		t.Errorf("expected 500 on callback failure; got %d", code)
+	}
+	// json messages are passed only to OnEvent
+	failCallback = false
+	var events []*esign.ConnectEvent
+	h.OnEvent = func(ctx context.Context, ev *esign.ConnectEvent) error {
+		events = append(events, ev)
+		return nil
+	}
+	jsonBody, err := ioutil.ReadFile("testdata/connect.json")
+	if err != nil {
+		t.Fatalf("read connect.json: %v", err)
+	}
+	if code := post(jsonBody, sign("NEWKEY", jsonBody)); code != 200 || len(events) != 1 || events[0].JSON == nil || envelopes != 1 {
+		t.Errorf("expected json event only; got %d %d %d", code, len(events), envelopes)
+	}
+	h.MaxBodySize = 100
+	if code := post(body, sign("NEWKEY", body)); code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413; got %d", code)
+	}
+	h.Secrets = nil
+	if code := post([]byte("<bad"), ""); code != http.StatusUnauthorized {
+		t.Errorf("expected 401 with no secrets; got %d", code)
+	}
+	h.InsecureSkipVerify, h.MaxBodySize = true, 0
+	if code := post([]byte("<bad")); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for invalid xml; got %d", code)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// ConnectJSON is a Connect message sent by a configuration using the
+// JSON (SIM) event format.
+type ConnectJSON struct {
+	// Event is the event type (e.g. envelope-completed, recipient-sent).
+	Event             string          `json:"event"`
+	APIVersion        string          `json:"apiVersion,omitempty"`
+	URI               string          `json:"uri,omitempty"`
+	RetryCount        json.Number     `json:"retryCount,omitempty"`
+	ConfigurationID   json.Number     `json:"configurationId,omitempty"`
+	GeneratedDateTime *DSTime         `json:"generatedDateTime,omitempty"`
+	Data              ConnectJSONData `json:"data"`
+}
+
+// ConnectJSONData identifies the subject of a ConnectJSON event.
+type ConnectJSONData struct {
+	AccountID   string `json:"accountId,omitempty"`
+	UserID      string `json:"userId,omitempty"`
+	EnvelopeID  string `json:"envelopeId,omitempty"`
+	RecipientID string `json:"recipientId,omitempty"`
+	// EnvelopeSummary is included when the configuration
+	// includes data.
+	EnvelopeSummary *model.Envelope `json:"envelopeSummary,omitempty"`
+}
+
+// ConnectEvent is a Connect message normalized from either the xml or
+// json format.
+type ConnectEvent struct {
+	// Event is the event type (e.g. envelope-completed).  The type of an xml
+	// message is derived from the envelope status.
+	Event      string
+	AccountID  string
+	EnvelopeID string
+	// Status is the envelope status.
+	Status string
+	// Recipient is the subject of a recipient event.
+	Recipient  *ConnectRecipient
+	Recipients []ConnectRecipient
+
+	GeneratedAt time.Time
+	Created     time.Time
+	Sent        time.Time
+	Delivered   time.Time
+	Completed   time.Time
+	Declined    time.Time
+	Voided      time.Time
+
+	// Envelope is the envelope summary of a json message, the converted
+	// envelope status of an xml message or the envelope of an
+	// EnvelopeConnectEvent.
+	Envelope *model.Envelope
+	// XML or JSON contains the original message.
+	XML  *ConnectData
+	JSON *ConnectJSON
+}
+
+// ConnectRecipient describes a recipient's status.
+type ConnectRecipient struct {
+	ID            string
+	Type          string
+	Name          string
+	Email         string
+	Status        string
+	RoutingOrder  string
+	DeclineReason string
+	Sent          time.Time
+	Delivered     time.Time
+	Signed        time.Time
+	Declined      time.Time
+}
+
+// DecodeConnectEvent decodes a Connect message detecting whether
+// the message is xml or json.
+func DecodeConnectEvent(body []byte) (*ConnectEvent, error) {
+	b := bytes.TrimSpace(body)
+	switch {
+	case len(b) == 0:
+		return nil, errors.New("empty connect message")
+	case b[0] == '{':
+		var msg ConnectJSON
+		if err := json.Unmarshal(b, &msg); err != nil {
+			return nil, err
+		}
+		var rcpts struct {
+			Data struct {
+				EnvelopeSummary struct {
+					Recipients connectJSONRecipients `json:"recipients"`
+				} `json:"envelopeSummary"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(b, &rcpts); err != nil {
+			return nil, err
+		}
+		return msg.event(rcpts.Data.EnvelopeSummary.Recipients.list()), nil
+	}
+	var cd ConnectData
+	if err := xml.Unmarshal(b, &cd); err != nil {
+		return nil, err
+	}
+	return cd.Event(), nil
+}
+
+// Event returns the normalized event of the xml message.
+func (cd *ConnectData) Event() *ConnectEvent {
+	es := &cd.EnvelopeStatus
+	loc := cd.Location()
+	ev := &ConnectEvent{
+		Event:       "envelope-" + strings.ToLower(es.Status),
+		EnvelopeID:  es.EnvelopeID,
+		Status:      es.Status,
+		GeneratedAt: es.TimeGenerated.TimeIn(loc),
+		Created:     es.Created.TimeIn(loc),
+		Sent:        es.Sent.TimeIn(loc),
+		Delivered:   es.Delivered.TimeIn(loc),
+		Completed:   es.Completed.TimeIn(loc),
+		XML:         cd,
+	}
+	for _, rs := range es.RecipientStatuses {
+		ev.Recipients = append(ev.Recipients, ConnectRecipient{
+			ID:            rs.RecipientID,
+			Type:          rs.Type,
+			Name:          rs.UserName,
+			Email:         rs.Email,
+			Status:        rs.Status,
+			RoutingOrder:  rs.RoutingOrder,
+			DeclineReason: rs.DeclineReason,
+			Sent:          rs.Sent.TimeIn(loc),
+			Delivered:     rs.Delivered.TimeIn(loc),
+			Signed:        rs.Signed.TimeIn(loc),
+		})
+	}
+	if env, err := es.EnvelopeIn(loc); err == nil {
+		ev.Envelope = env
+	}
+	return ev
+}
+
+func (msg *ConnectJSON) event(rcpts []ConnectRecipient) *ConnectEvent {
+	ev := &ConnectEvent{
+		Event:       msg.Event,
+		AccountID:   msg.Data.AccountID,
+		EnvelopeID:  msg.Data.EnvelopeID,
+		GeneratedAt: msg.GeneratedDateTime.Time(),
+		Recipients:  rcpts,
+		JSON:        msg,
+	}
+	ev.setEnvelope(msg.Data.EnvelopeSummary)
+	if id := msg.Data.RecipientID; id != "" {
+		ev.Recipient = &ConnectRecipient{ID: id}
+		for i := range rcpts {
+			if rcpts[i].ID == id {
+				ev.Recipient = &rcpts[i]
+				break
+			}
+		}
+	}
+	return ev
+}
+
+// EnvelopeConnectEvent returns an envelope event for an envelope retrieved
+// from the api (e.g. by polling for status changes) allowing the same code
+// to process pushed and polled changes.  The event is generated at the
+// envelope's StatusChangedDateTime.
+func EnvelopeConnectEvent(env *model.Envelope) (*ConnectEvent, error) {
+	ev := &ConnectEvent{Event: "envelope-" + strings.ToLower(env.Status)}
+	ev.setEnvelope(env)
+	ev.GeneratedAt = timeVal(env.StatusChangedDateTime)
+	if env.Recipients != nil {
+		b, err := json.Marshal(env.Recipients)
+		if err != nil {
+			return nil, err
+		}
+		var rcpts connectJSONRecipients
+		if err = json.Unmarshal(b, &rcpts); err != nil {
+			return nil, err
+		}
+		ev.Recipients = rcpts.list()
+	}
+	return ev, nil
+}
+
+// setEnvelope sets the event's envelope and envelope status fields.
+func (ev *ConnectEvent) setEnvelope(env *model.Envelope) {
+	if env == nil {
+		return
+	}
+	ev.Envelope = env
+	if ev.EnvelopeID == "" {
+		ev.EnvelopeID = env.EnvelopeID
+	}
+	ev.Status = env.Status
+	ev.Created = timeVal(env.CreatedDateTime)
+	ev.Sent = timeVal(env.SentDateTime)
+	ev.Delivered = timeVal(env.DeliveredDateTime)
+	ev.Completed = timeVal(env.CompletedDateTime)
+	ev.Declined = timeVal(env.DeclinedDateTime)
+	ev.Voided = timeVal(env.VoidedDateTime)
+}
+
+func timeVal(tm *time.Time) time.Time {
+	if tm == nil {
+		return time.Time{}
+	}
+	return *tm
+}
+
+// connectJSONRecipient contains the status fields common
+// to all recipient types.
+type connectJSONRecipient struct {
+	RecipientID       string  `json:"recipientId"`
+	RecipientType     string  `json:"recipientType"`
+	Name              string  `json:"name"`
+	Email             string  `json:"email"`
+	Status            string  `json:"status"`
+	RoutingOrder      string  `json:"routingOrder"`
+	DeclinedReason    string  `json:"declinedReason"`
+	SentDateTime      *DSTime `json:"sentDateTime"`
+	DeliveredDateTime *DSTime `json:"deliveredDateTime"`
+	SignedDateTime    *DSTime `json:"signedDateTime"`
+	DeclinedDateTime  *DSTime `json:"declinedDateTime"`
+}
+
+type connectJSONRecipients struct {
+	Agents              []connectJSONRecipient `json:"agents"`
+	CarbonCopies        []connectJSONRecipient `json:"carbonCopies"`
+	CertifiedDeliveries []connectJSONRecipient `json:"certifiedDeliveries"`
+	Editors             []connectJSONRecipient `json:"editors"`
+	InPersonSigners     []connectJSONRecipient `json:"inPersonSigners"`
+	Intermediaries      []connectJSONRecipient `json:"intermediaries"`
+	Seals               []connectJSONRecipient `json:"seals"`
+	Signers             []connectJSONRecipient `json:"signers"`
+	Witnesses           []connectJSONRecipient `json:"witnesses"`
+}
+
+// list returns recipients of all types.
+func (r connectJSONRecipients) list() []ConnectRecipient {
+	var list []ConnectRecipient
+	for _, rr := range [][]connectJSONRecipient{r.Agents, r.CarbonCopies, r.CertifiedDeliveries, r.Editors,
+		r.InPersonSigners, r.Intermediaries, r.Seals, r.Signers, r.Witnesses} {
+		for _, x := range rr {
+			list = append(list, ConnectRecipient{
+				ID:            x.RecipientID,
+				Type:          x.RecipientType,
+				Name:          x.Name,
+				Email:         x.Email,
+				Status:        x.Status,
+				RoutingOrder:  x.RoutingOrder,
+				DeclineReason: x.DeclinedReason,
+				Sent:          x.SentDateTime.Time(),
+				Delivered:     x.DeliveredDateTime.Time(),
+				Signed:        x.SignedDateTime.Time(),
+				Declined:      x.DeclinedDateTime.Time(),
+			})
+		}
+	}
+	return list
+}
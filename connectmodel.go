@@ -0,0 +1,281 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// connectRecipientTypes maps Connect recipient types to
+// model.Recipients fields.
+var connectRecipientTypes = map[string]string{
+	"signer":            "signers",
+	"carboncopy":        "carbonCopies",
+	"certifieddelivery": "certifiedDeliveries",
+	"agent":             "agents",
+	"editor":            "editors",
+	"intermediary":      "intermediaries",
+	"inpersonsigner":    "inPersonSigners",
+	"witness":           "witnesses",
+	"seal":              "seals",
+}
+
+// connectTabTypes maps Connect tab types (or the custom tab type of
+// Custom tabs) to model.Tabs fields.
+var connectTabTypes = map[string]string{
+	"signhere":         "signHereTabs",
+	"initialhere":      "initialHereTabs",
+	"datesigned":       "dateSignedTabs",
+	"fullname":         "fullNameTabs",
+	"firstname":        "firstNameTabs",
+	"lastname":         "lastNameTabs",
+	"company":          "companyTabs",
+	"title":            "titleTabs",
+	"email":            "emailTabs",
+	"emailaddress":     "emailAddressTabs",
+	"envelopeid":       "envelopeIdTabs",
+	"approve":          "approveTabs",
+	"decline":          "declineTabs",
+	"signerattachment": "signerAttachmentTabs",
+	"note":             "noteTabs",
+	"view":             "viewTabs",
+	"text":             "textTabs",
+	"checkbox":         "checkboxTabs",
+	"list":             "listTabs",
+	"radio":            "radioGroupTabs",
+	"date":             "dateTabs",
+	"number":           "numberTabs",
+	"ssn":              "ssnTabs",
+	"zip5":             "zipTabs",
+	"zip5dash4":        "zipTabs",
+	"formula":          "formulaTabs",
+}
+
+// Envelope converts the Connect message into the v2.1 model returned by
+// the envelopes service.  Times are in the message's Location.
+func (cd *ConnectData) Envelope() (*model.Envelope, error) {
+	return cd.EnvelopeStatus.EnvelopeIn(cd.Location())
+}
+
+// Envelope converts the envelope status into the v2.1 model returned by the
+// envelopes service treating times as UTC.  Use EnvelopeIn when the
+// account's time zone is known.
+func (es *EnvelopeStatusXML) Envelope() (*model.Envelope, error) {
+	return es.EnvelopeIn(time.UTC)
+}
+
+// EnvelopeIn converts the envelope status into the v2.1 model returned by
+// the envelopes service interpreting times in loc, the account's time zone.
+// Recipients are added to the Recipients list of their type and tab
+// statuses to the Tabs list of their (custom) tab type.  A recipient of an
+// unknown type is an error, and tabs of unknown types (e.g. a custom tab
+// without a custom tab type) are skipped.  Envelope, recipient and tab
+// statuses are lower case as returned by the api.
+func (es *EnvelopeStatusXML) EnvelopeIn(loc *time.Location) (*model.Envelope, error) {
+	env := &model.Envelope{
+		EnvelopeID:         es.EnvelopeID,
+		EmailSubject:       es.Subject,
+		Status:             strings.ToLower(es.Status),
+		CreatedDateTime:    timePtr(es.Created, loc),
+		SentDateTime:       timePtr(es.Sent, loc),
+		DeliveredDateTime:  timePtr(es.Delivered, loc),
+		CompletedDateTime:  timePtr(es.Completed, loc),
+		VoidedReason:       es.VoidReason,
+		SigningLocation:    es.SigningLocation,
+		AutoNavigation:     strconv.FormatBool(es.AutoNavigation),
+		EnvelopeIDStamping: model.DSBool(es.EnvelopeIDStamping),
+		AuthoritativeCopy:  model.DSBool(es.AuthoritativeCopy),
+	}
+	if es.UserName != "" || es.Email != "" {
+		env.Sender = &model.UserInfo{UserName: es.UserName, Email: es.Email}
+	}
+	if len(es.CustomFields) > 0 {
+		env.CustomFields = &model.CustomFields{}
+		for _, f := range es.CustomFields {
+			required := model.REQUIRED_FALSE
+			if f.Required {
+				required = model.REQUIRED_TRUE
+			}
+			env.CustomFields.TextCustomFields = append(env.CustomFields.TextCustomFields, model.TextCustomField{
+				Name:     f.Name,
+				Value:    f.Value,
+				Show:     model.DSBool(f.Show),
+				Required: required,
+			})
+		}
+	}
+	for _, d := range es.DocumentStatuses {
+		env.EnvelopeDocuments = append(env.EnvelopeDocuments, model.EnvelopeDocument{
+			DocumentID: d.ID,
+			Name:       d.Name,
+			Order:      d.Sequence,
+		})
+	}
+	if len(es.RecipientStatuses) > 0 {
+		rcpts, err := connectRecipients(es.RecipientStatuses, loc)
+		if err != nil {
+			return nil, err
+		}
+		env.Recipients = rcpts
+	}
+	return env, nil
+}
+
+// connectRecipients builds the json of each recipient's model and
+// unmarshals the result into model.Recipients.
+func connectRecipients(statuses []RecipientStatusXML, loc *time.Location) (*model.Recipients, error) {
+	lists := make(map[string][]map[string]interface{})
+	for _, rs := range statuses {
+		key, ok := connectRecipientTypes[strings.ToLower(rs.Type)]
+		if !ok {
+			return nil, fmt.Errorf("recipient %s has unknown type %q", rs.RecipientID, rs.Type)
+		}
+		r := map[string]interface{}{
+			"recipientId":    rs.RecipientID,
+			"recipientType":  strings.ToLower(rs.Type),
+			"name":           rs.UserName,
+			"email":          rs.Email,
+			"routingOrder":   rs.RoutingOrder,
+			"status":         strings.ToLower(rs.Status),
+			"declinedReason": rs.DeclineReason,
+		}
+		if key == "inPersonSigners" {
+			r["signerName"] = rs.UserName
+			r["hostEmail"] = rs.Email
+		}
+		setTime(r, "sentDateTime", rs.Sent, loc)
+		setTime(r, "deliveredDateTime", rs.Delivered, loc)
+		setTime(r, "signedDateTime", rs.Signed, loc)
+		var customFields []string
+		for _, f := range rs.CustomFields {
+			customFields = append(customFields, f.Value)
+		}
+		if len(customFields) > 0 {
+			r["customFields"] = customFields
+		}
+		if a := rs.RecipientAttachment; a.Data != "" || a.Label != "" {
+			r["recipientAttachments"] = []map[string]interface{}{{"label": a.Label, "data": a.Data}}
+		}
+		if len(rs.TabStatuses) > 0 {
+			r["tabs"] = connectTabs(rs.RecipientID, rs.TabStatuses)
+		}
+		lists[key] = append(lists[key], r)
+	}
+	b, err := json.Marshal(lists)
+	if err != nil {
+		return nil, err
+	}
+	var rcpts model.Recipients
+	if err = json.Unmarshal(b, &rcpts); err != nil {
+		return nil, err
+	}
+	return &rcpts, nil
+}
+
+// connectTabs returns the json of a model.Tabs.  Radio tabs with the same
+// label are combined into a single radio group.  Tabs of unknown types are
+// skipped.
+func connectTabs(recipientID string, statuses []TabStatusXML) map[string][]map[string]interface{} {
+	tabs := make(map[string][]map[string]interface{})
+	groups := make(map[string]int)
+	for _, ts := range statuses {
+		tabType := ts.TabType
+		if strings.EqualFold(tabType, "custom") && ts.CustomTabType != "" {
+			tabType = ts.CustomTabType
+		}
+		key, ok := connectTabTypes[strings.ToLower(tabType)]
+		if !ok {
+			continue
+		}
+		t := map[string]interface{}{
+			"tabType":     strings.ToLower(tabType),
+			"tabLabel":    ts.TabLabel,
+			"name":        ts.TabName,
+			"value":       ts.TabValue,
+			"documentId":  ts.DocumentID,
+			"recipientId": recipientID,
+			"pageNumber":  ts.PageNumber,
+			"xPosition":   ts.XPosition,
+			"yPosition":   ts.YPosition,
+			"status":      strings.ToLower(ts.Status),
+		}
+		switch key {
+		case "textTabs":
+			t["originalValue"] = ts.OriginalValue
+			t["validationPattern"] = ts.ValidationPattern
+		case "checkboxTabs":
+			t["selected"] = strconv.FormatBool(isChecked(ts.TabValue))
+		case "listTabs":
+			t["value"] = ts.ListSelectedValue
+			if ts.ListSelectedValue == "" {
+				t["value"] = ts.TabValue
+			}
+		case "radioGroupTabs":
+			radio := map[string]interface{}{
+				"value":      radioValue(ts),
+				"selected":   strconv.FormatBool(isChecked(ts.TabValue)),
+				"pageNumber": ts.PageNumber,
+				"xPosition":  ts.XPosition,
+				"yPosition":  ts.YPosition,
+				"status":     strings.ToLower(ts.Status),
+			}
+			// each option of a group is reported as a separate tab
+			if i, ok := groups[ts.DocumentID+"/"+ts.TabLabel]; ok {
+				g := tabs[key][i]
+				g["radios"] = append(g["radios"].([]map[string]interface{}), radio)
+				continue
+			}
+			groups[ts.DocumentID+"/"+ts.TabLabel] = len(tabs[key])
+			t = map[string]interface{}{
+				"groupName":   ts.TabLabel,
+				"documentId":  ts.DocumentID,
+				"recipientId": recipientID,
+				"radios":      []map[string]interface{}{radio},
+			}
+		}
+		tabs[key] = append(tabs[key], t)
+	}
+	return tabs
+}
+
+// radioValue returns the option value of a radio tab.  Connect reports
+// the option in TabName and an X in TabValue when selected.
+func radioValue(ts TabStatusXML) string {
+	if ts.TabName != "" {
+		return ts.TabName
+	}
+	return ts.TabValue
+}
+
+// isChecked returns true for selected checkbox and radio values.
+func isChecked(v string) bool {
+	switch strings.ToLower(v) {
+	case "x", "true", "on", "checked", "1":
+		return true
+	}
+	return false
+}
+
+func setTime(m map[string]interface{}, key string, d *DSTime, loc *time.Location) {
+	if tm := timePtr(d, loc); tm != nil {
+		m[key] = tm
+	}
+}
+
+// timePtr returns nil for a missing or unparseable DSTime.
+func timePtr(d *DSTime, loc *time.Location) *time.Time {
+	tm := d.TimeIn(loc)
+	if tm.IsZero() {
+		return nil
+	}
+	return &tm
+}
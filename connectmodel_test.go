@@ -0,0 +1,89 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+func TestConnectDataEnvelope(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/connect.xml")
+	if err != nil {
+		t.Fatalf("open testdata/connect.xml: %v", err)
+	}
+	var cd esign.ConnectData
+	if err = xml.Unmarshal(b, &cd); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	env, err := cd.Envelope()
+	if err != nil {
+		t.Fatalf("Envelope: %v", err)
+	}
+	if env.EnvelopeID != "8e0069bb-6193-46b0-b616-3914477b13a5" || env.Status != "completed" || env.AutoNavigation != "true" || !bool(env.EnvelopeIDStamping) {
+		t.Errorf("unexpected envelope %#v", env)
+	}
+	if env.CompletedDateTime == nil || env.SentDateTime == nil || env.CompletedDateTime.Before(*env.SentDateTime) {
+		t.Errorf("expected sent and completed times; got %v %v", env.SentDateTime, env.CompletedDateTime)
+	}
+	// times are in the message's TimeZoneOffset
+	if sent := time.Date(2014, 11, 11, 18, 43, 46, 283e6, time.UTC); env.SentDateTime == nil || !env.SentDateTime.Equal(sent) {
+		t.Errorf("expected sent at %v; got %v", sent, env.SentDateTime)
+	}
+	if ev := cd.Event(); !ev.Sent.Equal(*env.SentDateTime) {
+		t.Errorf("expected event sent at %v; got %v", env.SentDateTime, ev.Sent)
+	}
+	if env.CustomFields == nil || len(env.CustomFields.TextCustomFields) != 4 {
+		t.Fatalf("expected 4 custom fields; got %#v", env.CustomFields)
+	}
+	if f := env.CustomFields.TextCustomFields[1]; f.Name != "AccountId" || f.Value != "123456" || f.Required != model.REQUIRED_FALSE {
+		t.Errorf("unexpected custom field %#v", f)
+	}
+	if len(env.EnvelopeDocuments) != 1 || env.EnvelopeDocuments[0].Name != "Docusign1.pdf" {
+		t.Errorf("unexpected documents %#v", env.EnvelopeDocuments)
+	}
+	if env.Recipients == nil || len(env.Recipients.Signers) != 2 {
+		t.Fatalf("expected 2 signers; got %#v", env.Recipients)
+	}
+	signer := env.Recipients.Signers[1]
+	if signer.Email != "jfcote87@example.com" || signer.Status != "completed" || signer.SignedDateTime == nil || signer.Tabs == nil {
+		t.Fatalf("unexpected signer %#v", signer)
+	}
+	tabs := signer.Tabs
+	if len(tabs.SignHereTabs) != 1 || len(tabs.InitialHereTabs) != 1 || len(tabs.FirstNameTabs) != 1 || len(tabs.DateSignedTabs) != 1 {
+		t.Errorf("expected signature and name tabs; got %#v", tabs)
+	}
+	if len(tabs.ListTabs) != 1 || tabs.ListTabs[0].Value != "D" || tabs.ListTabs[0].TabLabel != "Drop Down 16" {
+		t.Errorf("unexpected list tabs %#v", tabs.ListTabs)
+	}
+	if len(tabs.RadioGroupTabs) != 1 || tabs.RadioGroupTabs[0].GroupName != "rb1" || len(tabs.RadioGroupTabs[0].Radios) != 3 ||
+		tabs.RadioGroupTabs[0].Radios[0].Value != "C" || !bool(tabs.RadioGroupTabs[0].Radios[0].Selected) {
+		t.Errorf("unexpected radio group tabs %#v", tabs.RadioGroupTabs)
+	}
+	if len(tabs.CheckboxTabs) != 1 {
+		t.Errorf("expected checkbox tab; got %#v", tabs.CheckboxTabs)
+	}
+	// tab statuses are lower case
+	if len(tabs.SignHereTabs) == 0 || len(tabs.RadioGroupTabs) == 0 || len(tabs.RadioGroupTabs[0].Radios) == 0 {
+		t.Fatalf("expected sign here and radio tabs; got %#v", tabs)
+	}
+	if tabs.SignHereTabs[0].Status != "signed" || tabs.RadioGroupTabs[0].Radios[0].Status != "signed" {
+		t.Errorf("expected signed tab statuses; got %q %q", tabs.SignHereTabs[0].Status, tabs.RadioGroupTabs[0].Radios[0].Status)
+	}
+	// the custom note without a custom tab type is skipped
+	if len(tabs.TextTabs) != 0 || len(tabs.NoteTabs) != 0 {
+		t.Errorf("expected unknown tab type to be skipped; got %#v %#v", tabs.TextTabs, tabs.NoteTabs)
+	}
+
+	cd.EnvelopeStatus.RecipientStatuses[0].Type = "Notary"
+	if _, err = cd.Envelope(); err == nil {
+		t.Errorf("expected unknown recipient type error; got success")
+	}
+}
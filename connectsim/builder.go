@@ -0,0 +1,350 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package connectsim
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Builder creates synthetic envelopes.
+//
+//	env := connectsim.NewEnvelope("Please sign").
+//		Sender("Sam Sender", "sam@example.com").
+//		Document("1", "contract.pdf").
+//		Signer("1", "Susan Smart", "susan@example.com", &model.Tabs{
+//			SignHereTabs: []model.SignHere{{TabBase: model.TabBase{DocumentID: "1"}}},
+//		}).
+//		CarbonCopy("2", "Bob Smith", "bob@example.com").
+//		Envelope()
+type Builder struct {
+	env model.Envelope
+	err error // first error, returned by Envelope
+}
+
+// NewEnvelope starts a created envelope with a random id.
+func NewEnvelope(subject string) *Builder {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return &Builder{env: model.Envelope{
+		EnvelopeID:            newID(),
+		EmailSubject:          subject,
+		Status:                "created",
+		CreatedDateTime:       &now,
+		StatusChangedDateTime: &now,
+		Recipients:            &model.Recipients{},
+	}}
+}
+
+// Sender sets the envelope's sender.
+func (b *Builder) Sender(name, email string) *Builder {
+	b.env.Sender = &model.UserInfo{UserName: name, Email: email}
+	return b
+}
+
+// Document adds a document.
+func (b *Builder) Document(id, name string) *Builder {
+	b.env.EnvelopeDocuments = append(b.env.EnvelopeDocuments, model.EnvelopeDocument{
+		DocumentID: id,
+		Name:       name,
+		Order:      strconv.Itoa(len(b.env.EnvelopeDocuments) + 1),
+	})
+	return b
+}
+
+// CustomField adds a text custom field.
+func (b *Builder) CustomField(name, value string) *Builder {
+	if b.env.CustomFields == nil {
+		b.env.CustomFields = &model.CustomFields{}
+	}
+	b.env.CustomFields.TextCustomFields = append(b.env.CustomFields.TextCustomFields, model.TextCustomField{
+		Name:     name,
+		Value:    value,
+		Required: model.REQUIRED_FALSE,
+	})
+	return b
+}
+
+// Signer adds a signer routed after the existing recipients.  The
+// recipient id of each tab is set to id.
+func (b *Builder) Signer(id, name, email string, tabs *model.Tabs) *Builder {
+	b.env.Recipients.Signers = append(b.env.Recipients.Signers, model.Signer{
+		RecipientID:   id,
+		RecipientType: "signer",
+		Name:          name,
+		Email:         email,
+		RoutingOrder:  b.nextRoutingOrder(),
+		Status:        "created",
+		Tabs:          b.recipientTabs(id, tabs),
+	})
+	return b
+}
+
+// CarbonCopy adds a carbon copy recipient routed after the existing
+// recipients.
+func (b *Builder) CarbonCopy(id, name, email string) *Builder {
+	b.env.Recipients.CarbonCopies = append(b.env.Recipients.CarbonCopies, model.CarbonCopy{
+		RecipientID:   id,
+		RecipientType: "carboncopy",
+		Name:          name,
+		Email:         email,
+		RoutingOrder:  b.nextRoutingOrder(),
+		Status:        "created",
+	})
+	return b
+}
+
+// Envelope returns a copy of the built envelope or the first error
+// encountered while building.
+func (b *Builder) Envelope() (*model.Envelope, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return copyEnvelope(&b.env)
+}
+
+func (b *Builder) nextRoutingOrder() string {
+	n := len(b.env.Recipients.Signers) + len(b.env.Recipients.CarbonCopies)
+	return strconv.Itoa(n + 1)
+}
+
+// recipientTabs returns a copy of tabs with the recipient id of each tab
+// set.  An error is saved for Envelope.
+func (b *Builder) recipientTabs(recipientID string, tabs *model.Tabs) *model.Tabs {
+	if tabs == nil {
+		return nil
+	}
+	result, err := recipientTabs(recipientID, tabs)
+	if err != nil {
+		if b.err == nil {
+			b.err = fmt.Errorf("recipient %s tabs: %v", recipientID, err)
+		}
+		return tabs
+	}
+	return result
+}
+
+// recipientTabs sets the recipient id of each tab.
+func recipientTabs(recipientID string, tabs *model.Tabs) (*model.Tabs, error) {
+	lists, err := tabLists(tabs)
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range lists {
+		for _, t := range list {
+			t["recipientId"] = recipientID
+		}
+	}
+	b, err := json.Marshal(lists)
+	if err != nil {
+		return nil, err
+	}
+	var result model.Tabs
+	if err = json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Advance updates env for an envelope or recipient event occurring at
+// time at.  Envelope events (envelope-sent, envelope-delivered,
+// envelope-completed, envelope-declined and envelope-voided) set the
+// envelope status.  Sending also sends to the recipients of the first
+// routing order, and completing completes all recipients.  Recipient
+// events (recipient-sent, recipient-delivered, recipient-completed,
+// recipient-declined, recipient-autoresponded and
+// recipient-authenticationfailed) set the status of recipientID.
+func Advance(env *model.Envelope, event, recipientID string, at time.Time) error {
+	at = at.UTC()
+	rcpts, err := recipientLists(env.Recipients)
+	if err != nil {
+		return err
+	}
+	switch event {
+	case "envelope-created":
+		env.Status, env.CreatedDateTime = "created", &at
+	case "envelope-sent":
+		env.Status, env.SentDateTime = "sent", &at
+		first := -1
+		for _, r := range rcpts.all() {
+			if o := routingOrder(r); first < 0 || o < first {
+				first = o
+			}
+		}
+		for _, r := range rcpts.all() {
+			if routingOrder(r) == first {
+				setRecipient(r, "sent", at)
+			}
+		}
+	case "envelope-delivered":
+		env.Status, env.DeliveredDateTime = "delivered", &at
+	case "envelope-completed":
+		env.Status, env.CompletedDateTime = "completed", &at
+		for _, r := range rcpts.all() {
+			if r["status"] != "completed" {
+				setRecipient(r, "completed", at)
+			}
+		}
+	case "envelope-declined":
+		env.Status, env.DeclinedDateTime = "declined", &at
+	case "envelope-voided":
+		env.Status, env.VoidedDateTime = "voided", &at
+		if env.VoidedReason == "" {
+			env.VoidedReason = "voided by simulator"
+		}
+	default:
+		if !strings.HasPrefix(event, "recipient-") {
+			return fmt.Errorf("unknown event %s", event)
+		}
+		status := strings.TrimPrefix(event, "recipient-")
+		if _, ok := recipientTimes[status]; !ok {
+			return fmt.Errorf("unknown event %s", event)
+		}
+		r := rcpts.find(recipientID)
+		if r == nil {
+			return fmt.Errorf("%s: recipient %q not found", event, recipientID)
+		}
+		setRecipient(r, status, at)
+	}
+	env.StatusChangedDateTime = &at
+	return rcpts.update(env)
+}
+
+// Lifecycle advances a copy of env through events returning a message
+// for each event.  Events occur step apart starting at start.  The
+// recipient of a recipient event is given after a colon (e.g.
+// "recipient-completed:1").
+func Lifecycle(env *model.Envelope, start time.Time, step time.Duration, events ...string) ([]*Message, error) {
+	env, err := copyEnvelope(env)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []*Message
+	for i, ev := range events {
+		at := start.Add(time.Duration(i) * step)
+		event, recipientID := ev, ""
+		if idx := strings.Index(ev, ":"); idx > 0 {
+			event, recipientID = ev[:idx], ev[idx+1:]
+		}
+		if err := Advance(env, event, recipientID, at); err != nil {
+			return nil, err
+		}
+		snapshot, err := copyEnvelope(env)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, &Message{
+			Event:       event,
+			RecipientID: recipientID,
+			Generated:   at,
+			Envelope:    snapshot,
+		})
+	}
+	return msgs, nil
+}
+
+// recipientTimes lists the timestamp field set for each recipient status.
+var recipientTimes = map[string]string{
+	"sent":                 "sentDateTime",
+	"delivered":            "deliveredDateTime",
+	"completed":            "signedDateTime",
+	"declined":             "declinedDateTime",
+	"autoresponded":        "sentDateTime",
+	"authenticationfailed": "deliveredDateTime",
+}
+
+func routingOrder(r map[string]interface{}) int {
+	s, _ := r["routingOrder"].(string)
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+func setRecipient(r map[string]interface{}, status string, at time.Time) {
+	r["status"] = status
+	if fld := recipientTimes[status]; fld != "" {
+		r[fld] = at
+	}
+	if status == "declined" && r["declinedReason"] == nil {
+		r["declinedReason"] = "declined by simulator"
+	}
+}
+
+// recipientMaps contains the json of model.Recipients.
+type recipientMaps map[string]interface{}
+
+func recipientLists(rcpts *model.Recipients) (recipientMaps, error) {
+	m := make(recipientMaps)
+	if rcpts == nil {
+		return m, nil
+	}
+	b, err := json.Marshal(rcpts)
+	if err != nil {
+		return nil, err
+	}
+	return m, json.Unmarshal(b, &m)
+}
+
+// all returns each recipient in order of the recipient type lists.
+func (rm recipientMaps) all() []map[string]interface{} {
+	var list []map[string]interface{}
+	for _, rt := range recipientTypes {
+		items, _ := rm[rt.key].([]interface{})
+		for _, item := range items {
+			if r, ok := item.(map[string]interface{}); ok {
+				list = append(list, r)
+			}
+		}
+	}
+	return list
+}
+
+func (rm recipientMaps) find(recipientID string) map[string]interface{} {
+	for _, r := range rm.all() {
+		if r["recipientId"] == recipientID {
+			return r
+		}
+	}
+	return nil
+}
+
+func (rm recipientMaps) update(env *model.Envelope) error {
+	if env.Recipients == nil {
+		return nil
+	}
+	b, err := json.Marshal(rm)
+	if err != nil {
+		return err
+	}
+	var rcpts model.Recipients
+	if err = json.Unmarshal(b, &rcpts); err != nil {
+		return err
+	}
+	env.Recipients = &rcpts
+	return nil
+}
+
+func copyEnvelope(env *model.Envelope) (*model.Envelope, error) {
+	b, err := json.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+	var c model.Envelope
+	return &c, json.Unmarshal(b, &c)
+}
+
+// newID returns a random uuid.
+func newID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,525 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package connectsim generates DocuSign Connect messages for testing
+// webhook consumers.  Messages are built from envelopes fetched from an
+// account or created with a Builder, encoded in the xml or json Connect
+// format and posted with HMAC signatures to a listener.
+//
+//	env, err := connectsim.NewEnvelope("Please sign").
+//		Signer("1", "Susan Smart", "susan@example.com", nil).
+//		Envelope()
+//	msgs, err := connectsim.Lifecycle(env, time.Now(), time.Minute,
+//		"envelope-sent", "recipient-delivered:1", "recipient-completed:1", "envelope-completed")
+//	sender := &connectsim.Sender{URL: "http://localhost:8080/connect", Secrets: []string{key}}
+//	for _, msg := range msgs {
+//		status, err := sender.Post(ctx, msg)
+//		...
+//	}
+package connectsim // import "github.com/jfcote87/esign/connectsim"
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/envelopes"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Message formats
+const (
+	XML  = "xml"
+	JSON = "json"
+)
+
+// Message describes a Connect message.
+type Message struct {
+	// Event is the json event name (e.g. envelope-sent,
+	// recipient-completed).
+	Event string
+	// RecipientID identifies the recipient of a recipient event.
+	RecipientID string
+	AccountID   string
+	UserID      string
+	// Generated is the time the message was generated.  If zero, the
+	// current time is used.
+	Generated time.Time
+	// Envelope contains the envelope status including recipients and tabs.
+	Envelope *model.Envelope
+	// FormData, if not nil, provides the form data of each recipient in
+	// xml messages.
+	FormData *model.EnvelopeFormData
+	// Documents are included in xml messages.
+	Documents []esign.DocumentPdfXML
+}
+
+// Fetch returns a message built from an envelope's current status,
+// recipients, tabs and form data.  Event is set from the envelope status.
+// AccountID and UserID are set from the resolved account and user of an
+// *esign.OAuth2Credential; set them directly for other credentials.
+func Fetch(ctx context.Context, cred esign.Credential, envelopeID string) (*Message, error) {
+	sv := envelopes.New(cred)
+	env, err := sv.Get(envelopeID).Include("custom_fields,documents").Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if env.Recipients, err = sv.RecipientsList(envelopeID).IncludeTabs().IncludeExtended().Do(ctx); err != nil {
+		return nil, err
+	}
+	formData, err := sv.FormDataGet(envelopeID).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := &Message{
+		Event:     "envelope-" + strings.ToLower(env.Status),
+		Envelope:  env,
+		FormData:  formData,
+		Generated: timeVal(env.StatusChangedDateTime),
+	}
+	if oc, ok := cred.(*esign.OAuth2Credential); ok {
+		st := oc.State()
+		msg.AccountID = st.AccountID
+		if st.UserInfo != nil {
+			msg.UserID = st.UserInfo.APIUsername
+		}
+	}
+	return msg, nil
+}
+
+// Encode returns the message body in the xml or json format.
+func (m *Message) Encode(format string) ([]byte, error) {
+	switch format {
+	case XML:
+		cd, err := m.ConnectData()
+		if err != nil {
+			return nil, err
+		}
+		b, err := xml.MarshalIndent(envelopeInformation{ConnectData: cd}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), b...), nil
+	case JSON:
+		return json.Marshal(m.ConnectJSON())
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+// envelopeInformation is the root element of an xml message.
+type envelopeInformation struct {
+	XMLName xml.Name `xml:"http://www.docusign.net/API/3.0 DocuSignEnvelopeInformation"`
+	*esign.ConnectData
+}
+
+// ConnectJSON returns the json message.  The envelope uri is set only
+// when AccountID is known.
+func (m *Message) ConnectJSON() *esign.ConnectJSON {
+	msg := &esign.ConnectJSON{
+		Event:             m.Event,
+		APIVersion:        "v2.1",
+		RetryCount:        "0",
+		GeneratedDateTime: dsTime(m.generated()),
+		Data: esign.ConnectJSONData{
+			AccountID:       m.AccountID,
+			UserID:          m.UserID,
+			RecipientID:     m.RecipientID,
+			EnvelopeSummary: m.Envelope,
+		},
+	}
+	if m.Envelope != nil {
+		msg.Data.EnvelopeID = m.Envelope.EnvelopeID
+		if m.AccountID != "" {
+			msg.URI = fmt.Sprintf("/restapi/v2.1/accounts/%s/envelopes/%s", m.AccountID, m.Envelope.EnvelopeID)
+		}
+	}
+	return msg
+}
+
+// ConnectData returns the xml message.  Recipients are listed in the order
+// of the model.Recipients lists, and tabs in the order of the model.Tabs
+// lists with the options of radio groups listed as separate tabs.
+func (m *Message) ConnectData() (*esign.ConnectData, error) {
+	env := m.Envelope
+	if env == nil {
+		return nil, fmt.Errorf("message has no envelope")
+	}
+	es := esign.EnvelopeStatusXML{
+		TimeGenerated:      dsTime(m.generated()),
+		EnvelopeID:         env.EnvelopeID,
+		Subject:            env.EmailSubject,
+		Status:             xmlStatus(env.Status),
+		Created:            dsTime(timeVal(env.CreatedDateTime)),
+		Sent:               dsTime(timeVal(env.SentDateTime)),
+		Delivered:          dsTime(timeVal(env.DeliveredDateTime)),
+		Completed:          dsTime(timeVal(env.CompletedDateTime)),
+		SigningLocation:    env.SigningLocation,
+		AutoNavigation:     strings.EqualFold(env.AutoNavigation, "true"),
+		EnvelopeIDStamping: bool(env.EnvelopeIDStamping),
+		AuthoritativeCopy:  bool(env.AuthoritativeCopy),
+		VoidReason:         env.VoidedReason,
+	}
+	if env.Sender != nil {
+		es.UserName, es.Email = env.Sender.UserName, env.Sender.Email
+	}
+	if env.CustomFields != nil {
+		for _, f := range env.CustomFields.TextCustomFields {
+			es.CustomFields = append(es.CustomFields, esign.CustomFieldXML{
+				Name:     f.Name,
+				Value:    f.Value,
+				Show:     bool(f.Show),
+				Required: f.Required == model.REQUIRED_TRUE,
+			})
+		}
+	}
+	for _, d := range env.EnvelopeDocuments {
+		es.DocumentStatuses = append(es.DocumentStatuses, esign.DocumentStatusXML{
+			ID:       d.DocumentID,
+			Name:     d.Name,
+			Sequence: d.Order,
+		})
+	}
+	rcpts, err := m.recipientStatuses()
+	if err != nil {
+		return nil, err
+	}
+	es.RecipientStatuses = rcpts
+	return &esign.ConnectData{EnvelopeStatus: es, DocumentPdfs: m.Documents}, nil
+}
+
+// recipientTypes lists the model.Recipients fields and their xml
+// recipient types.
+var recipientTypes = []struct {
+	key     string
+	xmlType string
+}{
+	{"signers", "Signer"},
+	{"agents", "Agent"},
+	{"editors", "Editor"},
+	{"intermediaries", "Intermediary"},
+	{"carbonCopies", "CarbonCopy"},
+	{"certifiedDeliveries", "CertifiedDelivery"},
+	{"inPersonSigners", "InPersonSigner"},
+	{"witnesses", "Witness"},
+	{"seals", "Seal"},
+}
+
+// simRecipient contains the fields common to all recipient types.
+type simRecipient struct {
+	RecipientID       string      `json:"recipientId"`
+	Name              string      `json:"name"`
+	SignerName        string      `json:"signerName"`
+	Email             string      `json:"email"`
+	HostEmail         string      `json:"hostEmail"`
+	RoutingOrder      string      `json:"routingOrder"`
+	Status            string      `json:"status"`
+	SentDateTime      *time.Time  `json:"sentDateTime"`
+	DeliveredDateTime *time.Time  `json:"deliveredDateTime"`
+	SignedDateTime    *time.Time  `json:"signedDateTime"`
+	DeclinedReason    string      `json:"declinedReason"`
+	CustomFields      []string    `json:"customFields"`
+	Tabs              *model.Tabs `json:"tabs"`
+}
+
+func (m *Message) recipientStatuses() ([]esign.RecipientStatusXML, error) {
+	if m.Envelope.Recipients == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(m.Envelope.Recipients)
+	if err != nil {
+		return nil, err
+	}
+	var lists map[string]json.RawMessage
+	if err = json.Unmarshal(b, &lists); err != nil {
+		return nil, err
+	}
+	var statuses []esign.RecipientStatusXML
+	for _, rt := range recipientTypes {
+		var rcpts []simRecipient
+		if raw, ok := lists[rt.key]; ok {
+			if err = json.Unmarshal(raw, &rcpts); err != nil {
+				return nil, err
+			}
+		}
+		for _, r := range rcpts {
+			rs := esign.RecipientStatusXML{
+				Type:          rt.xmlType,
+				RecipientID:   r.RecipientID,
+				UserName:      r.Name,
+				Email:         r.Email,
+				RoutingOrder:  r.RoutingOrder,
+				Status:        xmlStatus(r.Status),
+				Sent:          dsTime(timeVal(r.SentDateTime)),
+				Delivered:     dsTime(timeVal(r.DeliveredDateTime)),
+				Signed:        dsTime(timeVal(r.SignedDateTime)),
+				DeclineReason: r.DeclinedReason,
+			}
+			if rs.UserName == "" {
+				rs.UserName, rs.Email = r.SignerName, r.HostEmail
+			}
+			for _, v := range r.CustomFields {
+				rs.CustomFields = append(rs.CustomFields, esign.CustomFieldXML{Value: v})
+			}
+			if rs.TabStatuses, err = tabStatuses(r.Tabs); err != nil {
+				return nil, err
+			}
+			rs.FormData = m.formData(r.RecipientID)
+			statuses = append(statuses, rs)
+		}
+	}
+	return statuses, nil
+}
+
+func (m *Message) formData(recipientID string) []esign.NmValXML {
+	if m.FormData == nil {
+		return nil
+	}
+	var fields []esign.NmValXML
+	for _, rfd := range m.FormData.RecipientFormData {
+		if rfd.RecipientID != recipientID {
+			continue
+		}
+		for _, f := range rfd.FormData {
+			fields = append(fields, esign.NmValXML{Name: f.Name, Value: f.Value})
+		}
+	}
+	return fields
+}
+
+// tabTypes lists the model.Tabs fields and their xml tab types.  Custom
+// types are reported with a TabType of Custom.
+var tabTypes = []struct {
+	key     string
+	xmlType string
+	custom  bool
+}{
+	{"signHereTabs", "SignHere", false},
+	{"initialHereTabs", "InitialHere", false},
+	{"dateSignedTabs", "DateSigned", false},
+	{"fullNameTabs", "FullName", false},
+	{"firstNameTabs", "FirstName", false},
+	{"lastNameTabs", "LastName", false},
+	{"companyTabs", "Company", false},
+	{"titleTabs", "Title", false},
+	{"emailAddressTabs", "EmailAddress", false},
+	{"envelopeIdTabs", "EnvelopeID", false},
+	{"approveTabs", "Approve", false},
+	{"declineTabs", "Decline", false},
+	{"signerAttachmentTabs", "SignerAttachment", false},
+	{"textTabs", "Text", true},
+	{"checkboxTabs", "Checkbox", true},
+	{"listTabs", "List", true},
+	{"radioGroupTabs", "Radio", true},
+	{"dateTabs", "Date", true},
+	{"numberTabs", "Number", true},
+	{"ssnTabs", "SSN", true},
+	{"zipTabs", "ZIP5", true},
+	{"emailTabs", "Email", true},
+	{"noteTabs", "Note", true},
+	{"formulaTabs", "Formula", true},
+}
+
+// simTab contains the tab fields reported in xml messages.
+type simTab struct {
+	TabLabel          string       `json:"tabLabel"`
+	Name              string       `json:"name"`
+	Value             string       `json:"value"`
+	OriginalValue     string       `json:"originalValue"`
+	ValidationPattern string       `json:"validationPattern"`
+	DocumentID        string       `json:"documentId"`
+	PageNumber        string       `json:"pageNumber"`
+	XPosition         string       `json:"xPosition"`
+	YPosition         string       `json:"yPosition"`
+	Status            string       `json:"status"`
+	Selected          model.DSBool `json:"selected"`
+	GroupName         string       `json:"groupName"`
+	Radios            []simTab     `json:"radios"`
+}
+
+func tabStatuses(tabs *model.Tabs) ([]esign.TabStatusXML, error) {
+	if tabs == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(tabs)
+	if err != nil {
+		return nil, err
+	}
+	var lists map[string][]simTab
+	if err = json.Unmarshal(b, &lists); err != nil {
+		return nil, err
+	}
+	var statuses []esign.TabStatusXML
+	for _, tt := range tabTypes {
+		for _, t := range lists[tt.key] {
+			ts := esign.TabStatusXML{
+				TabType:           tt.xmlType,
+				Status:            t.Status,
+				XPosition:         t.XPosition,
+				YPosition:         t.YPosition,
+				TabLabel:          t.TabLabel,
+				TabName:           t.Name,
+				TabValue:          t.Value,
+				DocumentID:        t.DocumentID,
+				PageNumber:        t.PageNumber,
+				OriginalValue:     t.OriginalValue,
+				ValidationPattern: t.ValidationPattern,
+			}
+			if tt.custom {
+				ts.TabType, ts.CustomTabType = "Custom", tt.xmlType
+			}
+			switch tt.key {
+			case "checkboxTabs":
+				ts.TabValue = checked(t.Selected)
+			case "listTabs":
+				ts.ListSelectedValue = t.Value
+			case "radioGroupTabs":
+				for _, r := range t.Radios {
+					rs := ts
+					rs.TabLabel = t.GroupName
+					rs.TabName = r.Value
+					rs.TabValue = checked(r.Selected)
+					rs.Status = r.Status
+					rs.PageNumber, rs.XPosition, rs.YPosition = r.PageNumber, r.XPosition, r.YPosition
+					statuses = append(statuses, rs)
+				}
+				continue
+			}
+			statuses = append(statuses, ts)
+		}
+	}
+	return statuses, nil
+}
+
+func checked(selected model.DSBool) string {
+	if selected {
+		return "X"
+	}
+	return ""
+}
+
+// tabLists returns the json of model.Tabs.
+func tabLists(tabs *model.Tabs) (map[string][]map[string]interface{}, error) {
+	b, err := json.Marshal(tabs)
+	if err != nil {
+		return nil, err
+	}
+	var lists map[string][]map[string]interface{}
+	return lists, json.Unmarshal(b, &lists)
+}
+
+// xmlStatuses lists the xml form of api statuses.
+var xmlStatuses = map[string]string{
+	"autoresponded":        "AutoResponded",
+	"authenticationfailed": "AuthenticationFailed",
+	"faxpending":           "FaxPending",
+}
+
+// xmlStatus capitalizes an api status (e.g. completed becomes Completed).
+func xmlStatus(status string) string {
+	if s, ok := xmlStatuses[strings.ToLower(status)]; ok {
+		return s
+	}
+	if status == "" {
+		return ""
+	}
+	return strings.ToUpper(status[:1]) + strings.ToLower(status[1:])
+}
+
+func (m *Message) generated() time.Time {
+	if m.Generated.IsZero() {
+		return time.Now()
+	}
+	return m.Generated
+}
+
+// dsTime formats tm as a DSTime in UTC.  A zero tm returns nil.
+func dsTime(tm time.Time) *esign.DSTime {
+	if tm.IsZero() {
+		return nil
+	}
+	d := esign.DSTime(tm.UTC().Format("2006-01-02T15:04:05.999Z"))
+	return &d
+}
+
+func timeVal(tm *time.Time) time.Time {
+	if tm == nil {
+		return time.Time{}
+	}
+	return *tm
+}
+
+// Sign sets the X-DocuSign-Signature-N headers to the HMAC-SHA256 of body
+// for each secret.
+func Sign(hdr http.Header, body []byte, secrets ...string) {
+	for i, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		hdr.Set("X-DocuSign-Signature-"+strconv.Itoa(i+1), base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	}
+}
+
+// Sender posts messages to a Connect listener.
+type Sender struct {
+	// URL of the listener
+	URL string
+	// Format is XML or JSON.  If empty, XML is used.
+	Format string
+	// Secrets are the HMAC keys used to sign messages.  If empty, messages
+	// are not signed.
+	Secrets []string
+	// Client sends the messages.  If nil, a client with a DefaultTimeout
+	// timeout is used.
+	Client *http.Client
+}
+
+// DefaultTimeout limits each post of a Sender without a Client.
+const DefaultTimeout = 30 * time.Second
+
+var defaultClient = &http.Client{Timeout: DefaultTimeout}
+
+// Post sends the message returning the listener's response status.  An
+// error is returned only if the message could not be encoded or sent.
+func (s *Sender) Post(ctx context.Context, msg *Message) (int, error) {
+	format := s.Format
+	if format == "" {
+		format = XML
+	}
+	body, err := msg.Encode(format)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest("POST", s.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	if format == JSON {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	Sign(req.Header, body, s.Secrets...)
+	cl := s.Client
+	if cl == nil {
+		cl = defaultClient
+	}
+	res, err := cl.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+	return res.StatusCode, nil
+}
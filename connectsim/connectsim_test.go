@@ -0,0 +1,160 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package connectsim_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/connectsim"
+	"github.com/jfcote87/esign/v2.1/model"
+	"github.com/jfcote87/testutils"
+)
+
+const userInfo = `{"sub": "USER", "accounts": [
+	{"account_id": "ACCOUNT1", "is_default": true, "base_uri": "https://gotest.docusign.net"}]}`
+
+func testEnvelope(t *testing.T) *model.Envelope {
+	env, err := connectsim.NewEnvelope("Please sign").
+		Sender("Sam Sender", "sam@example.com").
+		Document("1", "contract.pdf").
+		CustomField("AccountId", "123456").
+		Signer("1", "Susan Smart", "susan@example.com", &model.Tabs{
+			SignHereTabs: []model.SignHere{{TabBase: model.TabBase{DocumentID: "1"}, TabPosition: model.TabPosition{TabLabel: "sig", PageNumber: "1"}}},
+			CheckboxTabs: []model.Checkbox{{TabBase: model.TabBase{DocumentID: "1"}, TabPosition: model.TabPosition{TabLabel: "agree"}, Selected: true}},
+			RadioGroupTabs: []model.RadioGroup{{TabBase: model.TabBase{DocumentID: "1"}, GroupName: "color", Radios: []model.Radio{
+				{Value: "red"}, {Value: "blue", Selected: true},
+			}}},
+		}).
+		CarbonCopy("2", "Bob Smith", "bob@example.com").
+		Envelope()
+	if err != nil {
+		t.Fatalf("Envelope: %v", err)
+	}
+	return env
+}
+
+func TestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2019, 11, 1, 12, 0, 0, 0, time.UTC)
+	msgs, err := connectsim.Lifecycle(testEnvelope(t), start, time.Minute,
+		"envelope-sent", "recipient-delivered:1", "recipient-completed:1", "envelope-completed")
+	if err != nil {
+		t.Fatalf("Lifecycle: %v", err)
+	}
+	if _, err = connectsim.Lifecycle(testEnvelope(t), start, time.Minute, "recipient-completed:9"); err == nil {
+		t.Errorf("expected unknown recipient error")
+	}
+
+	for _, format := range []string{connectsim.XML, connectsim.JSON} {
+		var events []*esign.ConnectEvent
+		srv := httptest.NewServer(&esign.ConnectHandler{
+			Secrets: []string{"old", "new"},
+			OnEvent: func(ctx context.Context, ev *esign.ConnectEvent) error {
+				events = append(events, ev)
+				return nil
+			},
+		})
+		sender := &connectsim.Sender{URL: srv.URL, Format: format, Secrets: []string{"new"}}
+		for _, msg := range msgs {
+			if status, err := sender.Post(ctx, msg); err != nil || status != http.StatusOK {
+				t.Fatalf("%s: post %s: %d %v", format, msg.Event, status, err)
+			}
+		}
+		sender.Secrets = []string{"wrong"}
+		if status, err := sender.Post(ctx, msgs[0]); err != nil || status != http.StatusUnauthorized {
+			t.Errorf("%s: expected 401 for invalid signature; got %d %v", format, status, err)
+		}
+		srv.Close()
+
+		if len(events) != 4 {
+			t.Fatalf("%s: expected 4 events; got %d", format, len(events))
+		}
+		if format == connectsim.JSON {
+			if events[1].Event != "recipient-delivered" || events[1].Recipient == nil || events[1].Recipient.Delivered.IsZero() {
+				t.Errorf("json: expected recipient-delivered; got %#v", events[1])
+			}
+		}
+		last := events[3]
+		if last.Event != "envelope-completed" || !last.GeneratedAt.Equal(start.Add(3*time.Minute)) || len(last.Recipients) != 2 {
+			t.Errorf("%s: unexpected completed event %#v", format, last)
+		}
+		env := last.Envelope
+		if env == nil || env.Recipients == nil || len(env.Recipients.Signers) != 1 || len(env.Recipients.CarbonCopies) != 1 {
+			t.Fatalf("%s: expected envelope recipients; got %#v", format, env)
+		}
+		signer := env.Recipients.Signers[0]
+		if signer.Status != "completed" || signer.SignedDateTime == nil || !signer.SignedDateTime.Equal(start.Add(2*time.Minute)) {
+			t.Errorf("%s: unexpected signer %#v", format, signer)
+		}
+		tabs := signer.Tabs
+		if tabs == nil || len(tabs.SignHereTabs) != 1 || tabs.SignHereTabs[0].RecipientID != "1" || len(tabs.CheckboxTabs) != 1 || !bool(tabs.CheckboxTabs[0].Selected) {
+			t.Fatalf("%s: unexpected tabs %#v", format, tabs)
+		}
+		if len(tabs.RadioGroupTabs) != 1 || len(tabs.RadioGroupTabs[0].Radios) != 2 || !bool(tabs.RadioGroupTabs[0].Radios[1].Selected) {
+			t.Errorf("%s: unexpected radio groups %#v", format, tabs.RadioGroupTabs)
+		}
+		if env.CustomFields == nil || len(env.CustomFields.TextCustomFields) != 1 || env.Status != "completed" {
+			t.Errorf("%s: unexpected envelope %#v", format, env)
+		}
+	}
+}
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	envPath := "/restapi/v2.1/accounts/ACCOUNT1/envelopes/ENV1"
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfo), nil),
+	}, &testutils.RequestTester{
+		Path:     envPath,
+		Query:    "include=custom_fields%2Cdocuments",
+		Response: testutils.MakeResponse(200, []byte(`{"envelopeId":"ENV1","status":"sent","emailSubject":"Contract","statusChangedDateTime":"2019-11-01T12:00:00Z","sentDateTime":"2019-11-01T12:00:00Z"}`), nil),
+	}, &testutils.RequestTester{
+		Path:     envPath + "/recipients",
+		Query:    "include_extended=true&include_tabs=true",
+		Response: testutils.MakeResponse(200, []byte(`{"signers":[{"recipientId":"1","name":"Susan Smart","email":"susan@example.com","status":"delivered","routingOrder":"1","tabs":{"textTabs":[{"tabLabel":"company","value":"Acme","documentId":"1"}]}}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:     envPath + "/form_data",
+		Response: testutils.MakeResponse(200, []byte(`{"envelopeId":"ENV1","recipientFormData":[{"recipientId":"1","formData":[{"name":"company","value":"Acme"}]}]}`), nil),
+	})
+	msg, err := connectsim.Fetch(ctx, cred, "ENV1")
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	b, err := msg.Encode(connectsim.XML)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	ev, err := esign.DecodeConnectEvent(b)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if ev.Event != "envelope-sent" || ev.EnvelopeID != "ENV1" || ev.Sent.IsZero() || len(ev.Recipients) != 1 || ev.Recipients[0].Status != "Delivered" {
+		t.Errorf("unexpected event %#v", ev)
+	}
+	rs := ev.XML.EnvelopeStatus.RecipientStatuses[0]
+	if len(rs.FormData) != 1 || rs.FormData[0].Value != "Acme" || len(rs.TabStatuses) != 1 || rs.TabStatuses[0].CustomTabType != "Text" {
+		t.Errorf("unexpected recipient status %#v", rs)
+	}
+	if msg.AccountID != "ACCOUNT1" || msg.UserID != "USER" {
+		t.Errorf("expected account and user from credential; got %q %q", msg.AccountID, msg.UserID)
+	}
+	if uri := msg.ConnectJSON().URI; uri != envPath {
+		t.Errorf("expected uri %s; got %s", envPath, uri)
+	}
+	if _, err = msg.Encode("yaml"); err == nil {
+		t.Errorf("expected unknown format error")
+	}
+}
@@ -0,0 +1,390 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+)
+
+// ConnectStreamDecoder decodes Connect xml messages that include documents
+// without holding the pdfs in memory.  The envelope status is decoded as soon
+// as its element ends, and each DocumentPDF's base64 content is decoded
+// directly to the writer returned by DocumentWriter.
+//
+//	d := &esign.ConnectStreamDecoder{
+//		OnEnvelopeStatus: func(es *esign.EnvelopeStatusXML) error {
+//			return saveStatus(es)
+//		},
+//		DocumentWriter: func(doc *esign.DocumentPdfXML) (io.WriteCloser, error) {
+//			return os.Create(filepath.Join(dir, filepath.Base(doc.Name)))
+//		},
+//	}
+//	err := d.Decode(r.Body)
+type ConnectStreamDecoder struct {
+	// OnEnvelopeStatus, if not nil, is called when the EnvelopeStatus
+	// element is decoded.
+	OnEnvelopeStatus func(*EnvelopeStatusXML) error
+	// DocumentWriter returns the destination of a pdf.  doc contains the
+	// DocumentPDF fields preceding the PDFBytes element (i.e. Name).  The
+	// writer is closed after the pdf is written.  If DocumentWriter is nil
+	// or returns a nil writer, the pdf is discarded.
+	DocumentWriter func(doc *DocumentPdfXML) (io.WriteCloser, error)
+}
+
+// Decode reads a Connect xml message from r.
+func (d *ConnectStreamDecoder) Decode(r io.Reader) error {
+	sc := &xmlScanner{br: bufio.NewReader(r)}
+	for {
+		tag, err := sc.nextTag()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if tag.end || tag.empty {
+			continue
+		}
+		switch tag.name {
+		case "EnvelopeStatus":
+			if err = d.envelopeStatus(sc, tag); err != nil {
+				return err
+			}
+		case "DocumentPDF":
+			if err = d.documentPDF(sc); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// envelopeStatus buffers and unmarshals the EnvelopeStatus element.
+func (d *ConnectStreamDecoder) envelopeStatus(sc *xmlScanner, start *xmlTag) error {
+	buf := bytes.NewBuffer(append([]byte(nil), start.raw...))
+	sc.capture = buf
+	defer func() { sc.capture = nil }()
+	if err := sc.skipElement(start.name); err != nil {
+		return err
+	}
+	var es EnvelopeStatusXML
+	if err := xml.Unmarshal(buf.Bytes(), &es); err != nil {
+		return err
+	}
+	if d.OnEnvelopeStatus != nil {
+		return d.OnEnvelopeStatus(&es)
+	}
+	return nil
+}
+
+// documentPDF reads the children of a DocumentPDF element streaming
+// PDFBytes to the DocumentWriter.
+func (d *ConnectStreamDecoder) documentPDF(sc *xmlScanner) error {
+	var doc DocumentPdfXML
+	for {
+		tag, err := sc.nextTag()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch {
+		case tag.end && tag.name == "DocumentPDF":
+			return nil
+		case tag.end || tag.empty:
+			continue
+		case tag.name == "PDFBytes":
+			if err = d.writePDF(sc, &doc); err != nil {
+				return err
+			}
+		case tag.name == "Name":
+			buf := bytes.NewBuffer(append([]byte(nil), tag.raw...))
+			sc.capture = buf
+			err = sc.skipElement(tag.name)
+			sc.capture = nil
+			if err != nil {
+				return err
+			}
+			var v struct {
+				Value string `xml:",chardata"`
+			}
+			if err = xml.Unmarshal(buf.Bytes(), &v); err != nil {
+				return err
+			}
+			doc.Name = v.Value
+		default:
+			if err = sc.skipElement(tag.name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (d *ConnectStreamDecoder) writePDF(sc *xmlScanner, doc *DocumentPdfXML) error {
+	var w io.WriteCloser
+	var err error
+	if d.DocumentWriter != nil {
+		if w, err = d.DocumentWriter(doc); err != nil {
+			return err
+		}
+	}
+	var dst io.Writer = ioutil.Discard
+	if w != nil {
+		dst = w
+	}
+	_, err = io.Copy(dst, base64.NewDecoder(base64.StdEncoding, &base64Text{sc: sc}))
+	if w != nil {
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("document %s: %v", doc.Name, err)
+	}
+	return nil
+}
+
+// base64Text reads element text up to the next tag removing
+// whitespace.  Character references, CDATA sections and comments
+// are resolved.
+type base64Text struct {
+	sc    *xmlScanner
+	cdata bool // reading a CDATA section
+	done  bool
+}
+
+func (b *base64Text) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) && !b.done {
+		c, err := b.next()
+		if err != nil {
+			return n, err
+		}
+		if c != 0 {
+			p[n] = c
+			n++
+		}
+	}
+	if n == 0 && b.done {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// next returns the next base64 character or 0 if a byte was consumed
+// without producing one.
+func (b *base64Text) next() (byte, error) {
+	br := b.sc.br
+	if b.cdata {
+		if pk, _ := br.Peek(3); string(pk) == "]]>" {
+			b.cdata = false
+			_, err := br.Discard(3)
+			return 0, err
+		}
+	} else if pk, _ := br.Peek(1); len(pk) > 0 && pk[0] == '<' {
+		if pk, _ = br.Peek(9); string(pk) == "<![CDATA[" {
+			b.cdata = true
+			_, err := br.Discard(9)
+			return 0, err
+		}
+		if pk, _ = br.Peek(4); string(pk) == "<!--" {
+			_, err := b.sc.readTag()
+			return 0, err
+		}
+		b.done = true
+		return 0, nil
+	}
+	c, err := br.ReadByte()
+	if err != nil {
+		return 0, unexpectedEOF(err)
+	}
+	switch {
+	case c == '&' && !b.cdata:
+		return b.reference()
+	case isSpace(c):
+		return 0, nil
+	}
+	return c, nil
+}
+
+// reference reads a character reference following '&'.  Only references
+// to whitespace and base64 characters are valid.
+func (b *base64Text) reference() (byte, error) {
+	var ref []byte
+	for len(ref) < 10 {
+		c, err := b.sc.br.ReadByte()
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		if c == ';' {
+			break
+		}
+		ref = append(ref, c)
+	}
+	var v uint64
+	var err error
+	switch {
+	case bytes.HasPrefix(ref, []byte("#x")):
+		v, err = strconv.ParseUint(string(ref[2:]), 16, 8)
+	case bytes.HasPrefix(ref, []byte("#")):
+		v, err = strconv.ParseUint(string(ref[1:]), 10, 8)
+	default:
+		err = errors.New("not a character reference")
+	}
+	c := byte(v)
+	switch {
+	case err != nil:
+	case isSpace(c):
+		return 0, nil
+	case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '+', c == '/', c == '=':
+		return c, nil
+	}
+	return 0, fmt.Errorf("invalid reference &%s; in base64 text", ref)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// xmlScanner reads the tags of an xml document.  Text between tags
+// is skipped unless read directly from br.
+type xmlScanner struct {
+	br *bufio.Reader
+	// capture, if not nil, receives all bytes read.
+	capture *bytes.Buffer
+}
+
+type xmlTag struct {
+	name  string // local name
+	end   bool
+	empty bool // self-closing
+	raw   []byte
+}
+
+// nextTag skips text, comments, processing instructions and directives
+// returning the next start or end tag.
+func (sc *xmlScanner) nextTag() (*xmlTag, error) {
+	for {
+		if err := sc.skipTo('<'); err != nil {
+			return nil, err
+		}
+		raw, err := sc.readTag()
+		if err != nil {
+			return nil, err
+		}
+		switch raw[1] {
+		case '?', '!':
+			continue
+		}
+		t := &xmlTag{raw: raw}
+		s := raw[1 : len(raw)-1]
+		if s[0] == '/' {
+			t.end, s = true, s[1:]
+		}
+		if len(s) > 0 && s[len(s)-1] == '/' {
+			t.empty, s = true, s[:len(s)-1]
+		}
+		if i := bytes.IndexAny(s, " \t\r\n"); i >= 0 {
+			s = s[:i]
+		}
+		if i := bytes.IndexByte(s, ':'); i >= 0 {
+			s = s[i+1:]
+		}
+		t.name = string(s)
+		return t, nil
+	}
+}
+
+// skipTo discards bytes up to delim.
+func (sc *xmlScanner) skipTo(delim byte) error {
+	for {
+		b, err := sc.br.ReadSlice(delim)
+		if err == bufio.ErrBufferFull {
+			sc.write(b)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		sc.write(b[:len(b)-1])
+		return sc.br.UnreadByte()
+	}
+}
+
+// readTag reads a tag beginning with '<' and ending with '>'.  Comments and
+// CDATA sections are read to their terminators.
+func (sc *xmlScanner) readTag() ([]byte, error) {
+	var buf []byte
+	var quote byte
+	for {
+		c, err := sc.br.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		buf = append(buf, c)
+		switch {
+		case bytes.HasPrefix(buf, []byte("<!--")):
+			if bytes.HasSuffix(buf, []byte("-->")) && len(buf) >= 7 {
+				sc.write(buf)
+				return buf, nil
+			}
+		case bytes.HasPrefix(buf, []byte("<![CDATA[")):
+			if bytes.HasSuffix(buf, []byte("]]>")) {
+				sc.write(buf)
+				return buf, nil
+			}
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			if len(buf) < 3 {
+				return nil, errors.New("xml: invalid tag")
+			}
+			sc.write(buf)
+			return buf, nil
+		}
+	}
+}
+
+// skipElement reads through the end tag of the current element.
+func (sc *xmlScanner) skipElement(name string) error {
+	depth := 1
+	for depth > 0 {
+		tag, err := sc.nextTag()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch {
+		case tag.name != name || tag.empty:
+		case tag.end:
+			depth--
+		default:
+			depth++
+		}
+	}
+	return nil
+}
+
+func (sc *xmlScanner) write(b []byte) {
+	if sc.capture != nil {
+		sc.capture.Write(b)
+	}
+}
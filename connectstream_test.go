@@ -0,0 +1,157 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jfcote87/esign"
+)
+
+type closeBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeBuffer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestConnectStreamDecoder(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/connect.xml")
+	if err != nil {
+		t.Fatalf("read connect.xml: %v", err)
+	}
+	pdfs := make([][]byte, 2)
+	var docs bytes.Buffer
+	docs.WriteString("<DocumentPDFs>\n<!-- documents -->\n")
+	for i := range pdfs {
+		pdfs[i] = make([]byte, 100000*(i+1))
+		rand.Read(pdfs[i])
+		enc := base64.StdEncoding.EncodeToString(pdfs[i])
+		docs.WriteString("<DocumentPDF>\n<Name>doc" + string(rune('1'+i)) + " &amp; terms.pdf</Name>\n<PDFBytes>")
+		for len(enc) > 76 {
+			docs.WriteString(enc[:76] + "\r\n")
+			enc = enc[76:]
+		}
+		docs.WriteString(enc + "</PDFBytes>\n<DocumentType>CONTENT</DocumentType>\n</DocumentPDF>\n")
+	}
+	docs.WriteString("</DocumentPDFs>\n</DocuSignEnvelopeInformation>")
+	msg := bytes.Replace(b, []byte("</DocuSignEnvelopeInformation>"), docs.Bytes(), 1)
+
+	var expected esign.ConnectData
+	if err = xml.Unmarshal(msg, &expected); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	var status *esign.EnvelopeStatusXML
+	var names []string
+	var outputs []*closeBuffer
+	d := &esign.ConnectStreamDecoder{
+		OnEnvelopeStatus: func(es *esign.EnvelopeStatusXML) error {
+			status = es
+			return nil
+		},
+		DocumentWriter: func(doc *esign.DocumentPdfXML) (io.WriteCloser, error) {
+			if status == nil {
+				t.Errorf("expected envelope status before documents")
+			}
+			names = append(names, doc.Name)
+			outputs = append(outputs, &closeBuffer{})
+			return outputs[len(outputs)-1], nil
+		},
+	}
+	if err = d.Decode(bytes.NewReader(msg)); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !reflect.DeepEqual(status, &expected.EnvelopeStatus) {
+		t.Errorf("expected envelope status to match xml.Unmarshal")
+	}
+	if !reflect.DeepEqual(names, []string{"doc1 & terms.pdf", "doc2 & terms.pdf"}) {
+		t.Errorf("expected document names; got %v", names)
+	}
+	for i, o := range outputs {
+		if !o.closed || !bytes.Equal(o.Bytes(), pdfs[i]) {
+			t.Errorf("pdf %d: expected %d closed bytes; got %d %v", i, len(pdfs[i]), o.Len(), o.closed)
+		}
+	}
+
+	// callback errors and truncated messages
+	d.OnEnvelopeStatus = func(es *esign.EnvelopeStatusXML) error {
+		return errors.New("status error")
+	}
+	if err = d.Decode(bytes.NewReader(msg)); err == nil || err.Error() != "status error" {
+		t.Errorf("expected status error; got %v", err)
+	}
+	d.OnEnvelopeStatus = nil
+	d.DocumentWriter = nil
+	if err = d.Decode(bytes.NewReader(msg[:len(msg)-200000])); err == nil || !strings.HasSuffix(err.Error(), io.ErrUnexpectedEOF.Error()) {
+		t.Errorf("expected unexpected EOF; got %v", err)
+	}
+}
+
+func TestConnectStreamDecoder_PDFBytes(t *testing.T) {
+	pdf := make([]byte, 300)
+	rand.Read(pdf)
+	enc := base64.StdEncoding.EncodeToString(pdf)
+	lines := []string{enc[:76], enc[76:152], enc[152:228], enc[228:]}
+	tests := []struct {
+		name    string
+		content string
+		invalid bool
+	}{
+		{name: "crlf references", content: strings.Join(lines, "&#xD;\n")},
+		{name: "decimal references", content: strings.Join(lines, "&#13;&#10;")},
+		{name: "base64 character reference", content: "&#" + strconv.Itoa(int(enc[0])) + ";" + enc[1:]},
+		{name: "cdata", content: "<![CDATA[" + strings.Join(lines, "\r\n") + "]]>"},
+		{name: "text and cdata", content: lines[0] + "\n<![CDATA[" + strings.Join(lines[1:], "\n") + "]]>\n"},
+		{name: "comment", content: lines[0] + "<!-- wrapped -->" + strings.Join(lines[1:], "")},
+		{name: "entity", content: lines[0] + "&amp;" + lines[1], invalid: true},
+		{name: "tag reference", content: lines[0] + "&#x3C;" + lines[1], invalid: true},
+		{name: "unterminated reference", content: lines[0] + "&#x0D0D0D0D0D0D" + lines[1], invalid: true},
+	}
+	for _, tt := range tests {
+		msg := "<DocuSignEnvelopeInformation><DocumentPDFs><DocumentPDF><Name>doc.pdf</Name><PDFBytes>" +
+			tt.content + "</PDFBytes></DocumentPDF></DocumentPDFs></DocuSignEnvelopeInformation>"
+		var out closeBuffer
+		d := &esign.ConnectStreamDecoder{
+			DocumentWriter: func(doc *esign.DocumentPdfXML) (io.WriteCloser, error) {
+				return &out, nil
+			},
+		}
+		err := d.Decode(strings.NewReader(msg))
+		if tt.invalid {
+			if err == nil {
+				t.Errorf("%s: expected error; got success", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !bytes.Equal(out.Bytes(), pdf) {
+			t.Errorf("%s: expected %d pdf bytes; got %d", tt.name, len(pdf), out.Len())
+		}
+		// compare with encoding/xml
+		var expected esign.ConnectData
+		if err = xml.Unmarshal([]byte(msg), &expected); err != nil || len(expected.DocumentPdfs) != 1 {
+			t.Fatalf("%s: xml.Unmarshal %v", tt.name, err)
+		}
+		if b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(expected.DocumentPdfs[0].PDFBytes), "")); err != nil || !bytes.Equal(b, pdf) {
+			t.Errorf("%s: expected xml.Unmarshal to match; got %v", tt.name, err)
+		}
+	}
+}
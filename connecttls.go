@@ -0,0 +1,294 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConnectClientCertError is returned when a Connect client certificate
+// is missing, does not chain to the configured roots or does not match the
+// pinned names.
+type ConnectClientCertError struct {
+	// Subject of the presented certificate, if any.
+	Subject string
+	Reason  string
+	// Err is the chain verification error, if any.
+	Err error
+}
+
+// Error fulfills error interface
+func (e *ConnectClientCertError) Error() string {
+	if e.Subject == "" {
+		return "connect client certificate: " + e.Reason
+	}
+	return fmt.Sprintf("connect client certificate %q: %s", e.Subject, e.Reason)
+}
+
+// Unwrap returns the chain verification error
+func (e *ConnectClientCertError) Unwrap() error {
+	return e.Err
+}
+
+// ConnectCertAudit records the verification of a Connect client
+// certificate.
+type ConnectCertAudit struct {
+	Time time.Time
+	// RemoteAddr is empty for certificates rejected during the TLS
+	// handshake.
+	RemoteAddr  string
+	Subject     string
+	Issuer      string
+	Serial      string
+	DNSNames    []string
+	Fingerprint string // hex encoded SHA-256 of the certificate
+	// Err is nil when the certificate was accepted.
+	Err error
+}
+
+// String formats the audit record as a single log line.
+func (a *ConnectCertAudit) String() string {
+	result := "accepted"
+	if a.Err != nil {
+		result = "rejected: " + a.Err.Error()
+	}
+	return fmt.Sprintf("%s connect client certificate %s remote=%q subject=%q issuer=%q serial=%s dns=%q sha256=%s",
+		a.Time.Format(time.RFC3339), result, a.RemoteAddr, a.Subject, a.Issuer, a.Serial,
+		strings.Join(a.DNSNames, ","), a.Fingerprint)
+}
+
+// ConnectClientCert verifies the X.509 client certificate that DocuSign
+// presents on Connect deliveries when the configuration enables mutual
+// TLS.  Use TLSConfig to verify and audit certificates once per connection
+// during the handshake and Handler to reject requests to the Connect
+// endpoint whose connection did not present an accepted certificate.  Both
+// checks complement HMAC verification by ConnectHandler.
+//
+//	cc := &esign.ConnectClientCert{
+//		Roots:    caPool,
+//		DNSNames: []string{"demo.docusign.net"},
+//		AuditLog: func(a *esign.ConnectCertAudit) { log.Print(a) },
+//	}
+//	srv := &http.Server{
+//		TLSConfig: cc.TLSConfig(nil),
+//		Handler:   cc.Handler(&esign.ConnectHandler{Secrets: secrets}),
+//	}
+//	err := srv.ListenAndServeTLS(certFile, keyFile)
+type ConnectClientCert struct {
+	// Roots are the CAs trusted to issue DocuSign's client certificate.
+	Roots *x509.CertPool
+	// Subjects, if not empty, pins the certificate's subject.  A value
+	// matches either the subject common name or the full distinguished
+	// name (e.g. "CN=demo.docusign.net,O=DocuSign\, Inc.,C=US").
+	Subjects []string
+	// DNSNames, if not empty, pins the certificate's subject alternative
+	// names.  The certificate must contain at least one of the names.
+	DNSNames []string
+	// AuditLog, if not nil, is called with the result of each handshake
+	// verification and each request rejected by Handler.
+	AuditLog func(*ConnectCertAudit)
+
+	accepted acceptedCerts
+}
+
+// Limits of the certificates remembered by ConnectClientCert.Handler.
+const (
+	// acceptedCertTTL is the time an accepted certificate is remembered
+	// after its handshake or last request.
+	acceptedCertTTL = time.Hour
+	// acceptedCertMax is the number of certificates remembered.  The least
+	// recently used certificate is forgotten first.
+	acceptedCertMax = 64
+)
+
+// acceptedCerts holds the expiry times of certificates, by fingerprint,
+// accepted during a handshake.
+type acceptedCerts struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+// add remembers fp until acceptedCertTTL after now, dropping expired
+// entries and, if full, the entry expiring first.
+func (ac *acceptedCerts) add(fp string, now time.Time) {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	if ac.expires == nil {
+		ac.expires = make(map[string]time.Time)
+	}
+	var oldest string
+	for k, exp := range ac.expires {
+		switch {
+		case !now.Before(exp):
+			delete(ac.expires, k)
+		case oldest == "" || exp.Before(ac.expires[oldest]):
+			oldest = k
+		}
+	}
+	if _, ok := ac.expires[fp]; !ok && len(ac.expires) >= acceptedCertMax {
+		delete(ac.expires, oldest)
+	}
+	ac.expires[fp] = now.Add(acceptedCertTTL)
+}
+
+// use returns true if fp has not expired, extending its expiry.
+func (ac *acceptedCerts) use(fp string, now time.Time) bool {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	exp, ok := ac.expires[fp]
+	if !ok {
+		return false
+	}
+	if !now.Before(exp) {
+		delete(ac.expires, fp)
+		return false
+	}
+	ac.expires[fp] = now.Add(acceptedCertTTL)
+	return true
+}
+
+// TLSConfig returns a copy of base, or a new config if base is nil, that
+// requires a client certificate verified by Verify.  Connections without
+// an accepted certificate fail during the handshake, so the config should
+// only be used by a listener dedicated to Connect deliveries.
+func (cc *ConnectClientCert) TLSConfig(base *tls.Config) *tls.Config {
+	cfg := &tls.Config{}
+	if base != nil {
+		cfg = base.Clone()
+	}
+	if cfg.MinVersion < tls.VersionTLS12 {
+		cfg.MinVersion = tls.VersionTLS12
+	}
+	// certificates, or their absence, are verified by Verify so that every
+	// rejection is audited
+	cfg.ClientAuth = tls.RequestClientCert
+	cfg.ClientCAs = cc.Roots
+	cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		certs := make([]*x509.Certificate, 0, len(rawCerts))
+		for _, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return err
+			}
+			certs = append(certs, cert)
+		}
+		err := cc.Verify(certs)
+		cc.audit("", certs, err)
+		if err == nil {
+			cc.accepted.add(fingerprint(certs[0]), time.Now())
+		}
+		return err
+	}
+	return cfg
+}
+
+// Handler returns a handler that calls next only for requests whose TLS
+// connection presented a certificate accepted during a handshake using
+// TLSConfig.  The certificate is not verified again, so requests on a
+// kept-alive connection are not audited.  Accepted certificates are
+// remembered for an hour after their last use, so a connection idle for
+// longer must be reestablished.  Other requests receive a 403 Forbidden
+// response.
+func (cc *ConnectClientCert) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var certs []*x509.Certificate
+		if r.TLS != nil {
+			certs = r.TLS.PeerCertificates
+		}
+		if err := cc.checkAccepted(certs); err != nil {
+			cc.audit(r.RemoteAddr, certs, err)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkAccepted returns an error unless the leaf certificate was accepted
+// during a handshake.
+func (cc *ConnectClientCert) checkAccepted(certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return &ConnectClientCertError{Reason: "no certificate presented"}
+	}
+	if !cc.accepted.use(fingerprint(certs[0]), time.Now()) {
+		return &ConnectClientCertError{Subject: certs[0].Subject.String(), Reason: "not accepted during handshake"}
+	}
+	return nil
+}
+
+// Verify checks that the first certificate chains to Roots through the
+// remaining certificates and matches the pinned names.  A
+// *ConnectClientCertError is returned on failure.
+func (cc *ConnectClientCert) Verify(certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return &ConnectClientCertError{Reason: "no certificate presented"}
+	}
+	leaf := certs[0]
+	if cc.Roots == nil {
+		return &ConnectClientCertError{Subject: leaf.Subject.String(), Reason: "no roots configured"}
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         cc.Roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return &ConnectClientCertError{Subject: leaf.Subject.String(), Reason: "chain verification failed", Err: err}
+	}
+	if len(cc.Subjects) > 0 && !matchAny(cc.Subjects, leaf.Subject.CommonName, leaf.Subject.String()) {
+		return &ConnectClientCertError{Subject: leaf.Subject.String(), Reason: "subject not pinned"}
+	}
+	if len(cc.DNSNames) > 0 && !matchAny(cc.DNSNames, leaf.DNSNames...) {
+		return &ConnectClientCertError{Subject: leaf.Subject.String(), Reason: "subject alternative names not pinned"}
+	}
+	return nil
+}
+
+// audit records a verification result in the audit log.
+func (cc *ConnectClientCert) audit(remoteAddr string, certs []*x509.Certificate, err error) {
+	if cc.AuditLog == nil {
+		return
+	}
+	a := &ConnectCertAudit{Time: time.Now(), RemoteAddr: remoteAddr, Err: err}
+	if len(certs) > 0 {
+		leaf := certs[0]
+		a.Subject = leaf.Subject.String()
+		a.Issuer = leaf.Issuer.String()
+		a.Serial = leaf.SerialNumber.String()
+		a.DNSNames = leaf.DNSNames
+		a.Fingerprint = fingerprint(leaf)
+	}
+	cc.AuditLog(a)
+}
+
+// fingerprint returns the hex encoded SHA-256 of cert.
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// matchAny returns true if any value equals a pinned name ignoring case.
+func matchAny(pinned []string, values ...string) bool {
+	for _, p := range pinned {
+		for _, v := range values {
+			if strings.EqualFold(p, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
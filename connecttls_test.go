@@ -0,0 +1,207 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate signed by parent.  A nil parent
+// creates a self-signed CA.
+func newTestCert(t *testing.T, parent *testCert, cn string, dnsNames ...string) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn, Organization: []string{"Test"}},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+func (tc *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{tc.cert.Raw}, PrivateKey: tc.key, Leaf: tc.cert}
+}
+
+func TestConnectClientCert_Verify(t *testing.T) {
+	ca := newTestCert(t, nil, "Test CA")
+	otherCA := newTestCert(t, nil, "Other CA")
+	good := newTestCert(t, ca, "connect.example.com", "connect.example.com")
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	cc := &esign.ConnectClientCert{
+		Roots:    roots,
+		Subjects: []string{"connect.example.com"},
+		DNSNames: []string{"CONNECT.example.com"},
+	}
+	tests := []struct {
+		name   string
+		certs  []*x509.Certificate
+		reason string
+	}{
+		{name: "accepted", certs: []*x509.Certificate{good.cert}},
+		{name: "none", reason: "no certificate presented"},
+		{name: "untrusted", certs: []*x509.Certificate{newTestCert(t, otherCA, "connect.example.com", "connect.example.com").cert}, reason: "chain verification failed"},
+		{name: "subject", certs: []*x509.Certificate{newTestCert(t, ca, "other.example.com", "connect.example.com").cert}, reason: "subject not pinned"},
+		{name: "san", certs: []*x509.Certificate{newTestCert(t, ca, "connect.example.com", "other.example.com").cert}, reason: "subject alternative names not pinned"},
+	}
+	for _, tt := range tests {
+		err := cc.Verify(tt.certs)
+		if tt.reason == "" {
+			if err != nil {
+				t.Errorf("%s: expected success; got %v", tt.name, err)
+			}
+			continue
+		}
+		var certErr *esign.ConnectClientCertError
+		if !errors.As(err, &certErr) || certErr.Reason != tt.reason {
+			t.Errorf("%s: expected %s; got %v", tt.name, tt.reason, err)
+		}
+	}
+	// subject may be pinned by distinguished name
+	cc = &esign.ConnectClientCert{Roots: roots, Subjects: []string{good.cert.Subject.String()}}
+	if err := cc.Verify([]*x509.Certificate{good.cert}); err != nil {
+		t.Errorf("expected distinguished name match; got %v", err)
+	}
+}
+
+func TestConnectClientCert_TLS(t *testing.T) {
+	ca := newTestCert(t, nil, "Test CA")
+	otherCA := newTestCert(t, nil, "Other CA")
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	var mu sync.Mutex
+	var audits []*esign.ConnectCertAudit
+	cc := &esign.ConnectClientCert{
+		Roots:    roots,
+		DNSNames: []string{"connect.example.com"},
+		AuditLog: func(a *esign.ConnectCertAudit) {
+			mu.Lock()
+			audits = append(audits, a)
+			mu.Unlock()
+		},
+	}
+	srv := httptest.NewUnstartedServer(cc.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+	srv.TLS = cc.TLSConfig(nil)
+	srv.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	post := func(cert *testCert) (*http.Response, error) {
+		cl := srv.Client()
+		tr := cl.Transport.(*http.Transport)
+		// send the certificate regardless of the server's acceptable CAs
+		tr.TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert == nil {
+				return &tls.Certificate{}, nil
+			}
+			c := cert.tlsCertificate()
+			return &c, nil
+		}
+		tr.CloseIdleConnections()
+		return cl.Post(srv.URL, "application/json", strings.NewReader("{}"))
+	}
+
+	res, err := post(newTestCert(t, ca, "connect", "connect.example.com"))
+	if err != nil {
+		t.Fatalf("expected accepted certificate; got %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected 200; got %d", res.StatusCode)
+	}
+	// a second request on the kept-alive connection is not audited
+	if res, err = srv.Client().Post(srv.URL, "application/json", strings.NewReader("{}")); err != nil {
+		t.Fatalf("expected second request accepted; got %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected 200 on second request; got %d", res.StatusCode)
+	}
+	for _, cert := range []*testCert{nil, newTestCert(t, otherCA, "connect", "connect.example.com"), newTestCert(t, ca, "connect", "other.example.com")} {
+		if res, err = post(cert); err == nil {
+			res.Body.Close()
+			t.Errorf("expected handshake failure; got %d", res.StatusCode)
+		}
+	}
+
+	mu.Lock()
+	// accepted: one handshake; rejected: each failed handshake
+	if len(audits) != 4 {
+		t.Fatalf("expected 4 audit entries; got %d", len(audits))
+	}
+	if audits[0].Err != nil || audits[0].Fingerprint == "" {
+		t.Errorf("expected accepted audit; got %s", audits[0])
+	}
+	for _, a := range audits[1:] {
+		if a.Err == nil || !strings.Contains(a.String(), "rejected") {
+			t.Errorf("expected rejected audit; got %s", a)
+		}
+	}
+	mu.Unlock()
+
+	// a request without a verified connection is forbidden
+	rec := httptest.NewRecorder()
+	cc.Handler(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest("POST", "/connect", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for request without certificate; got %d", rec.Code)
+	}
+	// as is a certificate not accepted during a handshake
+	req := httptest.NewRequest("POST", "/connect", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{newTestCert(t, ca, "connect", "connect.example.com").cert}}
+	rec = httptest.NewRecorder()
+	cc.Handler(http.NotFoundHandler()).ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for certificate not verified during handshake; got %d", rec.Code)
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/jfcote87/oauth2"
+)
+
+// CredentialState contains the serializable fields of an OAuth2Credential.
+// Use OAuth2Credential.State to create a snapshot for storage and
+// OAuth2Config.RestoreCredential or JWTConfig.RestoreCredential to
+// recreate the credential.
+type CredentialState struct {
+	Token       *oauth2.Token `json:"token,omitempty"`
+	UserInfo    *UserInfo     `json:"user_info,omitempty"`
+	AccountID   string        `json:"account_id,omitempty"`
+	BaseURI     string        `json:"base_uri,omitempty"`
+	Environment *Environment  `json:"environment,omitempty"`
+	ActAsUser   string        `json:"act_as_user,omitempty"`
+}
+
+// State returns a snapshot of the credential's token, userinfo, account id,
+// base uri and environment.
+func (cred *OAuth2Credential) State() *CredentialState {
+	cred.mu.Lock()
+	defer cred.mu.Unlock()
+	st := &CredentialState{
+		AccountID:   cred.accountID,
+		Environment: cred.env,
+		ActAsUser:   cred.actAsUser,
+	}
+	if cred.cachedToken != nil {
+		tk := *cred.cachedToken
+		st.Token = &tk
+	}
+	if cred.userInfo != nil {
+		u := *cred.userInfo
+		st.UserInfo = &u
+	}
+	if cred.baseURI != nil {
+		st.BaseURI = cred.baseURI.String()
+	}
+	return st
+}
+
+// credential creates an OAuth2Credential from the state.  The config's
+// env is authoritative; a state saved for a different environment is
+// rejected.
+func (st *CredentialState) credential(env *Environment, refresher func(context.Context, *oauth2.Token) (*oauth2.Token, error)) (*OAuth2Credential, error) {
+	if st == nil {
+		return nil, errors.New("nil credential state")
+	}
+	if err := st.checkEnvironment(env); err != nil {
+		return nil, err
+	}
+	cred := &OAuth2Credential{
+		accountID: st.AccountID,
+		refresher: refresher,
+		userInfo:  st.UserInfo,
+		env:       env,
+		actAsUser: st.ActAsUser,
+	}
+	if st.Token != nil {
+		tk := *st.Token
+		cred.cachedToken = &tk
+	}
+	if st.BaseURI > "" && st.AccountID > "" {
+		u, err := url.Parse(st.BaseURI)
+		if err != nil {
+			return nil, err
+		}
+		cred.baseURI = u
+	}
+	return cred, nil
+}
+
+// RestoreCredential recreates a credential from a CredentialState.  The
+// state's token must be valid or contain a refresh token.
+func (c *OAuth2Config) RestoreCredential(st *CredentialState) (*OAuth2Credential, error) {
+	if c == nil {
+		return nil, errors.New("nil configuration")
+	}
+	if st == nil || st.Token == nil {
+		return nil, errors.New("token may not be nil")
+	}
+	if !st.Token.Valid() && st.Token.RefreshToken == "" {
+		return nil, errors.New("empty refresh token")
+	}
+	cred, err := st.credential(c.environment(), c.refresher())
+	if err != nil {
+		return nil, err
+	}
+	cred.cacheFunc = c.CacheFunc
+	cred.Func = c.HTTPClientFunc
+	return cred, nil
+}
+
+// RestoreCredential recreates an impersonation credential for apiUserName
+// from a CredentialState.  If the state's token has expired, a new token is
+// obtained using the config's keys.
+func (c *JWTConfig) RestoreCredential(apiUserName string, st *CredentialState, scopes ...string) (*OAuth2Credential, error) {
+	if c == nil {
+		return nil, errors.New("nil configuration")
+	}
+	signers, err := c.signers()
+	if err != nil {
+		return nil, err
+	}
+	cred, err := st.credential(c.environment(), c.jwtRefresher(apiUserName, signers, scopes...))
+	if err != nil {
+		return nil, err
+	}
+	cred.cacheFunc = c.CacheFunc
+	cred.Func = c.HTTPClientFunc
+	return cred, nil
+}
+
+// StateCipher encrypts and decrypts serialized credential state.
+type StateCipher interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// Seal serializes the state as json and, if c is not nil, encrypts the result.
+func (st *CredentialState) Seal(c StateCipher) ([]byte, error) {
+	b, err := json.Marshal(st)
+	if err != nil || c == nil {
+		return b, err
+	}
+	return c.Encrypt(b)
+}
+
+// checkEnvironment returns an error if the state's Environment is set and
+// differs from env.
+func (st *CredentialState) checkEnvironment(env *Environment) error {
+	if st.Environment == nil || env == nil || *st.Environment == *env {
+		return nil
+	}
+	return fmt.Errorf("credential state environment %s does not match configured environment %s",
+		st.Environment.AuthHost, env.AuthHost)
+}
+
+// OpenCredentialState decrypts (if c is not nil) and unmarshals the output
+// of CredentialState.Seal.  If env is not nil, an error is returned when the
+// stored environment differs from env, which should be the environment of
+// the config used to restore the credential.
+func OpenCredentialState(b []byte, c StateCipher, env *Environment) (*CredentialState, error) {
+	var err error
+	if c != nil {
+		if b, err = c.Decrypt(b); err != nil {
+			return nil, err
+		}
+	}
+	var st *CredentialState
+	if err = json.Unmarshal(b, &st); err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, errors.New("empty credential state")
+	}
+	if err = st.checkEnvironment(env); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// AESGCMCipher returns a StateCipher using AES-GCM.  The key must be 16,
+// 24 or 32 bytes long.  A random nonce is prepended to each ciphertext.
+func AESGCMCipher(key []byte) (StateCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &gcmCipher{aead: aead}, nil
+}
+
+type gcmCipher struct {
+	aead cipher.AEAD
+}
+
+func (g *gcmCipher) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, g.aead.NonceSize(), g.aead.NonceSize()+len(plaintext)+g.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return g.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (g *gcmCipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	ns := g.aead.NonceSize()
+	if len(ciphertext) < ns {
+		return nil, errors.New("ciphertext too short")
+	}
+	return g.aead.Open(nil, ciphertext[:ns], ciphertext[ns:], nil)
+}
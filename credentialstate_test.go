@@ -0,0 +1,103 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/oauth2"
+	"github.com/jfcote87/testutils"
+)
+
+func TestCredentialState(t *testing.T) {
+	ctx := context.Background()
+	cfg, testTransport := getOAuth2ConfigTranspot()
+	var u *esign.UserInfo
+	if err := json.Unmarshal([]byte(userInfoSuccessResponse), &u); err != nil {
+		t.Fatalf("unmarshal userinfo: %v", err)
+	}
+	tk := &oauth2.Token{
+		AccessToken:  "ACCESSTOKEN",
+		RefreshToken: "REFRESHTOKEN",
+		Expiry:       time.Now().Add(time.Hour),
+	}
+	cred, err := cfg.Credential(tk, u)
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	st := cred.WithActAsUser("sender@example.com").State()
+	if st.AccountID != "fe0b61a3-3b9b-cafe-b7be-4592af32aa9b" || st.BaseURI != "https://gotest.docusign.net" {
+		t.Errorf("expected resolved default account; got %s %s", st.AccountID, st.BaseURI)
+	}
+	if st.Environment == nil || *st.Environment != *esign.DemoEnvironment() || st.Token.AccessToken != "ACCESSTOKEN" || st.ActAsUser != "sender@example.com" {
+		t.Errorf("unexpected state %#v", st)
+	}
+
+	cipher, err := esign.AESGCMCipher([]byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("AESGCMCipher: %v", err)
+	}
+	b, err := st.Seal(cipher)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if bytes.Contains(b, []byte("ACCESSTOKEN")) {
+		t.Errorf("expected encrypted state; found access token")
+	}
+	badCipher, _ := esign.AESGCMCipher([]byte("fedcba9876543210fedcba9876543210"))
+	if _, err = esign.OpenCredentialState(b, badCipher, nil); err == nil {
+		t.Errorf("expected decryption failure with wrong key; got success")
+	}
+	if _, err = esign.OpenCredentialState(b, cipher, esign.ProductionEnvironment()); err == nil {
+		t.Errorf("expected environment mismatch error; got success")
+	}
+	st2, err := esign.OpenCredentialState(b, cipher, esign.DemoEnvironment())
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if st2.Environment.AuthHost != "account-d.docusign.com" || st2.UserInfo.Email != "susan.smart@example.com" {
+		t.Errorf("unexpected restored state %#v", st2)
+	}
+
+	// a state pointing at an unconfigured host is rejected
+	tampered := *st2
+	tampered.Environment = &esign.Environment{AuthHost: "evil.example.com"}
+	if _, err = cfg.RestoreCredential(&tampered); err == nil {
+		t.Errorf("expected environment mismatch error on restore; got success")
+	}
+
+	restored, err := cfg.RestoreCredential(st2)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	// restored credential needs neither a token refresh nor a userinfo call
+	testTransport.Add(&testutils.RequestTester{
+		Host:   "gotest.docusign.net",
+		Path:   "/restapi/v2.1/accounts/fe0b61a3-3b9b-cafe-b7be-4592af32aa9b/envelopes",
+		Auth:   "Bearer ACCESSTOKEN",
+		Header: map[string][]string{"X-Docusign-Act-As-User": {"sender@example.com"}},
+	})
+	op := &esign.Op{
+		Credential: restored,
+		Method:     "GET",
+		Path:       "envelopes",
+		Version:    esign.VersionV21,
+	}
+	if err = op.Do(ctx, nil); err != nil {
+		t.Errorf("expected success from restored credential; got %v", err)
+	}
+
+	if _, err = cfg.RestoreCredential(&esign.CredentialState{Token: &oauth2.Token{AccessToken: "EXPIRED", Expiry: time.Now().Add(-time.Hour)}}); err == nil {
+		t.Errorf("expected empty refresh token error; got success")
+	}
+	if _, err = esign.OpenCredentialState([]byte("null"), nil, nil); err == nil {
+		t.Errorf("expected empty credential state error; got success")
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/jfcote87/ctxclient"
+	"github.com/jfcote87/oauth2"
+)
+
+// demoEnvironment defines the DocuSign developer sandbox account server.
+var demoEnvironment = Environment{
+	AuthHost:    "account-d.docusign.com",
+	TokenURL:    "https://account-d.docusign.com/oauth/token",
+	UserInfoURL: "https://account-d.docusign.com/oauth/userinfo",
+	JWKSURL:     "https://account-d.docusign.com/oauth/jwks",
+}
+
+// productionEnvironment defines the DocuSign production account server.
+var productionEnvironment = Environment{
+	AuthHost:    "account.docusign.com",
+	TokenURL:    "https://account.docusign.com/oauth/token",
+	UserInfoURL: "https://account.docusign.com/oauth/userinfo",
+	JWKSURL:     "https://account.docusign.com/oauth/jwks",
+}
+
+// DemoEnvironment returns a copy of the DocuSign developer sandbox
+// environment.
+func DemoEnvironment() *Environment {
+	env := demoEnvironment
+	return &env
+}
+
+// ProductionEnvironment returns a copy of the DocuSign production
+// environment.
+func ProductionEnvironment() *Environment {
+	env := productionEnvironment
+	return &env
+}
+
+// Environment describes the account server used for authorization,
+// token and userinfo calls.  Use DemoEnvironment() or
+// ProductionEnvironment() for DocuSign's servers, or create an Environment
+// to point at another auth host such as a local stand-in server for
+// testing.
+type Environment struct {
+	// AuthHost is the host (with optional port) of the account server.  It
+	// is used for the consent urls and as the audience of JWT assertions.
+	AuthHost string `json:"auth_host,omitempty"`
+	// TokenURL is the oauth2 token endpoint.  If blank,
+	// https://{AuthHost}/oauth/token is assumed.
+	TokenURL string `json:"token_url,omitempty"`
+	// UserInfoURL is the userinfo endpoint. If blank,
+	// https://{AuthHost}/oauth/userinfo is assumed.
+	UserInfoURL string `json:"userinfo_url,omitempty"`
+	// JWKSURL is the endpoint publishing the keys used to sign id tokens. If
+	// blank, https://{AuthHost}/oauth/jwks is assumed.
+	JWKSURL string `json:"jwks_url,omitempty"`
+	// BaseURI, if not empty, overrides the base_uri of the user's
+	// accounts returned from the userinfo endpoint.
+	BaseURI string `json:"base_uri,omitempty"`
+}
+
+// resolveEnvironment returns env if not nil.  Otherwise isDemo
+// determines the environment.
+func resolveEnvironment(env *Environment, isDemo bool) *Environment {
+	switch {
+	case env != nil:
+		return env
+	case isDemo:
+		return DemoEnvironment()
+	}
+	return ProductionEnvironment()
+}
+
+func (env *Environment) authURL() string {
+	return "https://" + env.AuthHost + "/oauth/auth"
+}
+
+func (env *Environment) tokenURL() string {
+	if env.TokenURL > "" {
+		return env.TokenURL
+	}
+	return "https://" + env.AuthHost + "/oauth/token"
+}
+
+func (env *Environment) userInfoURL() string {
+	if env.UserInfoURL > "" {
+		return env.UserInfoURL
+	}
+	return "https://" + env.AuthHost + "/oauth/userinfo"
+}
+
+func (env *Environment) jwksURL() string {
+	if env.JWKSURL > "" {
+		return env.JWKSURL
+	}
+	return "https://" + env.AuthHost + "/oauth/jwks"
+}
+
+// issuer returns the expected iss claim of id tokens
+func (env *Environment) issuer() string {
+	return "https://" + env.AuthHost + "/"
+}
+
+func (env *Environment) endpoint() oauth2.Endpoint {
+	return oauth2.Endpoint{
+		AuthURL:  env.authURL(),
+		TokenURL: env.tokenURL(),
+	}
+}
+
+// baseURI returns the BaseURI override if set.  Otherwise
+// u is returned.
+func (env *Environment) baseURI(u *url.URL) (*url.URL, error) {
+	if env == nil || env.BaseURI == "" {
+		return u, nil
+	}
+	return url.Parse(env.BaseURI)
+}
+
+func (env *Environment) getUserInfoForToken(ctx context.Context, f ctxclient.Func, tk *oauth2.Token) (*UserInfo, error) {
+	// needed to use token credential due to different host and path parameters for op
+	var u *UserInfo
+	err := (&Op{
+		Credential: &tokenCredential{tk, f},
+		Method:     "GET",
+		Path:       env.userInfoURL(),
+	}).Do(ctx, &u)
+	return u, err
+}
+
+// accountBaseURI returns the accountID and base uri for the user's account
+// id, applying the environment's BaseURI override.
+func (env *Environment) accountBaseURI(u *UserInfo, id string) (string, *url.URL, error) {
+	accountID, baseURI, err := u.getAccountID(id)
+	if err != nil {
+		return "", nil, err
+	}
+	baseURI, err = env.baseURI(baseURI)
+	return accountID, baseURI, err
+}
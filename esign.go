@@ -40,7 +40,10 @@ type APIVersion struct {
 	Prefix  string
 }
 
-// ResolveDSURL updates the passed *url.URL's settings.
+// ResolveDSURL updates the passed *url.URL's settings.  Relative paths are
+// appended to the version's account path.  Absolute paths (e.g. the
+// /v2.1/accounts/{accountId} path of accounts.Get) are appended to the
+// prefix with any {accountId} placeholder replaced by accountID.
 // https://developers.docusign.com/esign-rest-api/guides/authentication/user-info-endpoints#form-your-base-path
 func (v *APIVersion) ResolveDSURL(u *url.URL, host string, accountID string) *url.URL {
 	newURL := *u
@@ -57,7 +60,7 @@ func (v *APIVersion) ResolveDSURL(u *url.URL, host string, accountID string) *ur
 		}
 	}
 	if strings.HasPrefix(u.Path, "/") {
-		newURL.Path = prefix + u.Path
+		newURL.Path = prefix + strings.Replace(u.Path, "{accountId}", accountID, -1)
 	} else {
 		newURL.Path = prefix + version + "/accounts/" + accountID + "/" + u.Path
 	}
@@ -111,6 +114,8 @@ type ResponseError struct {
 	Status      int    `json:"-"`
 	Raw         []byte `json:"-"`
 	OriginalErr error  `json:"-"`
+	// Header contains the response headers, if available.
+	Header http.Header `json:"-"`
 }
 
 // Error fulfills error interface
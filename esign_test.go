@@ -54,6 +54,27 @@ func (t *TestCred) SetClient(cl *http.Client) {
 	}
 }
 
+func TestResolveDSURL(t *testing.T) {
+	tests := []struct {
+		version *esign.APIVersion
+		path    string
+		want    string
+	}{
+		{version: esign.VersionV21, path: "envelopes", want: "https://www.example.com/restapi/v2.1/accounts/1234/envelopes"},
+		{version: nil, path: "envelopes", want: "https://www.example.com/restapi/v2/accounts/1234/envelopes"},
+		{version: esign.VersionV21, path: "/v2.1/accounts/{accountId}", want: "https://www.example.com/restapi/v2.1/accounts/1234"},
+		{version: esign.VersionV21, path: "/v2.1/accounts/{accountId}/users/{accountId}", want: "https://www.example.com/restapi/v2.1/accounts/1234/users/1234"},
+		{version: esign.VersionV21, path: "/v2.1/accounts/provisioning", want: "https://www.example.com/restapi/v2.1/accounts/provisioning"},
+		{version: esign.ClickV1, path: "/v1/accounts/{accountId}/clickwraps", want: "https://www.example.com/clickapi/v1/accounts/1234/clickwraps"},
+	}
+	for _, tt := range tests {
+		u := tt.version.ResolveDSURL(&url.URL{Path: tt.path}, "www.example.com", "1234")
+		if u.String() != tt.want {
+			t.Errorf("%s: expected %s; got %s", tt.path, tt.want, u)
+		}
+	}
+}
+
 func TestOp_Do(t *testing.T) {
 	// Setup test credential and transport to check for path substitution
 	cx, testTransport := getTestCredentialClientTransport()
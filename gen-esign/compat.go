@@ -0,0 +1,420 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// CompatService describes the ops shared by the v2 and v2.1 versions
+// of a service.
+type CompatService struct {
+	Package string
+	Ops     []*CompatOp
+	Skipped []string // ops not shared by both versions
+}
+
+// CompatOp is an op whose service method has the same parameters in
+// both versions.
+type CompatOp struct {
+	Name      string // Service method
+	OpType    string
+	Doc       string
+	Params    []CompatParam
+	Options   []*CompatMethod // methods returning the op
+	Executors []*CompatMethod // methods executing the op (e.g. Do)
+}
+
+// CompatMethod is a method of an op type.
+type CompatMethod struct {
+	Name    string
+	Params  []CompatParam
+	Results []string
+}
+
+// CompatParam is a func parameter.  Type is the v2.1 type.
+type CompatParam struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// compatServiceFuncs contains the Service methods and op types of
+// a generated service package.
+type compatServiceFuncs struct {
+	methods map[string]*ast.FuncDecl
+	order   []string
+	opTypes map[string][]*ast.FuncDecl
+}
+
+// genCompat creates a compat subpackage for each service found in both
+// the generated v2 and v2.1 packages.
+func genCompat(baseDir string, tmpl *template.Template) error {
+	dirs, err := ioutil.ReadDir(path.Join(baseDir, "v2.1"))
+	if err != nil {
+		return err
+	}
+	for _, d := range dirs {
+		if !d.IsDir() || d.Name() == "model" {
+			continue
+		}
+		pkg := d.Name()
+		v2, err := parseServiceFuncs(path.Join(baseDir, "v2", pkg))
+		if os.IsNotExist(err) {
+			log.Printf("compat: %s not in v2", pkg)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		v21, err := parseServiceFuncs(path.Join(baseDir, "v2.1", pkg))
+		if err != nil {
+			return err
+		}
+		svc := compatOps(pkg, v2, v21)
+		log.Printf("compat: %s %d shared ops, %d skipped", pkg, len(svc.Ops), len(svc.Skipped))
+		if err = writeCompatService(baseDir, tmpl, svc); err != nil {
+			return fmt.Errorf("compat %s: %v", pkg, err)
+		}
+	}
+	return nil
+}
+
+// parseServiceFuncs reads the Service methods and op type methods
+// of the package in dir.
+func parseServiceFuncs(dir string) (*compatServiceFuncs, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	sf := &compatServiceFuncs{
+		methods: make(map[string]*ast.FuncDecl),
+		opTypes: make(map[string][]*ast.FuncDecl),
+	}
+	for _, p := range pkgs {
+		var fileNames []string
+		for fn := range p.Files {
+			fileNames = append(fileNames, fn)
+		}
+		sort.Strings(fileNames)
+		for _, fn := range fileNames {
+			for _, decl := range p.Files[fn].Decls {
+				fd, ok := decl.(*ast.FuncDecl)
+				if !ok || fd.Recv == nil || !fd.Name.IsExported() {
+					continue
+				}
+				recv := types.ExprString(fd.Recv.List[0].Type)
+				if recv == "*Service" {
+					sf.methods[fd.Name.Name] = fd
+					sf.order = append(sf.order, fd.Name.Name)
+					continue
+				}
+				recv = strings.TrimPrefix(recv, "*")
+				sf.opTypes[recv] = append(sf.opTypes[recv], fd)
+			}
+		}
+	}
+	return sf, nil
+}
+
+// compatOps lists the ops of v21 found in v2 with identical signatures.
+func compatOps(pkg string, v2, v21 *compatServiceFuncs) *CompatService {
+	svc := &CompatService{Package: pkg}
+	for _, nm := range v21.order {
+		fd21, fd2 := v21.methods[nm], v2.methods[nm]
+		if fd2 == nil || signature(fd21) != signature(fd2) {
+			svc.Skipped = append(svc.Skipped, nm)
+			continue
+		}
+		op := &CompatOp{
+			Name:   nm,
+			OpType: strings.TrimPrefix(types.ExprString(fd21.Type.Results.List[0].Type), "*"),
+			Doc:    firstSentence(fd21.Doc),
+			Params: params(fd21.Type.Params),
+		}
+		v2Methods := make(map[string]string)
+		for _, m := range v2.opTypes[op.OpType] {
+			v2Methods[m.Name.Name] = signature(m)
+		}
+		for _, m := range v21.opTypes[op.OpType] {
+			if v2Methods[m.Name.Name] != signature(m) {
+				continue
+			}
+			cm := &CompatMethod{Name: m.Name.Name, Params: params(m.Type.Params)}
+			for _, r := range fieldTypes(m.Type.Results) {
+				cm.Results = append(cm.Results, r)
+			}
+			if len(cm.Results) == 1 && cm.Results[0] == "*"+op.OpType {
+				op.Options = append(op.Options, cm)
+				continue
+			}
+			if !validResults(cm.Results) {
+				continue
+			}
+			op.Executors = append(op.Executors, cm)
+		}
+		if !hasDo(op.Executors) {
+			svc.Skipped = append(svc.Skipped, nm)
+			continue
+		}
+		svc.Ops = append(svc.Ops, op)
+	}
+	return svc
+}
+
+// signature returns the parameter and result types of fd.
+func signature(fd *ast.FuncDecl) string {
+	return types.ExprString(fd.Type)
+}
+
+func params(fl *ast.FieldList) []CompatParam {
+	var list []CompatParam
+	for _, f := range fl.List {
+		typ := f.Type
+		variadic := false
+		if ell, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ell.Elt, true
+		}
+		for _, n := range f.Names {
+			list = append(list, CompatParam{Name: n.Name, Type: types.ExprString(typ), Variadic: variadic})
+		}
+	}
+	return list
+}
+
+func fieldTypes(fl *ast.FieldList) []string {
+	var list []string
+	if fl == nil {
+		return nil
+	}
+	for _, f := range fl.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			list = append(list, types.ExprString(f.Type))
+		}
+	}
+	return list
+}
+
+// validResults reports whether results are pointers followed by an error.
+func validResults(results []string) bool {
+	if len(results) == 0 || results[len(results)-1] != "error" {
+		return false
+	}
+	for _, r := range results[:len(results)-1] {
+		if !strings.HasPrefix(r, "*") {
+			return false
+		}
+	}
+	return true
+}
+
+func hasDo(executors []*CompatMethod) bool {
+	for _, m := range executors {
+		if m.Name == "Do" {
+			return true
+		}
+	}
+	return false
+}
+
+// firstSentence returns the first line of a doc comment.
+func firstSentence(cg *ast.CommentGroup) string {
+	if cg == nil {
+		return ""
+	}
+	line := strings.SplitN(strings.TrimSpace(cg.Text()), "\n", 2)[0]
+	return strings.TrimSpace(line)
+}
+
+// writeCompatService creates compat/{pkg}/{pkg}.go.
+func writeCompatService(baseDir string, tmpl *template.Template, svc *CompatService) error {
+	dir := path.Join(baseDir, "compat", svc.Package)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	fn := path.Join(dir, svc.Package+".go")
+	f, err := os.Create(fn)
+	if err != nil {
+		return err
+	}
+	data := struct {
+		*CompatService
+		Packages []string
+		BasePkg  string
+	}{
+		CompatService: svc,
+		Packages:      svc.imports(*basePkg),
+		BasePkg:       *basePkg,
+	}
+	err = tmpl.Execute(f, data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil && !*skipFormat {
+		err = exec.Command("gofmt", "-s", "-w", fn).Run()
+	}
+	return err
+}
+
+// imports returns the packages used by the generated file.
+func (svc *CompatService) imports(base string) []string {
+	std := map[string]bool{`"context"`: true}
+	pkgs := []string{
+		`"` + base + `"`,
+		`v2` + svc.Package + ` "` + base + `/v2/` + svc.Package + `"`,
+		`"` + base + `/v2.1/` + svc.Package + `"`,
+	}
+	var hasModel, hasConversion bool
+	check := func(typ string) {
+		switch {
+		case strings.Contains(typ, "model."):
+			hasModel = true
+		case strings.Contains(typ, "io."):
+			std[`"io"`] = true
+		case strings.Contains(typ, "time."):
+			std[`"time"`] = true
+		}
+	}
+	for _, op := range svc.Ops {
+		hasConversion = hasConversion || op.HasConversion()
+		for _, p := range op.Params {
+			check(p.Type)
+		}
+		for _, m := range append(op.Options, op.Executors...) {
+			for _, p := range m.Params {
+				check(p.Type)
+			}
+			for _, r := range m.Results {
+				check(r)
+			}
+		}
+	}
+	if hasConversion {
+		pkgs = append(pkgs, `"`+base+`/compat"`)
+	}
+	if hasModel {
+		pkgs = append(pkgs, `"`+base+`/v2.1/model"`)
+	}
+	if hasConversion {
+		pkgs = append(pkgs, `v2model "`+base+`/v2/model"`)
+	}
+	var list []string
+	for k := range std {
+		list = append(list, k)
+	}
+	sort.Strings(list)
+	sort.Strings(pkgs)
+	return append(append(list, ""), pkgs...)
+}
+
+// HasConversion reports whether any parameter or result uses a
+// model type.
+func (op *CompatOp) HasConversion() bool {
+	if len(op.ConvertedParams()) > 0 {
+		return true
+	}
+	for _, m := range op.Executors {
+		if m.ConvertsResult() {
+			return true
+		}
+	}
+	return false
+}
+
+// ConvertedParams returns the parameters using model types.
+func (op *CompatOp) ConvertedParams() []CompatParam {
+	var list []CompatParam
+	for _, p := range op.Params {
+		if strings.Contains(p.Type, "model.") {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// ParamList returns the parameter declarations.
+func ParamList(list []CompatParam) string {
+	var s []string
+	for _, p := range list {
+		if p.Variadic {
+			s = append(s, p.Name+" ..."+p.Type)
+			continue
+		}
+		s = append(s, p.Name+" "+p.Type)
+	}
+	return strings.Join(s, ", ")
+}
+
+// ArgList returns the call arguments.  Model parameters are replaced
+// by their converted v2 values if v2 is set.
+func ArgList(list []CompatParam, v2 bool) string {
+	var s []string
+	for _, p := range list {
+		nm := p.Name
+		if v2 && strings.Contains(p.Type, "model.") {
+			nm = "v2" + nm
+		}
+		if p.Variadic {
+			nm += "..."
+		}
+		s = append(s, nm)
+	}
+	return strings.Join(s, ", ")
+}
+
+// V2Type returns typ using the v2 model package.
+func V2Type(typ string) string {
+	return strings.Replace(typ, "model.", "v2model.", -1)
+}
+
+// ResultList returns the method's results.
+func (m *CompatMethod) ResultList() string {
+	if len(m.Results) == 1 {
+		return m.Results[0]
+	}
+	return "(" + strings.Join(m.Results, ", ") + ")"
+}
+
+// ConvertsResult reports whether the method returns a model type.
+func (m *CompatMethod) ConvertsResult() bool {
+	return len(m.Results) == 2 && strings.Contains(m.Results[0], "model.")
+}
+
+// ErrReturn returns the return statement values for err.
+func (m *CompatMethod) ErrReturn(err string) string {
+	s := make([]string, 0, len(m.Results))
+	for range m.Results[:len(m.Results)-1] {
+		s = append(s, "nil")
+	}
+	return strings.Join(append(s, err), ", ")
+}
+
+var compatFuncs = template.FuncMap{
+	"ParamList": ParamList,
+	"ArgList":   ArgList,
+	"V2Type":    V2Type,
+}
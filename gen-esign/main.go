@@ -4,7 +4,9 @@
 // license that can be found in the LICENSE file.
 
 // gen-esign creates the esign subpackages based upon DocuSign's
-// esignature.rest.swagger.json definition file.
+// esignature.rest.swagger.json definition file.  With the -compat flag,
+// gen-esign instead creates the compat subpackages from the generated
+// v2 and v2.1 packages.
 
 package main
 
@@ -33,6 +35,7 @@ var (
 	buildFlag   = flag.Bool("build", false, "Compile generated packages.")
 	swaggerFile = flag.String("swagger_file", "gen-esign/esignature.rest.swagger-v2.1.json,gen-esign/esignature.rest.swagger.json", "If non-empty, comma separated list to local files containing the API version definitions.")
 	skipFormat  = flag.Bool("skip_format", false, "skip gofmt command")
+	compatFlag  = flag.Bool("compat", false, "Generate the compat packages from the generated v2 and v2.1 packages.")
 )
 
 // Version contains parameters for generating an eSignature version
@@ -67,6 +70,16 @@ func main() {
 	if !strings.HasPrefix(*templDir, "/") {
 		*templDir = path.Join(*baseDir, *templDir)
 	}
+	if *compatFlag {
+		compatTmpl, err := template.New("compat.tmpl").Funcs(compatFuncs).ParseFiles(path.Join(*templDir, "compat.tmpl"))
+		if err != nil {
+			log.Fatalf("Templates: %v", err)
+		}
+		if err = genCompat(*baseDir, compatTmpl); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
 	genTemplates, err := template.ParseFiles(path.Join(*templDir, "service.tmpl"), path.Join(*templDir, "/model.tmpl"))
 	if err != nil {
 		log.Fatalf("Templates: %v", err)
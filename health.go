@@ -0,0 +1,174 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Health check names
+const (
+	HealthCheckToken     = "token"
+	HealthCheckUserInfo  = "userinfo"
+	HealthCheckAccount   = "account"
+	HealthCheckClockSkew = "clock_skew"
+)
+
+// HealthCheck verifies that a credential is able to call DocuSign.  A
+// HealthCheck may be used as an http.Handler, responding with a json
+// HealthReport and a status of 200 if healthy and 503 otherwise.  Unless
+// IncludeDetails is set, the handler reports only status and latency.
+type HealthCheck struct {
+	Credential Credential
+	// MaxClockSkew is the largest allowed difference between the local clock
+	// and the DocuSign server's Date header.  If 0, 1 minute is assumed.
+	MaxClockSkew time.Duration
+	// MaxLatency, if not 0, fails the account check when the account call
+	// takes longer.
+	MaxLatency time.Duration
+	// IncludeDetails adds the user, account, base uri, clock skew and
+	// individual check results to the handler's response.  Set only when
+	// the handler is not reachable by unauthenticated callers.
+	IncludeDetails bool
+}
+
+// HealthReport contains the results of a HealthCheck.
+type HealthReport struct {
+	Healthy   bool      `json:"healthy"`
+	CheckedAt time.Time `json:"checked_at"`
+	UserID    string    `json:"user_id,omitempty"`
+	AccountID string    `json:"account_id,omitempty"`
+	BaseURI   string    `json:"base_uri,omitempty"`
+	// Latency is the round trip time of the account call.
+	Latency time.Duration `json:"latency"`
+	// ClockSkew is the server time minus the local time.
+	ClockSkew time.Duration       `json:"clock_skew"`
+	Checks    []HealthCheckResult `json:"checks"`
+}
+
+// HealthCheckResult is the outcome of a single check.
+type HealthCheckResult struct {
+	Name     string        `json:"name"`
+	OK       bool          `json:"ok"`
+	Skipped  bool          `json:"skipped,omitempty"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Run performs the checks.  Token and userinfo checks are performed
+// only for an *OAuth2Credential and are skipped for other credentials.
+func (h *HealthCheck) Run(ctx context.Context) *HealthReport {
+	rpt := &HealthReport{CheckedAt: time.Now()}
+	if h == nil || h.Credential == nil {
+		rpt.add(HealthCheckToken, time.Time{}, errors.New("nil credential"))
+		return rpt
+	}
+	var err error
+	if cred, ok := h.Credential.(*OAuth2Credential); ok {
+		tm := time.Now()
+		_, err = cred.Token(ctx)
+		rpt.add(HealthCheckToken, tm, err)
+		if err != nil {
+			return rpt
+		}
+		tm = time.Now()
+		var u *UserInfo
+		if u, err = cred.UserInfo(ctx); err == nil {
+			st := cred.State()
+			rpt.UserID, rpt.AccountID, rpt.BaseURI = u.APIUsername, st.AccountID, st.BaseURI
+		}
+		rpt.add(HealthCheckUserInfo, tm, err)
+		if err != nil {
+			return rpt
+		}
+	} else {
+		rpt.skip(HealthCheckToken)
+		rpt.skip(HealthCheckUserInfo)
+	}
+
+	tm := time.Now()
+	serverTime, err := h.getAccount(ctx)
+	rpt.Latency = time.Since(tm)
+	if err == nil && h.MaxLatency > 0 && rpt.Latency > h.MaxLatency {
+		err = fmt.Errorf("latency %v exceeds %v", rpt.Latency, h.MaxLatency)
+	}
+	rpt.add(HealthCheckAccount, tm, err)
+	if serverTime.IsZero() {
+		rpt.skip(HealthCheckClockSkew)
+		return rpt
+	}
+	// compare server time to the midpoint of the request
+	rpt.ClockSkew = serverTime.Sub(tm.Add(rpt.Latency / 2)).Truncate(time.Second)
+	maxSkew := h.MaxClockSkew
+	if maxSkew == 0 {
+		maxSkew = time.Minute
+	}
+	err = nil
+	if rpt.ClockSkew > maxSkew || rpt.ClockSkew < -maxSkew {
+		err = fmt.Errorf("clock skew %v exceeds %v", rpt.ClockSkew, maxSkew)
+	}
+	rpt.add(HealthCheckClockSkew, time.Time{}, err)
+	return rpt
+}
+
+// getAccount calls the v2.1 get account endpoint returning the
+// response's Date header.
+func (h *HealthCheck) getAccount(ctx context.Context) (time.Time, error) {
+	req, err := http.NewRequest("GET", "/v2.1/accounts/{accountId}", nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := h.Credential.AuthDo(ctx, req, VersionV21)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer res.Body.Close()
+	serverTime, _ := http.ParseTime(res.Header.Get("Date"))
+	return serverTime, nil
+}
+
+// add appends a check result.  The report is healthy only if
+// all checks succeed.
+func (rpt *HealthReport) add(name string, start time.Time, err error) {
+	r := HealthCheckResult{Name: name, OK: err == nil}
+	if !start.IsZero() {
+		r.Duration = time.Since(start)
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	rpt.Checks = append(rpt.Checks, r)
+	rpt.Healthy = true
+	for _, c := range rpt.Checks {
+		if !c.OK && !c.Skipped {
+			rpt.Healthy = false
+		}
+	}
+}
+
+func (rpt *HealthReport) skip(name string) {
+	rpt.Checks = append(rpt.Checks, HealthCheckResult{Name: name, Skipped: true})
+}
+
+// ServeHTTP runs the health check and writes the json report.
+func (h *HealthCheck) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rpt := h.Run(r.Context())
+	if h == nil || !h.IncludeDetails {
+		rpt = &HealthReport{Healthy: rpt.Healthy, CheckedAt: rpt.CheckedAt, Latency: rpt.Latency}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !rpt.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(rpt)
+}
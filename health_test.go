@@ -0,0 +1,96 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/testutils"
+)
+
+func TestHealthCheck(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	accountPath := "/restapi/v2.1/accounts/fe0b61a3-3b9b-cafe-b7be-4592af32aa9b"
+	dateHeader := func(offset time.Duration) http.Header {
+		return http.Header{"Date": {time.Now().Add(offset).UTC().Format(http.TimeFormat)}}
+	}
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfoSuccessResponse), nil),
+	}, &testutils.RequestTester{
+		Host:     "gotest.docusign.net",
+		Path:     accountPath,
+		Response: testutils.MakeResponse(200, []byte(`{"accountName":"World Wide Co"}`), dateHeader(0)),
+	})
+	hc := &esign.HealthCheck{Credential: cred}
+	rpt := hc.Run(ctx)
+	if !rpt.Healthy || len(rpt.Checks) != 4 {
+		t.Fatalf("expected healthy report with 4 checks; got %#v", rpt)
+	}
+	if rpt.AccountID != "fe0b61a3-3b9b-cafe-b7be-4592af32aa9b" || rpt.BaseURI != "https://gotest.docusign.net" || rpt.UserID != "50d89ab1-dad5-d00d-b410-92ee3110b970" {
+		t.Errorf("expected resolved user and account; got %s %s %s", rpt.UserID, rpt.AccountID, rpt.BaseURI)
+	}
+
+	// handler reports only status and latency by default
+	testTransport.Add(&testutils.RequestTester{
+		Path:     accountPath,
+		Response: testutils.MakeResponse(200, []byte(`{}`), dateHeader(0)),
+	})
+	rec := httptest.NewRecorder()
+	hc.ServeHTTP(rec, httptest.NewRequest("GET", "/health", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status 200; got %d", rec.Code)
+	}
+	rpt = nil
+	if err := json.Unmarshal(rec.Body.Bytes(), &rpt); err != nil {
+		t.Fatalf("unmarshal report: %v", err)
+	}
+	if !rpt.Healthy || rpt.UserID != "" || rpt.AccountID != "" || rpt.BaseURI != "" || len(rpt.Checks) != 0 {
+		t.Errorf("expected report without details; got %s", rec.Body.String())
+	}
+
+	// clock skew served over http
+	hc.IncludeDetails = true
+	testTransport.Add(&testutils.RequestTester{
+		Path:     accountPath,
+		Response: testutils.MakeResponse(200, []byte(`{}`), dateHeader(5*time.Minute)),
+	})
+	rec = httptest.NewRecorder()
+	hc.ServeHTTP(rec, httptest.NewRequest("GET", "/health", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status 503; got %d", rec.Code)
+	}
+	rpt = nil
+	if err := json.Unmarshal(rec.Body.Bytes(), &rpt); err != nil {
+		t.Fatalf("unmarshal report: %v", err)
+	}
+	if rpt.AccountID == "" {
+		t.Errorf("expected account id with IncludeDetails")
+	}
+	if last := rpt.Checks[len(rpt.Checks)-1]; last.Name != esign.HealthCheckClockSkew || last.OK || rpt.ClockSkew < 4*time.Minute {
+		t.Errorf("expected clock skew failure; got %#v", rpt)
+	}
+
+	// account error
+	testTransport.Add(&testutils.RequestTester{
+		Path:     accountPath,
+		Response: testutils.MakeResponse(401, []byte(`{"errorCode":"USER_AUTHENTICATION_FAILED","message":"invalid token"}`), nil),
+	})
+	rpt = hc.Run(ctx)
+	if rpt.Healthy || rpt.Checks[2].Name != esign.HealthCheckAccount || rpt.Checks[2].Error == "" || !rpt.Checks[3].Skipped {
+		t.Errorf("expected account failure; got %#v", rpt)
+	}
+}
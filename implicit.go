@@ -0,0 +1,87 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// implicit.go implements the implicit grant flow for browser based
+// applications.
+// https://developers.docusign.com/esign-rest-api/guides/authentication/oauth2-implicit
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/oauth2"
+)
+
+// ErrTokenExpired is returned by a credential without refresh
+// capabilities once its token expires.  The user must reauthorize.
+var ErrTokenExpired = errors.New("token expired; reauthorization required")
+
+// ImplicitGrantURL returns a URL to DocuSign's consent page requesting an
+// access token be returned in the redirect url's fragment
+// (response_type=token).  The Secret and ExtendedLifetime settings are
+// ignored as the implicit grant does not issue refresh tokens.
+//
+// If scopes are empty, {"signature"} is assumed.
+func (c *OAuth2Config) ImplicitGrantURL(state string, scopes ...string) string {
+	if len(scopes) == 0 {
+		scopes = []string{"signature"}
+	}
+	return c.authURL(state, scopes, oauth2.SetAuthURLParam("response_type", "token"))
+}
+
+// ImplicitCredential parses the fragment returned to the redirect url of
+// an implicit grant and returns a credential that fails with ErrTokenExpired
+// once the token expires. The fragment may be passed as the full redirect url
+// or the portion after the #. The fragment's state must match state.
+func (c *OAuth2Config) ImplicitCredential(fragment, state string) (*OAuth2Credential, error) {
+	if c == nil {
+		return nil, errors.New("nil configuration")
+	}
+	if idx := strings.Index(fragment, "#"); idx >= 0 {
+		fragment = fragment[idx+1:]
+	}
+	vals, err := url.ParseQuery(fragment)
+	if err != nil {
+		return nil, err
+	}
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(vals.Get("state"))) != 1 {
+		return nil, errors.New("implicit grant: invalid state")
+	}
+	if code := vals.Get("error"); code > "" {
+		return nil, &OAuth2Error{Code: code, Description: vals.Get("error_description")}
+	}
+	tk := &oauth2.Token{
+		AccessToken: vals.Get("access_token"),
+		TokenType:   vals.Get("token_type"),
+	}
+	if tk.AccessToken == "" {
+		return nil, errors.New("implicit grant: missing access_token")
+	}
+	expiresIn, err := strconv.ParseInt(vals.Get("expires_in"), 10, 64)
+	if err != nil || expiresIn <= 0 {
+		return nil, errors.New("implicit grant: invalid expires_in")
+	}
+	// expire early to avoid sending a token about to expire
+	tk.Expiry = time.Now().Add(time.Duration(expiresIn-oauth2.DefaultExpiryDelta) * time.Second)
+	return &OAuth2Credential{
+		accountID:   c.AccountID,
+		cachedToken: tk,
+		refresher:   expiredRefresher,
+		cacheFunc:   c.CacheFunc,
+		env:         c.environment(),
+		Func:        c.HTTPClientFunc,
+	}, nil
+}
+
+func expiredRefresher(context.Context, *oauth2.Token) (*oauth2.Token, error) {
+	return nil, ErrTokenExpired
+}
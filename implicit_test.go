@@ -0,0 +1,71 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/testutils"
+)
+
+func TestOAuth2Config_ImplicitGrant(t *testing.T) {
+	ctx := context.Background()
+	cfg, testTransport := getOAuth2ConfigTranspot()
+	cfg.Secret = ""
+	cfg.ExtendedLifetime = true
+	authURL := cfg.ImplicitGrantURL("STATE", "signature", "impersonation")
+	expectedURL := "https://account-d.docusign.com/oauth/auth?client_id=KEY&redirect_uri=https%3A%2F%2Fwww.example.com%2Ftoken&response_type=token&scope=signature%20impersonation&state=STATE"
+	if authURL != expectedURL {
+		t.Errorf("expected %s; got %s", expectedURL, authURL)
+	}
+
+	tests := []struct {
+		name     string
+		fragment string
+		state    string
+		err      string
+	}{
+		{name: "state mismatch", fragment: "access_token=ABC&expires_in=3600&state=OTHER", state: "STATE", err: "implicit grant: invalid state"},
+		{name: "blank state", fragment: "access_token=ABC&expires_in=3600", state: "", err: "implicit grant: invalid state"},
+		{name: "denied", fragment: "error=access_denied&state=STATE", state: "STATE", err: "oauth2 error: status 0 access_denied"},
+		{name: "no token", fragment: "expires_in=3600&state=STATE", state: "STATE", err: "implicit grant: missing access_token"},
+		{name: "no expiry", fragment: "access_token=ABC&state=STATE", state: "STATE", err: "implicit grant: invalid expires_in"},
+	}
+	for _, tt := range tests {
+		if _, err := cfg.ImplicitCredential(tt.fragment, tt.state); err == nil || err.Error() != tt.err {
+			t.Errorf("%s: expected %s; got %v", tt.name, tt.err, err)
+		}
+	}
+
+	cred, err := cfg.ImplicitCredential("https://www.example.com/token#access_token=ABC&token_type=bearer&expires_in=28800&state=STATE", "STATE")
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Auth:     "Bearer ABC",
+		Response: testutils.MakeResponse(200, []byte(userInfoSuccessResponse), nil),
+	})
+	tk, err := cred.Token(ctx)
+	if err != nil {
+		t.Fatalf("expected token; got %v", err)
+	}
+	if exp := time.Until(tk.Expiry); exp < 7*time.Hour+59*time.Minute || exp > 8*time.Hour {
+		t.Errorf("expected 8 hour expiry; got %v", exp)
+	}
+
+	expired, err := cfg.ImplicitCredential("access_token=ABC&expires_in=1&state=STATE", "STATE")
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	// expiry is reduced by oauth2.DefaultExpiryDelta
+	if _, err = expired.Token(ctx); !errors.Is(err, esign.ErrTokenExpired) {
+		t.Errorf("expected ErrTokenExpired; got %v", err)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// jwt.go performs the JWT grant token request.  jwt.Config.Token
+// flattens error responses into a string, so the request is
+// made here to return typed errors.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jfcote87/ctxclient"
+	"github.com/jfcote87/oauth2"
+	"github.com/jfcote87/oauth2/jws"
+	"github.com/jfcote87/oauth2/jwt"
+)
+
+// Values of the error_description returned with an invalid_grant error.
+// See https://developers.docusign.com/esign-rest-api/guides/authentication/oauth2-jsonwebtoken#step-3-request-a-user-access-token
+const (
+	InvalidGrantUserNotFound   = "user_not_found"
+	InvalidGrantNoValidKeys    = "no_valid_keys_or_signatures"
+	InvalidGrantIssuerNotFound = "issuer_not_found"
+)
+
+const (
+	jwtGrantType               = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	oauth2ErrorConsentRequired = "consent_required"
+	oauth2ErrorInvalidGrant    = "invalid_grant"
+)
+
+// OAuth2Error describes an error response from the token endpoint.
+// See https://tools.ietf.org/html/rfc6749#section-5.2
+type OAuth2Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+	Status      int    `json:"-"`
+	Raw         []byte `json:"-"`
+}
+
+// Error fulfills error interface
+func (e *OAuth2Error) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("oauth2 error: status %d %s", e.Status, e.Code)
+	}
+	return fmt.Sprintf("oauth2 error: status %d %s: %s", e.Status, e.Code, e.Description)
+}
+
+// ConsentRequiredError is returned by a JWT credential when the user
+// has not granted consent for impersonation.  Redirect the user to
+// UserConsentURL to obtain consent.
+type ConsentRequiredError struct {
+	// Subject is the api user name used in the JWT assertion.
+	Subject string
+	// Scopes requested in the JWT assertion.
+	Scopes []string
+	// UserConsentURL is the url for the user to grant consent.  The
+	// redirect url is set from JWTConfig.ConsentRedirectURL.
+	UserConsentURL string
+	// Err is the token endpoint's response.
+	Err *OAuth2Error
+}
+
+// Error fulfills error interface
+func (e *ConsentRequiredError) Error() string {
+	return fmt.Sprintf("consent required for %s; obtain consent at %s", e.Subject, e.UserConsentURL)
+}
+
+// Unwrap returns the token endpoint's error response
+func (e *ConsentRequiredError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidGrantError is returned by a JWT credential when DocuSign rejects
+// the JWT assertion.  Reason contains the error_description, usually one
+// of the InvalidGrant constants.
+type InvalidGrantError struct {
+	// Subject is the api user name used in the JWT assertion.
+	Subject string
+	// KeyPairID identifies the RSA key pair used to sign the assertion.
+	KeyPairID string
+	// Reason is the error_description of the response
+	Reason string
+	// Err is the token endpoint's response.
+	Err *OAuth2Error
+}
+
+// Error fulfills error interface
+func (e *InvalidGrantError) Error() string {
+	return fmt.Sprintf("invalid_grant for %s: %s", e.Subject, e.Reason)
+}
+
+// Unwrap returns the token endpoint's error response
+func (e *InvalidGrantError) Unwrap() error {
+	return e.Err
+}
+
+// jwtGrant contains the values needed to request a token
+// via a JWT assertion.
+type jwtGrant struct {
+	issuer   string
+	subject  string
+	audience string
+	tokenURL string
+	scopes   []string
+	options  *jwt.ConfigOptions
+	f        ctxclient.Func
+	// consentURL creates a user consent url for a ConsentRequiredError
+	consentURL func(scopes ...string) string
+}
+
+// claimSet creates the JWT payload
+func (g *jwtGrant) claimSet() (*jws.ClaimSet, error) {
+	privateClaims := make(map[string]interface{})
+	if g.options != nil {
+		for k, v := range g.options.PrivateClaims {
+			privateClaims[k] = v
+		}
+	}
+	if len(g.scopes) > 0 {
+		privateClaims["scope"] = strings.Join(g.scopes, " ")
+	}
+	cs := &jws.ClaimSet{
+		Issuer:        g.issuer,
+		Audience:      g.audience,
+		Subject:       g.subject,
+		PrivateClaims: privateClaims,
+	}
+	return cs, cs.SetExpirationClaims(g.iatOffset(), g.expiresIn())
+}
+
+func (g *jwtGrant) iatOffset() time.Duration {
+	if g.options == nil || g.options.IatOffset == nil {
+		return time.Duration(oauth2.DefaultExpiryDelta) * time.Second
+	}
+	return time.Duration(*g.options.IatOffset) * time.Second
+}
+
+func (g *jwtGrant) expiresIn() time.Duration {
+	if g.options == nil || g.options.ExpiresIn == nil {
+		return time.Hour
+	}
+	return time.Duration(*g.options.ExpiresIn) * time.Second
+}
+
+func (g *jwtGrant) expiryDelta() time.Duration {
+	if g.options == nil || g.options.ExpiryDelta == nil {
+		return time.Duration(oauth2.DefaultExpiryDelta) * time.Second
+	}
+	return time.Duration(*g.options.ExpiryDelta) * time.Second
+}
+
+// token signs a JWT assertion with signer and exchanges it for
+// an access token.
+func (g *jwtGrant) token(ctx context.Context, signer JWTSigner) (*oauth2.Token, error) {
+	cs, err := g.claimSet()
+	if err != nil {
+		return nil, err
+	}
+	assertionSigner := &jwsSigner{ctx: ctx, signer: signer}
+	assertion, err := cs.JWT(assertionSigner)
+	if err != nil {
+		return nil, err
+	}
+	payload := url.Values{
+		"grant_type": {jwtGrantType},
+		"assertion":  {assertion},
+	}
+	if g.options != nil {
+		for k, v := range g.options.FormValues {
+			payload[k] = v
+		}
+	}
+	res, err := g.f.PostForm(ctx, g.tokenURL, payload)
+	if err != nil {
+		return nil, g.tokenError(err, signer.KeyID())
+	}
+	defer res.Body.Close()
+	raw := make(map[string]interface{})
+	if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("jwt: unable to decode token: %v", err)
+	}
+	tk, err := oauth2.TokenFromMap(raw, g.expiryDelta())
+	if err != nil {
+		return nil, err
+	}
+	if g.options != nil && g.options.NewTokenFunc != nil {
+		err = g.options.NewTokenFunc(ctx, tk, &jwt.Config{
+			Signer:         assertionSigner,
+			Issuer:         g.issuer,
+			Subject:        g.subject,
+			TokenURL:       g.tokenURL,
+			Audience:       g.audience,
+			Scopes:         g.scopes,
+			Options:        g.options,
+			HTTPClientFunc: g.f,
+		})
+	}
+	return tk, err
+}
+
+// tokenError converts an error response from the token endpoint
+// into a *ConsentRequiredError, *InvalidGrantError or *OAuth2Error.
+func (g *jwtGrant) tokenError(err error, keyPairID string) error {
+	nsErr, ok := err.(*ctxclient.NotSuccess)
+	if !ok {
+		return err
+	}
+	oerr := &OAuth2Error{Status: nsErr.StatusCode, Raw: nsErr.Body}
+	if json.Unmarshal(nsErr.Body, oerr) != nil || oerr.Code == "" {
+		return NewResponseError(nsErr.Body, nsErr.StatusCode)
+	}
+	switch oerr.Code {
+	case oauth2ErrorConsentRequired:
+		ce := &ConsentRequiredError{
+			Subject: g.subject,
+			Scopes:  g.scopes,
+			Err:     oerr,
+		}
+		if g.consentURL != nil {
+			ce.UserConsentURL = g.consentURL(g.scopes...)
+		}
+		return ce
+	case oauth2ErrorInvalidGrant:
+		return &InvalidGrantError{
+			Subject:   g.subject,
+			KeyPairID: keyPairID,
+			Reason:    oerr.Description,
+			Err:       oerr,
+		}
+	}
+	return oerr
+}
@@ -0,0 +1,292 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/oauth2"
+)
+
+// CredentialPool provides impersonation credentials for many users of a single
+// JWTConfig.  Credentials are created on first use, share the config's parsed
+// signers and cached userinfo, and are removed after being idle.  The
+// credentials of a user's accounts share a single token.
+type CredentialPool struct {
+	cfg         *JWTConfig
+	signers     []JWTSigner
+	scopes      []string
+	idleTimeout time.Duration
+
+	mu        sync.Mutex
+	entries   map[poolKey]*poolEntry
+	users     map[string]*OAuth2Credential
+	stats     map[string]*PoolUserStats
+	lastEvict time.Time
+}
+
+type poolKey struct {
+	apiUserID string
+	accountID string
+}
+
+type poolEntry struct {
+	cred     *OAuth2Credential
+	lastUsed time.Time
+}
+
+// PoolUserStats contains metrics for a user of a CredentialPool.
+type PoolUserStats struct {
+	APIUserID string `json:"api_user_id"`
+	// Credentials is the number of credentials currently pooled for the user.
+	Credentials int `json:"credentials"`
+	// TokenRefreshes counts successful token requests.
+	TokenRefreshes int64 `json:"token_refreshes"`
+	// ConsentFailures counts token requests returning a ConsentRequiredError.
+	ConsentFailures int64 `json:"consent_failures"`
+	// InvalidGrants counts token requests returning an InvalidGrantError.
+	InvalidGrants int64 `json:"invalid_grants"`
+	// Errors counts all other failed token requests.
+	Errors int64 `json:"errors"`
+	// LastUsed is the time of the latest Get or token refresh.
+	LastUsed time.Time `json:"last_used"`
+}
+
+// CredentialPool returns a pool of impersonation credentials for the config.
+// Credentials not used for idleTimeout are removed from the pool.  An idleTimeout
+// of 0 disables eviction.  If no scopes listed, signature is assumed.
+func (c *JWTConfig) CredentialPool(idleTimeout time.Duration, scopes ...string) (*CredentialPool, error) {
+	if c == nil {
+		return nil, errors.New("nil configuration")
+	}
+	signers, err := c.signers()
+	if err != nil {
+		return nil, err
+	}
+	return &CredentialPool{
+		cfg:         c,
+		signers:     signers,
+		scopes:      scopes,
+		idleTimeout: idleTimeout,
+		entries:     make(map[poolKey]*poolEntry),
+		users:       make(map[string]*OAuth2Credential),
+		stats:       make(map[string]*PoolUserStats),
+	}, nil
+}
+
+// Get returns the pooled credential for apiUserID and accountID, creating
+// it if necessary.  A blank accountID indicates the user's default account.
+func (p *CredentialPool) Get(apiUserID, accountID string) (*OAuth2Credential, error) {
+	if p == nil {
+		return nil, errors.New("nil pool")
+	}
+	if apiUserID == "" {
+		return nil, errors.New("apiUserID may not be blank")
+	}
+	now := time.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.idleTimeout > 0 && now.Sub(p.lastEvict) > p.idleTimeout/2 {
+		p.evict(now)
+	}
+	key := poolKey{apiUserID: apiUserID, accountID: accountID}
+	st := p.userStats(apiUserID)
+	st.LastUsed = now
+	if e, ok := p.entries[key]; ok {
+		e.lastUsed = now
+		return e.cred, nil
+	}
+	if accountID == "" {
+		accountID = p.cfg.AccountID
+	}
+	cred := p.user(apiUserID).WithAccountID(accountID)
+	p.entries[key] = &poolEntry{cred: cred, lastUsed: now}
+	st.Credentials++
+	return cred, nil
+}
+
+// user returns the credential from which the user's account credentials
+// are derived.  Must be called with p.mu locked.
+func (p *CredentialPool) user(apiUserID string) *OAuth2Credential {
+	if cred, ok := p.users[apiUserID]; ok {
+		return cred
+	}
+	env := p.cfg.environment()
+	src := &poolTokenSource{
+		refresh: p.refresher(apiUserID, p.cfg.jwtRefresher(apiUserID, p.signers, p.scopes...)),
+		fetchUserInfo: func(ctx context.Context, tk *oauth2.Token) (*UserInfo, error) {
+			return env.getUserInfoForToken(ctx, p.cfg.HTTPClientFunc, tk)
+		},
+	}
+	cred := &OAuth2Credential{
+		refresher:   src.token,
+		cacheFunc:   p.cfg.CacheFunc,
+		getUserInfo: src.userInfo,
+		env:         env,
+		Func:        p.cfg.HTTPClientFunc,
+	}
+	p.users[apiUserID] = cred
+	return cred
+}
+
+// poolTokenSource shares a user's token and userinfo between the
+// credentials of the user's accounts so that only one JWT grant is made per
+// token lifetime and userinfo is requested once.
+type poolTokenSource struct {
+	mu            sync.Mutex
+	tk            *oauth2.Token
+	u             *UserInfo
+	refresh       func(context.Context, *oauth2.Token) (*oauth2.Token, error)
+	fetchUserInfo func(context.Context, *oauth2.Token) (*UserInfo, error)
+}
+
+func (s *poolTokenSource) token(ctx context.Context, _ *oauth2.Token) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tk.Valid() {
+		return s.tk, nil
+	}
+	tk, err := s.refresh(ctx, s.tk)
+	if err != nil {
+		return nil, err
+	}
+	s.tk = tk
+	return tk, nil
+}
+
+// userInfo returns the user's userinfo, requesting it with tk on first use.
+func (s *poolTokenSource) userInfo(ctx context.Context, tk *oauth2.Token) (*UserInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.u == nil {
+		u, err := s.fetchUserInfo(ctx, tk)
+		if err != nil {
+			return nil, err
+		}
+		s.u = u
+	}
+	return s.u, nil
+}
+
+// userStats returns the stats for apiUserID.  Must be called
+// with p.mu locked.
+func (p *CredentialPool) userStats(apiUserID string) *PoolUserStats {
+	st, ok := p.stats[apiUserID]
+	if !ok {
+		st = &PoolUserStats{APIUserID: apiUserID}
+		p.stats[apiUserID] = st
+	}
+	return st
+}
+
+// refresher wraps the jwt refresher to record metrics.
+func (p *CredentialPool) refresher(apiUserID string, f func(context.Context, *oauth2.Token) (*oauth2.Token, error)) func(context.Context, *oauth2.Token) (*oauth2.Token, error) {
+	return func(ctx context.Context, tk *oauth2.Token) (*oauth2.Token, error) {
+		newTk, err := f(ctx, tk)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		st, ok := p.stats[apiUserID]
+		if !ok { // user removed from pool
+			return newTk, err
+		}
+		st.LastUsed = time.Now()
+		switch err.(type) {
+		case nil:
+			st.TokenRefreshes++
+		case *ConsentRequiredError:
+			st.ConsentFailures++
+		case *InvalidGrantError:
+			st.InvalidGrants++
+		default:
+			st.Errors++
+		}
+		return newTk, err
+	}
+}
+
+// EvictIdle removes credentials that have not been used within the
+// pool's idle timeout and returns the number removed.
+func (p *CredentialPool) EvictIdle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.evict(time.Now())
+}
+
+// evict must be called with p.mu locked
+func (p *CredentialPool) evict(now time.Time) int {
+	p.lastEvict = now
+	if p.idleTimeout <= 0 {
+		return 0
+	}
+	var cnt int
+	for k, e := range p.entries {
+		if now.Sub(e.lastUsed) > p.idleTimeout {
+			delete(p.entries, k)
+			cnt++
+			st := p.userStats(k.apiUserID)
+			if st.Credentials--; st.Credentials == 0 {
+				p.removeUser(k.apiUserID)
+			}
+		}
+	}
+	return cnt
+}
+
+// Remove deletes all pooled credentials, cached userinfo and metrics for
+// apiUserID.
+func (p *CredentialPool) Remove(apiUserID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for k := range p.entries {
+		if k.apiUserID == apiUserID {
+			delete(p.entries, k)
+		}
+	}
+	p.removeUser(apiUserID)
+}
+
+// removeUser deletes the user's token source, userinfo and metrics.  Must
+// be called with p.mu locked.
+func (p *CredentialPool) removeUser(apiUserID string) {
+	delete(p.users, apiUserID)
+	delete(p.stats, apiUserID)
+}
+
+// Len returns the number of pooled credentials.
+func (p *CredentialPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.entries)
+}
+
+// UserStats returns the metrics for apiUserID.
+func (p *CredentialPool) UserStats(apiUserID string) PoolUserStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if st, ok := p.stats[apiUserID]; ok {
+		return *st
+	}
+	return PoolUserStats{APIUserID: apiUserID}
+}
+
+// Stats returns the metrics for every user of the pool sorted by
+// APIUserID.
+func (p *CredentialPool) Stats() []PoolUserStats {
+	p.mu.Lock()
+	results := make([]PoolUserStats, 0, len(p.stats))
+	for _, st := range p.stats {
+		results = append(results, *st)
+	}
+	p.mu.Unlock()
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].APIUserID < results[j].APIUserID
+	})
+	return results
+}
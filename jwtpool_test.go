@@ -0,0 +1,138 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/testutils"
+)
+
+func TestCredentialPool(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	clx := &http.Client{Transport: testTransport}
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		PrivateKey:    testPK,
+		KeyPairID:     "KEYPAIR",
+		IsDemo:        true,
+		HTTPClientFunc: func(ctx context.Context) (*http.Client, error) {
+			return clx, nil
+		},
+	}
+	pool, err := cfg.CredentialPool(time.Hour)
+	if err != nil {
+		t.Fatalf("expected pool; got %v", err)
+	}
+	if _, err = pool.Get("", ""); err == nil {
+		t.Errorf("expected blank apiUserID error; got success")
+	}
+	cred, err := pool.Get("USER1", "")
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	if cred2, _ := pool.Get("USER1", ""); cred2 != cred {
+		t.Errorf("expected same credential from pool")
+	}
+
+	tokenResponse := &testutils.RequestTester{
+		Path:     "/oauth/token",
+		Response: testutils.MakeResponse(200, []byte(tokenSuccessResponse), nil),
+	}
+	testTransport.Add(tokenResponse, &testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfoSuccessResponse), nil),
+	})
+	if _, err = cred.Token(ctx); err != nil {
+		t.Fatalf("expected token; got %v", err)
+	}
+
+	// second account should use the user's token and cached userinfo
+	// (no token or userinfo call)
+	acct2, _ := pool.Get("USER1", "abcd61a3-3b9b-cafe-b7be-4592af32aa9b")
+	testTransport.Add(&testutils.RequestTester{
+		Host: "gotest.docusign.net",
+		Path: "/restapi/v2.1/accounts/abcd61a3-3b9b-cafe-b7be-4592af32aa9b/abc",
+	})
+	req, _ := http.NewRequest("GET", "abc", nil)
+	res, err := acct2.AuthDo(ctx, req, esign.VersionV21)
+	if err != nil {
+		t.Fatalf("expected account 2 request; got %v", err)
+	}
+	res.Body.Close()
+
+	// consent failure for user 2
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/token",
+		Response: testutils.MakeResponse(400, []byte(`{"error":"consent_required"}`), nil),
+	})
+	cred3, _ := pool.Get("USER2", "")
+	if _, err = cred3.Token(ctx); err == nil {
+		t.Errorf("expected consent required; got success")
+	}
+
+	st := pool.UserStats("USER1")
+	if st.TokenRefreshes != 1 || st.Credentials != 2 || st.ConsentFailures != 0 {
+		t.Errorf("expected USER1 1 refresh, 2 credentials; got %#v", st)
+	}
+	st = pool.UserStats("USER2")
+	if st.ConsentFailures != 1 || st.TokenRefreshes != 0 {
+		t.Errorf("expected USER2 1 consent failure; got %#v", st)
+	}
+	if stats := pool.Stats(); len(stats) != 2 || stats[0].APIUserID != "USER1" {
+		t.Errorf("expected stats for USER1 and USER2; got %#v", stats)
+	}
+
+	if pool.Len() != 3 {
+		t.Errorf("expected 3 pooled credentials; got %d", pool.Len())
+	}
+	pool.Remove("USER2")
+	if pool.Len() != 2 {
+		t.Errorf("expected 2 pooled credentials after remove; got %d", pool.Len())
+	}
+	if stats := pool.Stats(); len(stats) != 1 || stats[0].APIUserID != "USER1" {
+		t.Errorf("expected USER2 stats removed; got %#v", stats)
+	}
+
+	// idle eviction
+	shortPool, _ := cfg.CredentialPool(time.Millisecond)
+	shortPool.Get("USER1", "")
+	time.Sleep(5 * time.Millisecond)
+	if cnt := shortPool.EvictIdle(); cnt != 1 || shortPool.Len() != 0 {
+		t.Errorf("expected 1 evicted credential; got %d, len = %d", cnt, shortPool.Len())
+	}
+	if stats := shortPool.Stats(); len(stats) != 0 {
+		t.Errorf("expected evicted user stats removed; got %#v", stats)
+	}
+
+	// credentials created before the user's token share one token and
+	// userinfo call
+	newPool, _ := cfg.CredentialPool(time.Hour)
+	c1, _ := newPool.Get("USER3", "")
+	c2, _ := newPool.Get("USER3", "abcd61a3-3b9b-cafe-b7be-4592af32aa9b")
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/token",
+		Response: testutils.MakeResponse(200, []byte(tokenSuccessResponse), nil),
+	}, &testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfoSuccessResponse), nil),
+	})
+	for _, c := range []*esign.OAuth2Credential{c1, c2} {
+		if _, err = c.Token(ctx); err != nil {
+			t.Fatalf("expected shared token; got %v", err)
+		}
+	}
+	if u1, u2 := c1.State(), c2.State(); u1.AccountID == u2.AccountID || u2.AccountID != "abcd61a3-3b9b-cafe-b7be-4592af32aa9b" {
+		t.Errorf("expected default and second account; got %s %s", u1.AccountID, u2.AccountID)
+	}
+	if len(testTransport.Queue) != 0 {
+		t.Errorf("expected all requests sent; %d remain", len(testTransport.Queue))
+	}
+}
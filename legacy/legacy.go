@@ -81,7 +81,9 @@ func (o OauthCredential) AuthDo(ctx context.Context, req *http.Request, v *esign
 	r2.Header = h
 	res, err := o.Func.Do(ctx, &r2)
 	if nsErr, ok := err.(*ctxclient.NotSuccess); ok {
-		return nil, esign.NewResponseError(nsErr.Body, nsErr.StatusCode)
+		re := esign.NewResponseError(nsErr.Body, nsErr.StatusCode)
+		re.Header = nsErr.Header
+		return nil, re
 	}
 	return res, err
 }
@@ -200,7 +202,9 @@ func (c Config) AuthDo(ctx context.Context, req *http.Request, v *esign.APIVersi
 	r2.Header = h
 	res, err := c.Func.Do(ctx, &r2)
 	if nsErr, ok := err.(*ctxclient.NotSuccess); ok {
-		return nil, esign.NewResponseError(nsErr.Body, nsErr.StatusCode)
+		re := esign.NewResponseError(nsErr.Body, nsErr.StatusCode)
+		re.Header = nsErr.Header
+		return nil, re
 	}
 	return res, err
 }
@@ -0,0 +1,222 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package legacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2/authentication"
+	"github.com/jfcote87/esign/v2/model"
+)
+
+// MigrationPlan contains the user and account information needed to
+// replace a legacy credential with an esign.JWTConfig impersonation
+// credential.  Create with NewMigrationPlan.
+type MigrationPlan struct {
+	// UserID is the api user id used as the subject of JWT assertions.
+	UserID   string `json:"user_id,omitempty"`
+	UserName string `json:"user_name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	// Account is the account being migrated.
+	Account model.LoginAccount `json:"account"`
+	// Accounts lists every account available to the legacy credential.
+	Accounts []model.LoginAccount `json:"accounts,omitempty"`
+	// IsDemo reports whether the legacy credential uses the demo host.
+	IsDemo bool `json:"is_demo,omitempty"`
+}
+
+// NewMigrationPlan uses a working legacy credential (Config or OauthCredential)
+// to discover the user id and accounts via the v2 login_information call.
+// accountID may be either the account's id or guid.  If blank, the user's
+// default account is used.
+func NewMigrationPlan(ctx context.Context, cred esign.Credential, accountID string) (*MigrationPlan, error) {
+	info, err := authentication.New(cred).Login().IncludeAccountIDGUID().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range info.LoginAccounts {
+		if (accountID == "" && bool(a.IsDefault)) || (accountID != "" && (accountID == a.AccountID || accountID == a.AccountIDGUID)) {
+			return &MigrationPlan{
+				UserID:   a.UserID,
+				UserName: a.UserName,
+				Email:    a.Email,
+				Account:  a,
+				Accounts: info.LoginAccounts,
+				IsDemo:   isDemoCredential(cred, a),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no login account %s found", accountID)
+}
+
+// ConsentURL returns the url the user must visit to grant cfg's
+// integrator key consent for impersonation.
+func (p *MigrationPlan) ConsentURL(cfg *esign.JWTConfig, redirectURL string, scopes ...string) string {
+	return cfg.UserConsentURL(redirectURL, scopes...)
+}
+
+// MigrationReport lists the differences between the accounts available
+// to the legacy credential and those available to the new credential.
+type MigrationReport struct {
+	UserID      string                `json:"user_id,omitempty"`
+	Differences []MigrationDifference `json:"differences,omitempty"`
+}
+
+// MigrationDifference values for Issue
+const (
+	MigrationMissingAccount = "missing_account" // account not available to oauth2 credential
+	MigrationNewAccount     = "new_account"     // account available only to oauth2 credential
+	MigrationBaseURL        = "base_url"        // account's host differs
+)
+
+// MigrationDifference describes an account whose access or base url
+// differs between the legacy and oauth2 credentials.
+type MigrationDifference struct {
+	AccountID     string `json:"account_id,omitempty"`
+	AccountName   string `json:"account_name,omitempty"`
+	Issue         string `json:"issue,omitempty"`
+	LegacyBaseURL string `json:"legacy_base_url,omitempty"`
+	OAuth2BaseURI string `json:"oauth2_base_uri,omitempty"`
+}
+
+// Credential creates a JWT impersonation credential for the plan's user and
+// account and verifies it by retrieving the user's userinfo. If consent has not
+// been granted, an *esign.ConsentRequiredError is returned.  The report lists
+// any differences in account access or base url between the two credentials.
+// An error is returned if cfg's environment is DocuSign's demo environment and
+// the legacy credential's is not, or vice versa.
+func (p *MigrationPlan) Credential(ctx context.Context, cfg *esign.JWTConfig, scopes ...string) (*esign.OAuth2Credential, *MigrationReport, error) {
+	if p == nil || p.UserID == "" {
+		return nil, nil, errors.New("migration plan has no user id")
+	}
+	if err := p.checkEnvironment(cfg); err != nil {
+		return nil, nil, err
+	}
+	cred, err := cfg.Credential(p.UserID, nil, nil, scopes...)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, err := cred.UserInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	report := p.compare(u)
+	cred = cred.WithAccountID(accountGUID(p.Account))
+	if _, err = cred.Token(ctx); err != nil {
+		return nil, report, err
+	}
+	return cred, report, nil
+}
+
+// compare lists differences between the legacy login accounts and
+// the accounts returned by the userinfo call.
+func (p *MigrationPlan) compare(u *esign.UserInfo) *MigrationReport {
+	report := &MigrationReport{UserID: p.UserID}
+	found := make(map[string]bool)
+	for _, la := range p.Accounts {
+		id := accountGUID(la)
+		var ua *esign.UserInfoAccount
+		for i := range u.Accounts {
+			if u.Accounts[i].AccountID == id {
+				ua = &u.Accounts[i]
+				break
+			}
+		}
+		if ua == nil {
+			report.Differences = append(report.Differences, MigrationDifference{
+				AccountID:     id,
+				AccountName:   la.Name,
+				Issue:         MigrationMissingAccount,
+				LegacyBaseURL: la.BaseURL,
+			})
+			continue
+		}
+		found[id] = true
+		if hostOf(la.BaseURL) != hostOf(ua.BaseURI) {
+			report.Differences = append(report.Differences, MigrationDifference{
+				AccountID:     id,
+				AccountName:   la.Name,
+				Issue:         MigrationBaseURL,
+				LegacyBaseURL: la.BaseURL,
+				OAuth2BaseURI: ua.BaseURI,
+			})
+		}
+	}
+	for _, ua := range u.Accounts {
+		if !found[ua.AccountID] {
+			report.Differences = append(report.Differences, MigrationDifference{
+				AccountID:     ua.AccountID,
+				AccountName:   ua.AccountName,
+				Issue:         MigrationNewAccount,
+				OAuth2BaseURI: ua.BaseURI,
+			})
+		}
+	}
+	return report
+}
+
+// checkEnvironment verifies that the legacy credential and cfg use the
+// same DocuSign environment.  Custom environments are not checked.
+func (p *MigrationPlan) checkEnvironment(cfg *esign.JWTConfig) error {
+	if cfg == nil {
+		return errors.New("nil configuration")
+	}
+	isDemo := cfg.IsDemo
+	if cfg.Environment != nil {
+		switch cfg.Environment.AuthHost {
+		case esign.DemoEnvironment().AuthHost:
+			isDemo = true
+		case esign.ProductionEnvironment().AuthHost:
+			isDemo = false
+		default:
+			return nil
+		}
+	}
+	if isDemo != p.IsDemo {
+		return fmt.Errorf("legacy credential demo setting (%v) does not match oauth2 config (%v)", p.IsDemo, isDemo)
+	}
+	return nil
+}
+
+// isDemoCredential reports whether the legacy credential uses the demo
+// host.  For other credential types, the account's base url is checked.
+func isDemoCredential(cred esign.Credential, a model.LoginAccount) bool {
+	var host string
+	switch c := cred.(type) {
+	case Config:
+		host = getHost(c.IsDemoAccount, c.Host)
+	case *Config:
+		host = getHost(c.IsDemoAccount, c.Host)
+	case OauthCredential:
+		host = getHost(c.IsDemoAccount, c.Host)
+	case *OauthCredential:
+		host = getHost(c.IsDemoAccount, c.Host)
+	default:
+		host = hostOf(a.BaseURL)
+	}
+	return host == demoHost
+}
+
+// accountGUID returns the guid of the login account which matches the
+// userinfo account id.  Older responses may not include the guid.
+func accountGUID(a model.LoginAccount) string {
+	if a.AccountIDGUID > "" {
+		return a.AccountIDGUID
+	}
+	return a.AccountID
+}
+
+func hostOf(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	return u.Host
+}
@@ -0,0 +1,165 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package legacy_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/legacy"
+	"github.com/jfcote87/testutils"
+)
+
+const loginInformation = `{"loginAccounts": [
+	{"accountId": "1001", "accountIdGuid": "ACCT1", "name": "Main", "isDefault": "true", "userId": "USER1", "userName": "Sam User", "email": "sam@example.com", "baseUrl": "https://na2.docusign.net/restapi/v2/accounts/1001"},
+	{"accountId": "1002", "accountIdGuid": "ACCT2", "name": "Second", "isDefault": "false", "userId": "USER1", "userName": "Sam User", "email": "sam@example.com", "baseUrl": "https://na3.docusign.net/restapi/v2/accounts/1002"}]}`
+
+const tokenResponse = `{"access_token": "TOKEN", "token_type": "Bearer", "expires_in": 3600}`
+
+func testPrivateKey(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func TestMigrationPlan(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	clientFunc := func(ctx context.Context) (*http.Client, error) {
+		return &http.Client{Transport: testTransport}, nil
+	}
+	legacyCfg := legacy.Config{
+		IntegratorKey: "KEY",
+		UserName:      "sam@example.com",
+		Password:      "PASSWORD",
+		Host:          "na2.docusign.net",
+		Func:          clientFunc,
+	}
+	jwtCfg := &esign.JWTConfig{
+		IntegratorKey:  "KEY",
+		KeyPairID:      "KEYPAIR",
+		PrivateKey:     testPrivateKey(t),
+		HTTPClientFunc: clientFunc,
+	}
+	loginTester := func(status int, body string) *testutils.RequestTester {
+		return &testutils.RequestTester{
+			Host:     "na2.docusign.net",
+			Path:     "/restapi/v2/login_information",
+			Query:    "include_account_id_guid=true",
+			Response: testutils.MakeResponse(status, []byte(body), nil),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		accountID string
+		login     *testutils.RequestTester
+		isDemo    bool
+		userInfo  string
+		planErr   bool
+		credErr   bool
+		issues    []string
+	}{
+		{
+			name:     "matching accounts",
+			login:    loginTester(200, loginInformation),
+			userInfo: `{"sub": "USER1", "accounts": [{"account_id": "ACCT1", "is_default": true, "account_name": "Main", "base_uri": "https://na2.docusign.net"}, {"account_id": "ACCT2", "account_name": "Second", "base_uri": "https://na3.docusign.net"}]}`,
+		},
+		{
+			name:      "base uri differs",
+			accountID: "1002",
+			login:     loginTester(200, loginInformation),
+			userInfo:  `{"sub": "USER1", "accounts": [{"account_id": "ACCT1", "is_default": true, "account_name": "Main", "base_uri": "https://na2.docusign.net"}, {"account_id": "ACCT2", "account_name": "Second", "base_uri": "https://na4.docusign.net"}]}`,
+			issues:    []string{legacy.MigrationBaseURL},
+		},
+		{
+			name:     "account missing from oauth2",
+			login:    loginTester(200, loginInformation),
+			userInfo: `{"sub": "USER1", "accounts": [{"account_id": "ACCT1", "is_default": true, "account_name": "Main", "base_uri": "https://na2.docusign.net"}]}`,
+			issues:   []string{legacy.MigrationMissingAccount},
+		},
+		{
+			name:     "account missing from legacy",
+			login:    loginTester(200, loginInformation),
+			userInfo: `{"sub": "USER1", "accounts": [{"account_id": "ACCT1", "is_default": true, "account_name": "Main", "base_uri": "https://na2.docusign.net"}, {"account_id": "ACCT2", "account_name": "Second", "base_uri": "https://na3.docusign.net"}, {"account_id": "ACCT3", "account_name": "Third", "base_uri": "https://na3.docusign.net"}]}`,
+			issues:   []string{legacy.MigrationNewAccount},
+		},
+		{
+			name:      "unknown legacy account",
+			accountID: "9999",
+			login:     loginTester(200, loginInformation),
+			planErr:   true,
+		},
+		{
+			name:    "legacy login fails",
+			login:   loginTester(401, `{"errorCode": "USER_AUTHENTICATION_FAILED", "message": "One or both of Username and Password are invalid."}`),
+			planErr: true,
+		},
+		{
+			name:    "demo legacy host with production oauth2",
+			login:   &testutils.RequestTester{Host: "demo.docusign.net", Response: testutils.MakeResponse(200, []byte(loginInformation), nil)},
+			isDemo:  true,
+			credErr: true,
+		},
+	}
+	for _, tt := range tests {
+		testTransport.Queue = nil
+		cfg := legacyCfg
+		if tt.isDemo {
+			cfg.Host = "demo.docusign.net"
+		}
+		testTransport.Add(tt.login)
+		plan, err := legacy.NewMigrationPlan(ctx, cfg, tt.accountID)
+		if tt.planErr {
+			if err == nil {
+				t.Errorf("%s: expected plan error; got success", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: expected plan; got %v", tt.name, err)
+			continue
+		}
+		if plan.UserID != "USER1" || plan.IsDemo != tt.isDemo || len(plan.Accounts) != 2 {
+			t.Errorf("%s: unexpected plan %#v", tt.name, plan)
+		}
+		if tt.userInfo > "" {
+			testTransport.Add(&testutils.RequestTester{
+				Path:     "/oauth/token",
+				Response: testutils.MakeResponse(200, []byte(tokenResponse), nil),
+			}, &testutils.RequestTester{
+				Path:     "/oauth/userinfo",
+				Response: testutils.MakeResponse(200, []byte(tt.userInfo), nil),
+			})
+		}
+		_, report, err := plan.Credential(ctx, jwtCfg)
+		if tt.credErr {
+			if err == nil {
+				t.Errorf("%s: expected credential error; got success", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: expected credential; got %v", tt.name, err)
+			continue
+		}
+		var issues []string
+		for _, d := range report.Differences {
+			issues = append(issues, d.Issue)
+		}
+		if !reflect.DeepEqual(issues, tt.issues) {
+			t.Errorf("%s: expected issues %v; got %v", tt.name, tt.issues, issues)
+		}
+	}
+}
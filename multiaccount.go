@@ -0,0 +1,69 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"sync"
+)
+
+// AccountCredentials returns a credential for each of the user's accounts
+// in the order listed in UserInfo.Accounts.  Each credential's account id
+// and base uri are resolved, so ops may be sent without further userinfo
+// calls.
+func (cred *OAuth2Credential) AccountCredentials(ctx context.Context) ([]*OAuth2Credential, error) {
+	u, err := cred.UserInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	creds := make([]*OAuth2Credential, 0, len(u.Accounts))
+	for _, a := range u.Accounts {
+		c := cred.clone()
+		if c.accountID, c.baseURI, err = c.env.accountBaseURI(u, a.AccountID); err != nil {
+			return nil, err
+		}
+		creds = append(creds, c)
+	}
+	return creds, nil
+}
+
+// AccountResult contains the result of a FanOut call for a single account.
+type AccountResult struct {
+	Account UserInfoAccount
+	Result  interface{}
+	Err     error
+}
+
+// FanOut concurrently calls f with a credential for each of the user's
+// accounts.  Results are returned in the order of UserInfo.Accounts and
+// each contains the account and f's return values.  An error is returned
+// only if the user's accounts cannot be determined.
+//
+//	results, err := cred.FanOut(ctx, func(ctx context.Context, acct *esign.OAuth2Credential) (interface{}, error) {
+//		return envelopes.New(acct).ListStatusChanges().FromDate(tm).Status("sent").Do(ctx)
+//	})
+func (cred *OAuth2Credential) FanOut(ctx context.Context, f func(context.Context, *OAuth2Credential) (interface{}, error)) ([]AccountResult, error) {
+	u, err := cred.UserInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := cred.AccountCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]AccountResult, len(creds))
+	var wg sync.WaitGroup
+	for i := range creds {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i].Account = u.Accounts[i]
+			results[i].Result, results[i].Err = f(ctx, creds[i])
+		}(i)
+	}
+	wg.Wait()
+	return results, nil
+}
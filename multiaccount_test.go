@@ -0,0 +1,87 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/testutils"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func fanOutResponse(r *http.Request) (*http.Response, error) {
+	if r.URL.Host != "override.docusign.net" {
+		return testutils.MakeResponse(400, []byte(`{"errorCode":"HOST","message":"`+r.URL.Host+`"}`), nil), nil
+	}
+	if r.URL.Path == "/restapi/v2.1/accounts/abcd61a3-3b9b-cafe-b7be-4592af32aa9b/envelopes" {
+		return testutils.MakeResponse(400, []byte(`{"errorCode":"ACCOUNT_LACKS_PERMISSIONS","message":"denied"}`), nil), nil
+	}
+	return testutils.MakeResponse(200, []byte(`{"resultSetSize":"1"}`), nil), nil
+}
+
+func TestOAuth2Credential_FanOut(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	env := &esign.Environment{
+		AuthHost: "account-d.docusign.com",
+		BaseURI:  "https://override.docusign.net",
+	}
+	cred := esign.TokenCredentialWithEnvironment("ABCDEF", env).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfoSuccessResponse), nil),
+	})
+	creds, err := cred.AccountCredentials(ctx)
+	if err != nil {
+		t.Fatalf("expected account credentials; got %v", err)
+	}
+	if len(creds) != 2 {
+		t.Fatalf("expected 2 credentials; got %d", len(creds))
+	}
+
+	// testutils.Transport is not safe for concurrent requests, so respond
+	// based upon the request's url
+	cred.SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+		return &http.Client{Transport: roundTripFunc(fanOutResponse)}, nil
+	})
+	results, err := cred.FanOut(ctx, func(ctx context.Context, acct *esign.OAuth2Credential) (interface{}, error) {
+		var res map[string]interface{}
+		err := (&esign.Op{
+			Credential: acct,
+			Method:     "GET",
+			Path:       "envelopes",
+			Version:    esign.VersionV21,
+		}).Do(ctx, &res)
+		return res, err
+	})
+	if err != nil {
+		t.Fatalf("expected results; got %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results; got %d", len(results))
+	}
+	if results[0].Account.AccountID != "fe0b61a3-3b9b-cafe-b7be-4592af32aa9b" || results[0].Err != nil {
+		t.Errorf("expected success for default account; got %s %v", results[0].Account.AccountID, results[0].Err)
+	}
+	if res, ok := results[0].Result.(map[string]interface{}); !ok || res["resultSetSize"] != "1" {
+		t.Errorf("expected resultSetSize 1; got %#v", results[0].Result)
+	}
+	var respErr *esign.ResponseError
+	if results[1].Account.AccountName != "Account2" || !errors.As(results[1].Err, &respErr) || respErr.ErrorCode != "ACCOUNT_LACKS_PERMISSIONS" {
+		t.Errorf("expected ACCOUNT_LACKS_PERMISSIONS for Account2; got %s %v", results[1].Account.AccountName, results[1].Err)
+	}
+}
@@ -21,38 +21,9 @@ import (
 
 	"github.com/jfcote87/ctxclient"
 	"github.com/jfcote87/oauth2"
-	"github.com/jfcote87/oauth2/jws"
 	"github.com/jfcote87/oauth2/jwt"
 )
 
-type demoFlag bool
-
-func (df demoFlag) endpoint() oauth2.Endpoint {
-	// endpoints
-	return oauth2.Endpoint{
-		AuthURL:  "https://" + df.tokenURI() + "/oauth/auth",
-		TokenURL: "https://" + df.tokenURI() + "/oauth/token",
-	}
-}
-
-func (df demoFlag) tokenURI() string {
-	if df {
-		return "account-d.docusign.com"
-	}
-	return "account.docusign.com"
-}
-
-func (df demoFlag) getUserInfoForToken(ctx context.Context, f ctxclient.Func, tk *oauth2.Token) (*UserInfo, error) {
-	// needed to use token credential due to different host and path parameters for op
-	var u *UserInfo
-	err := (&Op{
-		Credential: &tokenCredential{tk, f},
-		Method:     "GET",
-		Path:       "https://" + df.tokenURI() + "/oauth/userinfo",
-	}).Do(ctx, &u)
-	return u, err
-}
-
 // OAuth2Config allows for 3-legged oauth via a code grant mechanism
 // see https://developers.docusign.com/esign-rest-api/guides/authentication/oauth2-code-grant
 type OAuth2Config struct {
@@ -60,7 +31,7 @@ type OAuth2Config struct {
 	// https://developers.docusign.com/esign-rest-api/guides
 	IntegratorKey string `json:"integrator_key,omitempty"`
 	// Secret generated when setting up integration in DocuSign. Leave blank for
-	// implicit grant.
+	// implicit grant (see ImplicitGrantURL).
 	Secret string `json:"secret,omitempty"`
 	// The redirect URI must exactly match one of those pre-registered for the
 	// integrator key. This determines where to redirect the user after they
@@ -83,6 +54,9 @@ type OAuth2Config struct {
 	ExtendedLifetime bool `json:"extended_lifetime,omitempty"`
 	// Use developer sandbox
 	IsDemo bool `json:"is_demo,omitempty"`
+	// Environment determines the account server used for authorization.
+	// If nil, IsDemo selects DemoEnvironment() or ProductionEnvironment().
+	Environment *Environment `json:"environment,omitempty"`
 	// determines client used for oauth2 token calls.  If
 	// nil, ctxclient.Default will be used.
 	HTTPClientFunc ctxclient.Func `json:"-"`
@@ -96,11 +70,15 @@ func (c *OAuth2Config) codeGrantConfig(scopes ...string) *oauth2.Config {
 		ClientID:       c.IntegratorKey,
 		ClientSecret:   c.Secret,
 		Scopes:         scopes,
-		Endpoint:       demoFlag(c.IsDemo).endpoint(),
+		Endpoint:       c.environment().endpoint(),
 		HTTPClientFunc: c.HTTPClientFunc,
 	}
 }
 
+func (c *OAuth2Config) environment() *Environment {
+	return resolveEnvironment(c.Environment, c.IsDemo)
+}
+
 func addUnique(scopes []string, scope string) []string {
 	for _, val := range scopes {
 		if val == scope {
@@ -125,8 +103,13 @@ func (c *OAuth2Config) AuthURL(state string, scopes ...string) string {
 	if c.ExtendedLifetime {
 		scopes = addUnique(scopes, "extended")
 	}
+	return c.authURL(state, scopes)
+}
+
+// authURL creates the authorization url adding opts to the
+// config's prompt and ui_locales parameters.
+func (c *OAuth2Config) authURL(state string, scopes []string, opts ...oauth2.AuthCodeOption) string {
 	cfg := c.codeGrantConfig(scopes...)
-	opts := make([]oauth2.AuthCodeOption, 0)
 	if c.Prompt {
 		opts = append(opts, oauth2.SetAuthURLParam("prompt", "login"))
 	}
@@ -152,7 +135,7 @@ func (c *OAuth2Config) Exchange(ctx context.Context, code string) (*OAuth2Creden
 	if err != nil {
 		return nil, err
 	}
-	u, err := demoFlag(c.IsDemo).getUserInfoForToken(ctx, cfg.HTTPClientFunc, tk)
+	u, err := c.environment().getUserInfoForToken(ctx, cfg.HTTPClientFunc, tk)
 	if err != nil {
 		return nil, err
 	}
@@ -190,18 +173,19 @@ func (c *OAuth2Config) Credential(tk *oauth2.Token, u *UserInfo) (*OAuth2Credent
 	var accountID = c.AccountID
 	var baseURI *url.URL
 	var err error
+	env := c.environment()
 	if tokenIsValid && u != nil {
-		if accountID, baseURI, err = u.getAccountID(accountID); err != nil {
+		if accountID, baseURI, err = env.accountBaseURI(u, accountID); err != nil {
 			return nil, err
 		}
 	}
 	return &OAuth2Credential{
-		accountID:   c.AccountID,
+		accountID:   accountID,
 		baseURI:     baseURI,
 		cachedToken: tk,
 		refresher:   c.refresher(),
 		cacheFunc:   c.CacheFunc,
-		isDemo:      demoFlag(c.IsDemo),
+		env:         env,
 		userInfo:    u,
 		Func:        c.HTTPClientFunc,
 	}, nil
@@ -216,14 +200,34 @@ type JWTConfig struct {
 	IntegratorKey string `json:"integrator_key,omitempty"`
 	// Use developer sandbox
 	IsDemo bool `json:"is_demo,omitempty"`
+	// Environment determines the account server used for authorization.
+	// If nil, IsDemo selects DemoEnvironment() or ProductionEnvironment().
+	Environment *Environment `json:"environment,omitempty"`
 	// PEM encoding of an RSA Private Key.
 	// see https://developers.docusign.com/esign-rest-api/guides/authentication/oauth2-jsonwebtoken#prerequisites
 	// for how to create RSA keys to the application.
 	PrivateKey string `json:"private_key,omitempty"`
 	KeyPairID  string `json:"key_pair_id,omitempty"`
+	// Signer, if not nil, signs JWT assertions in place of PrivateKey
+	// and KeyPairID.  Use a Signer to keep the private key in an HSM or
+	// external key service.
+	Signer JWTSigner `json:"-"`
+	// KeyPairs lists additional RSA key pairs registered for the integrator
+	// key, allowing for key rotation.  Assertions are signed with the primary
+	// key (Signer or PrivateKey) if set, then with each KeyPairs entry in order
+	// until DocuSign no longer rejects the signature as invalid_grant
+	// no_valid_keys_or_signatures.
+	KeyPairs []JWTKeyPair `json:"key_pairs,omitempty"`
+	// KeyPairFunc, if not nil, is called after a token is obtained passing the
+	// key pair id of the successful key and the ids of keys that DocuSign
+	// rejected.  Use to determine when an old key may be safely retired.
+	KeyPairFunc func(ctx context.Context, keyPairID string, rejected []string) `json:"-"`
 	// DocuSign users may have more than one account.  If AccountID is
 	// not set then the user's default account will be used.
 	AccountID string `json:"account_id,omitempty"`
+	// ConsentRedirectURL is the redirect url used to create the UserConsentURL
+	// of a ConsentRequiredError.
+	ConsentRedirectURL string `json:"consent_redirect_url,omitempty"`
 	// Options can specify how long the token will be valid. DocuSign
 	// limits this to 1 hour.  1 hour is assumed if left empty.  Offsets
 	// for expiring token may also be used.  Do not set FormValues or Custom Claims.
@@ -236,6 +240,10 @@ type JWTConfig struct {
 	HTTPClientFunc ctxclient.Func `json:"-"`
 }
 
+func (c *JWTConfig) environment() *Environment {
+	return resolveEnvironment(c.Environment, c.IsDemo)
+}
+
 // UserConsentURL creates a url allowing a user to consent to impersonation
 // https://developers.docusign.com/esign-rest-api/guides/authentication/obtaining-consent#individual-consent
 func (c *JWTConfig) UserConsentURL(redirectURL string, scopes ...string) string {
@@ -244,7 +252,7 @@ func (c *JWTConfig) UserConsentURL(redirectURL string, scopes ...string) string
 		scopeValue = strings.Join(addUnique(scopes, "impersonation"), " ")
 	}
 	// docusign insists upon %20 not + in scope definition
-	return demoFlag(c.IsDemo).endpoint().AuthURL + "?" + replacePlus(url.Values{
+	return c.environment().authURL() + "?" + replacePlus(url.Values{
 		"response_type": {"code"},
 		"scope":         {scopeValue},
 		"client_id":     {c.IntegratorKey},
@@ -290,7 +298,7 @@ func (c *JWTConfig) ExternalAdminConsentURL(redirectURL, authType, state string,
 		v.Set("prompt", "login")
 	}
 	query := replacePlus(v.Encode())
-	return "https://" + demoFlag(c.IsDemo).tokenURI() + "/oauth/auth?" + query, nil
+	return c.environment().authURL() + "?" + query, nil
 }
 
 // AdminConsentResponse is the response sent to the redirect url of and external admin
@@ -308,40 +316,98 @@ type AdminConsentResponse struct {
 	COID      []string `json:"coid"`      //  list of organization IDs for the organizations whose admin has granted consent
 }
 
-func (c *JWTConfig) jwtRefresher(apiUserName string, signer jws.Signer, scopes ...string) func(ctx context.Context, tk *oauth2.Token) (*oauth2.Token, error) {
+func (c *JWTConfig) jwtRefresher(apiUserName string, signers []JWTSigner, scopes ...string) func(ctx context.Context, tk *oauth2.Token) (*oauth2.Token, error) {
 	if len(scopes) == 0 {
 		scopes = []string{"signature", "impersonation"}
 	} else {
 		scopes = addUnique(scopes, "impersonation")
 	}
-	cfg := &jwt.Config{
-		Issuer:         c.IntegratorKey,
-		Signer:         signer,
-		Subject:        apiUserName,
-		Options:        c.Options,
-		Scopes:         scopes,
-		Audience:       demoFlag(c.IsDemo).tokenURI(),
-		TokenURL:       demoFlag(c.IsDemo).endpoint().TokenURL,
-		HTTPClientFunc: c.HTTPClientFunc,
+	env := c.environment()
+	g := &jwtGrant{
+		issuer:   c.IntegratorKey,
+		subject:  apiUserName,
+		audience: env.AuthHost,
+		tokenURL: env.tokenURL(),
+		scopes:   scopes,
+		options:  c.Options,
+		f:        c.HTTPClientFunc,
+		consentURL: func(scopes ...string) string {
+			return c.UserConsentURL(c.ConsentRedirectURL, scopes...)
+		},
 	}
+	keyPairFunc := c.KeyPairFunc
 	return func(ctx context.Context, tk *oauth2.Token) (*oauth2.Token, error) {
-		return cfg.Token(ctx)
+		var rejected []string
+		var err error
+		for _, signer := range signers {
+			var newTk *oauth2.Token
+			if newTk, err = g.token(ctx, signer); err != nil {
+				// try next key only if signature is rejected
+				if grantErr, ok := err.(*InvalidGrantError); ok && grantErr.Reason == InvalidGrantNoValidKeys {
+					rejected = append(rejected, signer.KeyID())
+					continue
+				}
+				return nil, err
+			}
+			if keyPairFunc != nil {
+				keyPairFunc(ctx, signer.KeyID(), rejected)
+			}
+			return newTk, nil
+		}
+		return nil, err
+	}
+}
+
+// JWTKeyPair is an RSA key pair registered for the integrator key.
+type JWTKeyPair struct {
+	// PEM encoding of an RSA Private Key.
+	PrivateKey string `json:"private_key,omitempty"`
+	KeyPairID  string `json:"key_pair_id,omitempty"`
+	// Signer, if not nil, is used in place of PrivateKey and KeyPairID.
+	Signer JWTSigner `json:"-"`
+}
+
+func (kp JWTKeyPair) signer() (JWTSigner, error) {
+	if kp.Signer != nil {
+		return kp.Signer, nil
+	}
+	return PEMSigner([]byte(kp.PrivateKey), kp.KeyPairID)
+}
+
+// signers returns the primary signer, c.Signer or PrivateKey, followed
+// by signers for each of the KeyPairs.
+func (c *JWTConfig) signers() ([]JWTSigner, error) {
+	var signers []JWTSigner
+	if c.Signer != nil || c.PrivateKey > "" || len(c.KeyPairs) == 0 {
+		signer, err := JWTKeyPair{PrivateKey: c.PrivateKey, KeyPairID: c.KeyPairID, Signer: c.Signer}.signer()
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
 	}
+	for i, kp := range c.KeyPairs {
+		signer, err := kp.signer()
+		if err != nil {
+			return nil, fmt.Errorf("key pair %d: %v", i, err)
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
 }
 
 // Credential returns an *OAuth2Credential.  The passed token will be refreshed
 // as needed.  If no scopes listed, signature is assumed.
 func (c *JWTConfig) Credential(apiUserName string, token *oauth2.Token, u *UserInfo, scopes ...string) (*OAuth2Credential, error) {
-	signer, err := jws.RS256FromPEM([]byte(c.PrivateKey), c.KeyPairID)
+	signers, err := c.signers()
 	if err != nil {
 		return nil, err
 	}
 	return &OAuth2Credential{
 		accountID:   c.AccountID,
 		cachedToken: token,
-		refresher:   c.jwtRefresher(apiUserName, signer, scopes...),
+		refresher:   c.jwtRefresher(apiUserName, signers, scopes...),
 		cacheFunc:   c.CacheFunc,
-		isDemo:      demoFlag(c.IsDemo),
+		env:         c.environment(),
 		userInfo:    u,
 		Func:        c.HTTPClientFunc,
 	}, nil
@@ -355,7 +421,11 @@ type OAuth2Credential struct {
 	refresher   func(context.Context, *oauth2.Token) (*oauth2.Token, error)
 	cacheFunc   func(context.Context, oauth2.Token, UserInfo)
 	userInfo    *UserInfo
-	isDemo      demoFlag
+	// getUserInfo, if not nil, replaces the userinfo call (e.g. to share
+	// userinfo between the credentials of a CredentialPool user).
+	getUserInfo func(context.Context, *oauth2.Token) (*UserInfo, error)
+	env         *Environment
+	actAsUser   string // X-DocuSign-Act-As-User header value
 	mu          sync.Mutex
 	ctxclient.Func
 }
@@ -378,6 +448,9 @@ func (cred *OAuth2Credential) AuthDo(ctx context.Context, req *http.Request, v *
 	r2.Header = h
 
 	t.SetAuthHeader(&r2)
+	if cred.actAsUser != "" {
+		r2.Header.Set("X-DocuSign-Act-As-User", cred.actAsUser)
+	}
 	// finalize url
 	r2.URL = v.ResolveDSURL(req.URL, cred.baseURI.Host, cred.accountID)
 	res, err := cred.Func.Do(ctx, &r2)
@@ -392,14 +465,44 @@ func (cred *OAuth2Credential) WithAccountID(accountID string) *OAuth2Credential
 	if cred == nil {
 		return nil
 	}
-	var mu sync.Mutex
-	cred.mu.Lock()
-	c := *cred
-	c.mu = mu
-	cred.mu.Unlock()
+	c := cred.clone()
 	c.baseURI = nil
 	c.accountID = accountID
-	return &c
+	return c
+}
+
+// WithActAsUser creates a copy of the current credential that sends requests on
+// behalf of the user identified by userIDOrEmail by setting the
+// X-DocuSign-Act-As-User header.  The credential's user must have send on behalf
+// of rights in the account. An empty userIDOrEmail removes the header.
+//
+// See https://developers.docusign.com/esign-rest-api/guides/authentication/send-on-behalf
+func (cred *OAuth2Credential) WithActAsUser(userIDOrEmail string) *OAuth2Credential {
+	if cred == nil {
+		return nil
+	}
+	c := cred.clone()
+	c.actAsUser = userIDOrEmail
+	return c
+}
+
+// clone copies the credential's fields, excluding the mutex, to
+// a new credential.
+func (cred *OAuth2Credential) clone() *OAuth2Credential {
+	cred.mu.Lock()
+	defer cred.mu.Unlock()
+	return &OAuth2Credential{
+		accountID:   cred.accountID,
+		baseURI:     cred.baseURI,
+		cachedToken: cred.cachedToken,
+		refresher:   cred.refresher,
+		cacheFunc:   cred.cacheFunc,
+		userInfo:    cred.userInfo,
+		getUserInfo: cred.getUserInfo,
+		env:         cred.env,
+		actAsUser:   cred.actAsUser,
+		Func:        cred.Func,
+	}
 }
 
 // UserInfo returns user data returned from the /oauth/userinfo ednpoint.
@@ -446,14 +549,18 @@ func (cred *OAuth2Credential) Token(ctx context.Context) (*oauth2.Token, error)
 	}
 	// check for userInfo and set AccountID and BaseURI to resolve op urls
 	if cred.userInfo == nil {
-		cred.userInfo, err = cred.isDemo.getUserInfoForToken(ctx, cred.Func, cred.cachedToken)
+		if cred.getUserInfo != nil {
+			cred.userInfo, err = cred.getUserInfo(ctx, cred.cachedToken)
+		} else {
+			cred.userInfo, err = cred.env.getUserInfoForToken(ctx, cred.Func, cred.cachedToken)
+		}
 		if err != nil {
 			return nil, err
 		}
 		updateCache = (cred.cacheFunc != nil)
 	}
 	if cred.baseURI == nil || cred.accountID == "" { // values may be blank if loading userinfo from cache
-		if cred.accountID, cred.baseURI, err = cred.userInfo.getAccountID(cred.accountID); err != nil {
+		if cred.accountID, cred.baseURI, err = cred.env.accountBaseURI(cred.userInfo, cred.accountID); err != nil {
 			return nil, err
 		}
 	}
@@ -482,11 +589,18 @@ func (cred *OAuth2Credential) SetCacheFunc(f func(context.Context, oauth2.Token,
 // TokenCredential create a static credential without refresh capabilities.  When
 // the token expires, ops will receive a 401 error,
 func TokenCredential(accessToken string, isDemo bool) *OAuth2Credential {
+	return TokenCredentialWithEnvironment(accessToken, resolveEnvironment(nil, isDemo))
+}
+
+// TokenCredentialWithEnvironment creates a static credential without refresh
+// capabilities whose userinfo calls are sent to env.  A nil env indicates
+// ProductionEnvironment.
+func TokenCredentialWithEnvironment(accessToken string, env *Environment) *OAuth2Credential {
 	return &OAuth2Credential{
 		cachedToken: &oauth2.Token{
 			AccessToken: accessToken,
 		},
-		isDemo: demoFlag(isDemo),
+		env: resolveEnvironment(env, false),
 	}
 }
 
@@ -504,7 +618,9 @@ func (t *tokenCredential) AuthDo(ctx context.Context, req *http.Request, v *APIV
 
 func toResponseError(err error) error {
 	if nsErr, ok := err.(*ctxclient.NotSuccess); ok {
-		return NewResponseError(nsErr.Body, nsErr.StatusCode)
+		re := NewResponseError(nsErr.Body, nsErr.StatusCode)
+		re.Header = nsErr.Header
+		return re
 	}
 	return err
 }
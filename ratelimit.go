@@ -0,0 +1,93 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimited returns a Credential that waits for l before each call
+// to cred.  A limiter may be shared by many credentials.
+func RateLimited(cred Credential, l *RateLimiter) Credential {
+	if l == nil {
+		return cred
+	}
+	return &rateLimitedCredential{cred: cred, limiter: l}
+}
+
+type rateLimitedCredential struct {
+	cred    Credential
+	limiter *RateLimiter
+}
+
+// AuthDo waits for the rate limit before calling the underlying credential.
+func (r *rateLimitedCredential) AuthDo(ctx context.Context, req *http.Request, v *APIVersion) (*http.Response, error) {
+	if err := r.limiter.Wait(ctx); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return r.cred.AuthDo(ctx, req, v)
+}
+
+// RateLimiter is a token bucket allowing a number of calls per second
+// with bursts of up to burst calls.
+type RateLimiter struct {
+	rate   float64
+	burst  float64
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond calls with
+// bursts of up to burst calls.  If requestsPerSecond is not positive, nil
+// (no limit) is returned.  A burst less than 1 is treated as 1.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{rate: requestsPerSecond, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Wait blocks until a call is allowed or ctx is done.  A nil limiter
+// never blocks.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++ // return unused reservation
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
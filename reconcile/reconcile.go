@@ -0,0 +1,354 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package reconcile retries failed Connect deliveries.  A Reconciler lists
+// the account's Connect failure log, republishes failed envelopes in
+// batches and reports envelopes that continue to fail after MaxAttempts.
+//
+//	r := &reconcile.Reconciler{
+//		Credential:  cred,
+//		MaxAttempts: 5,
+//		OnReport: func(ctx context.Context, rpt *reconcile.Report) {
+//			if rpt.Alert() {
+//				notifyOps(rpt)
+//			}
+//		},
+//	}
+//	err := r.Run(ctx, 15*time.Minute)
+package reconcile // import "github.com/jfcote87/esign/reconcile"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/v2.1/connect"
+	"github.com/jfcote87/esign/v2.1/model"
+)
+
+// Defaults for Reconciler values
+const (
+	DefaultMaxAttempts = 5
+	DefaultBatchSize   = 100
+)
+
+// Attempt is the retry state of a failing envelope.
+type Attempt struct {
+	// Count is the number of successful retry calls.
+	Count int `json:"count"`
+	// Failed is the latest creation time of the envelope's failure log
+	// entries.  It determines whether the entries left the Lookback
+	// window.
+	Failed time.Time `json:"failed,omitempty"`
+	// GaveUp is set once the envelope has been reported in Report.GaveUp.
+	GaveUp bool `json:"gave_up,omitempty"`
+}
+
+// AttemptStore persists the retry state of each failing envelope.
+type AttemptStore interface {
+	LoadAttempts(ctx context.Context) (map[string]*Attempt, error)
+	SaveAttempts(ctx context.Context, attempts map[string]*Attempt) error
+}
+
+// Reconciler retries failed Connect deliveries.
+type Reconciler struct {
+	Credential esign.Credential
+	// Attempts persists retry counts between runs.  If nil, counts are
+	// kept in memory.
+	Attempts AttemptStore
+	// MaxAttempts is the number of retries before an envelope is reported
+	// as permanently failing.  If 0, DefaultMaxAttempts is used.
+	MaxAttempts int
+	// BatchSize is the number of envelopes per retry call.  If 0,
+	// DefaultBatchSize is used.
+	BatchSize int
+	// Lookback, if not 0, limits the failure log to failures within the
+	// duration.  Envelopes whose failures leave the window are dropped
+	// without being reported as resolved.
+	Lookback time.Duration
+	// Synchronous waits for each retry's delivery result.  Failure log
+	// entries of successfully delivered envelopes are deleted immediately.
+	// Otherwise a retried envelope is resolved when its entries no longer
+	// appear in the failure log.
+	Synchronous bool
+	// OnReport, if not nil, is called with the report of each run.
+	OnReport func(context.Context, *Report)
+
+	mu       sync.Mutex
+	attempts map[string]*Attempt
+}
+
+// Report describes a reconciliation run.
+type Report struct {
+	StartedAt time.Time     `json:"started_at"`
+	Duration  time.Duration `json:"duration"`
+	// Failures is the number of entries in the failure log.
+	Failures int `json:"failures"`
+	// Retried contains the retry result of each republished envelope.
+	Retried []RetryResult `json:"retried,omitempty"`
+	// Resolved lists envelopes whose failures were resolved.
+	Resolved []string `json:"resolved,omitempty"`
+	// GaveUp lists envelopes that failed MaxAttempts retries.  Each
+	// envelope is reported by a single run.
+	GaveUp []Failure `json:"gave_up,omitempty"`
+	// Errors lists errors encountered during the run.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// RetryResult is the outcome of republishing an envelope.
+type RetryResult struct {
+	EnvelopeID string `json:"envelope_id"`
+	Attempt    int    `json:"attempt"`
+	Status     string `json:"status,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// Failure describes a permanently failing envelope.
+type Failure struct {
+	EnvelopeID string `json:"envelope_id"`
+	Attempts   int    `json:"attempts"`
+	ConfigURL  string `json:"config_url,omitempty"`
+	Error      string `json:"error,omitempty"`
+	LastTry    string `json:"last_try,omitempty"`
+}
+
+// Alert returns true if envelopes were found to be permanently failing or
+// the run encountered errors.
+func (rpt *Report) Alert() bool {
+	return len(rpt.GaveUp) > 0 || len(rpt.Errors) > 0
+}
+
+// Run reconciles every interval until ctx is done.
+func (r *Reconciler) Run(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("reconcile: interval must be positive")
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		r.Reconcile(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Reconcile lists failures, retries failed envelopes and deletes the
+// failure log entries of resolved envelopes.  Errors are recorded in the
+// report.
+func (r *Reconciler) Reconcile(ctx context.Context) *Report {
+	rpt := &Report{StartedAt: time.Now()}
+	r.reconcile(ctx, rpt)
+	rpt.Duration = time.Since(rpt.StartedAt)
+	if r.OnReport != nil {
+		r.OnReport(ctx, rpt)
+	}
+	return rpt
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, rpt *Report) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	attempts, err := r.loadAttempts(ctx)
+	if err != nil {
+		rpt.addErr(err)
+		return
+	}
+	sv := connect.New(r.Credential)
+	op := sv.EventsListFailures()
+	var from time.Time
+	if r.Lookback > 0 {
+		from = rpt.StartedAt.Add(-r.Lookback)
+		op.FromDate(from)
+	}
+	logs, err := op.Do(ctx)
+	if err != nil {
+		rpt.addErr(err)
+		return
+	}
+	rpt.Failures = len(logs.Failures)
+
+	// group failure log entries by envelope
+	failures := make(map[string][]model.ConnectLog)
+	var envelopeIDs []string
+	for _, f := range logs.Failures {
+		if f.EnvelopeID == "" {
+			continue
+		}
+		if _, ok := failures[f.EnvelopeID]; !ok {
+			envelopeIDs = append(envelopeIDs, f.EnvelopeID)
+		}
+		failures[f.EnvelopeID] = append(failures[f.EnvelopeID], f)
+	}
+	// retried envelopes no longer failing are resolved unless their
+	// failures only aged out of the lookback window
+	for id, a := range attempts {
+		if _, ok := failures[id]; ok {
+			continue
+		}
+		delete(attempts, id)
+		if r.Lookback > 0 && a.Failed.Before(from) {
+			continue
+		}
+		rpt.Resolved = append(rpt.Resolved, id)
+	}
+	sort.Strings(rpt.Resolved)
+
+	var retries []string
+	for _, id := range envelopeIDs {
+		a := attempts[id]
+		if a != nil {
+			a.Failed = lastFailed(failures[id])
+		}
+		switch {
+		case a == nil:
+		case a.Count >= r.maxAttempts():
+			if !a.GaveUp {
+				a.GaveUp = true
+				last := failures[id][len(failures[id])-1]
+				rpt.GaveUp = append(rpt.GaveUp, Failure{
+					EnvelopeID: id,
+					Attempts:   a.Count,
+					ConfigURL:  last.ConfigURL,
+					Error:      last.Error,
+					LastTry:    last.LastTry,
+				})
+			}
+			continue
+		}
+		retries = append(retries, id)
+	}
+	for len(retries) > 0 {
+		batch := retries
+		if len(batch) > r.batchSize() {
+			batch = batch[:r.batchSize()]
+		}
+		retries = retries[len(batch):]
+		r.retry(ctx, sv, batch, attempts, failures, rpt)
+	}
+	if err = r.saveAttempts(ctx, attempts); err != nil {
+		rpt.addErr(err)
+	}
+}
+
+// retry republishes a batch of envelopes.  When synchronous, the failure
+// entries of delivered envelopes are deleted.  Attempts are counted only
+// if the retry call succeeds.
+func (r *Reconciler) retry(ctx context.Context, sv *connect.Service, batch []string, attempts map[string]*Attempt, failures map[string][]model.ConnectLog, rpt *Report) {
+	filter := &model.ConnectFailureFilter{EnvelopeIds: batch}
+	if r.Synchronous {
+		filter.Synchronous = "true"
+	}
+	res, err := sv.EventsRetryForEnvelopes(filter).Do(ctx)
+	if err != nil {
+		rpt.addErr(err)
+		return
+	}
+	for _, id := range batch {
+		a := attempts[id]
+		if a == nil {
+			a = &Attempt{Failed: lastFailed(failures[id])}
+			attempts[id] = a
+		}
+		a.Count++
+	}
+	for _, q := range res.RetryQueue {
+		var n int
+		if a := attempts[q.EnvelopeID]; a != nil {
+			n = a.Count
+		}
+		rpt.Retried = append(rpt.Retried, RetryResult{
+			EnvelopeID: q.EnvelopeID,
+			Attempt:    n,
+			Status:     q.Status,
+			Message:    q.StatusMessage,
+		})
+		if r.Synchronous && strings.EqualFold(q.Status, "success") {
+			r.resolve(ctx, sv, q.EnvelopeID, attempts, failures, rpt)
+		}
+	}
+}
+
+// resolve deletes the failure log entries of a delivered envelope.
+func (r *Reconciler) resolve(ctx context.Context, sv *connect.Service, id string, attempts map[string]*Attempt, failures map[string][]model.ConnectLog, rpt *Report) {
+	for _, f := range failures[id] {
+		if f.FailureID == "" {
+			continue
+		}
+		if err := sv.EventsDeleteFailure(f.FailureID).Do(ctx); err != nil {
+			rpt.addErr(err)
+			return
+		}
+	}
+	delete(attempts, id)
+	rpt.Resolved = append(rpt.Resolved, id)
+}
+
+// lastFailed returns the latest creation time of an envelope's failure
+// log entries.
+func lastFailed(logs []model.ConnectLog) time.Time {
+	var last time.Time
+	for _, f := range logs {
+		if f.Created != nil && f.Created.After(last) {
+			last = *f.Created
+		}
+	}
+	return last
+}
+
+func (r *Reconciler) loadAttempts(ctx context.Context) (map[string]*Attempt, error) {
+	if r.Attempts != nil {
+		m, err := r.Attempts.LoadAttempts(ctx)
+		if m == nil && err == nil {
+			m = make(map[string]*Attempt)
+		}
+		return m, err
+	}
+	m := make(map[string]*Attempt)
+	for k, v := range r.attempts {
+		a := *v
+		m[k] = &a
+	}
+	return m, nil
+}
+
+func (r *Reconciler) saveAttempts(ctx context.Context, m map[string]*Attempt) error {
+	if r.Attempts != nil {
+		return r.Attempts.SaveAttempts(ctx, m)
+	}
+	r.attempts = m
+	return nil
+}
+
+func (r *Reconciler) maxAttempts() int {
+	if r.MaxAttempts > 0 {
+		return r.MaxAttempts
+	}
+	return DefaultMaxAttempts
+}
+
+func (r *Reconciler) batchSize() int {
+	if r.BatchSize > 0 {
+		return r.BatchSize
+	}
+	return DefaultBatchSize
+}
+
+func (rpt *Report) addErr(err error) {
+	rpt.Errors = append(rpt.Errors, err.Error())
+}
+
+// String summarizes the report.
+func (rpt *Report) String() string {
+	return fmt.Sprintf("failures: %d, retried: %d, resolved: %d, gave up: %d, errors: %d",
+		rpt.Failures, len(rpt.Retried), len(rpt.Resolved), len(rpt.GaveUp), len(rpt.Errors))
+}
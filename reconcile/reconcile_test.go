@@ -0,0 +1,190 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package reconcile_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/esign/reconcile"
+	"github.com/jfcote87/testutils"
+)
+
+const userInfo = `{"sub": "USER", "accounts": [
+	{"account_id": "ACCOUNT1", "is_default": true, "base_uri": "https://gotest.docusign.net"}]}`
+
+const failuresPath = "/restapi/v2.1/accounts/ACCOUNT1/connect/failures"
+const retryPath = "/restapi/v2.1/accounts/ACCOUNT1/connect/envelopes/retry_queue"
+
+func TestReconciler(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	var reports []*reconcile.Report
+	r := &reconcile.Reconciler{
+		Credential:  cred,
+		MaxAttempts: 2,
+		BatchSize:   2,
+		Synchronous: true,
+		OnReport: func(ctx context.Context, rpt *reconcile.Report) {
+			reports = append(reports, rpt)
+		},
+	}
+
+	// first run: ENV1, ENV2 and ENV3 fail; ENV2 is delivered on retry
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfo), nil),
+	}, &testutils.RequestTester{
+		Path: failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[
+			{"envelopeId":"ENV1","failureId":"F1","error":"timeout"},
+			{"envelopeId":"ENV2","failureId":"F2"},
+			{"envelopeId":"ENV1","failureId":"F3","error":"503 from listener"},
+			{"envelopeId":"ENV3","failureId":"F4"}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:     retryPath,
+		Method:   "PUT",
+		Payload:  []byte(`{"envelopeIds":["ENV1","ENV2"],"synchronous":"true"}` + "\n"),
+		Response: testutils.MakeResponse(200, []byte(`{"retryQueue":[{"envelopeId":"ENV1","status":"Failed"},{"envelopeId":"ENV2","status":"Success"}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:   failuresPath + "/F2",
+		Method: "DELETE",
+	}, &testutils.RequestTester{
+		Path:     retryPath,
+		Method:   "PUT",
+		Payload:  []byte(`{"envelopeIds":["ENV3"],"synchronous":"true"}` + "\n"),
+		Response: testutils.MakeResponse(200, []byte(`{"retryQueue":[{"envelopeId":"ENV3","status":"Failed"}]}`), nil),
+	})
+	rpt := r.Reconcile(ctx)
+	if rpt.Failures != 4 || len(rpt.Retried) != 3 || !reflect.DeepEqual(rpt.Resolved, []string{"ENV2"}) || rpt.Alert() {
+		t.Fatalf("run 1: unexpected report %s %v", rpt, rpt.Errors)
+	}
+
+	// a failed retry call does not count as an attempt
+	testTransport.Add(&testutils.RequestTester{
+		Path: failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[
+			{"envelopeId":"ENV1","failureId":"F3","error":"503 from listener"},
+			{"envelopeId":"ENV3","failureId":"F4"}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:     retryPath,
+		Response: testutils.MakeResponse(500, []byte(`{"errorCode":"UNKNOWN"}`), nil),
+	})
+	if rpt = r.Reconcile(ctx); len(rpt.Errors) != 1 || len(rpt.Retried) != 0 {
+		t.Fatalf("retry error: unexpected report %s", rpt)
+	}
+
+	// second run: ENV1 retried a second time, ENV3 was resolved
+	testTransport.Add(&testutils.RequestTester{
+		Path:     failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[{"envelopeId":"ENV1","failureId":"F3","error":"503 from listener"}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:     retryPath,
+		Response: testutils.MakeResponse(200, []byte(`{"retryQueue":[{"envelopeId":"ENV1","status":"Failed"}]}`), nil),
+	})
+	rpt = r.Reconcile(ctx)
+	if len(rpt.Retried) != 1 || rpt.Retried[0].Attempt != 2 || !reflect.DeepEqual(rpt.Resolved, []string{"ENV3"}) {
+		t.Fatalf("run 2: unexpected report %s %#v", rpt, rpt)
+	}
+
+	// third run: ENV1 exceeds MaxAttempts
+	testTransport.Add(&testutils.RequestTester{
+		Path:     failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[{"envelopeId":"ENV1","failureId":"F3","error":"503 from listener"}]}`), nil),
+	})
+	rpt = r.Reconcile(ctx)
+	if !rpt.Alert() || len(rpt.Retried) != 0 || len(rpt.GaveUp) != 1 {
+		t.Fatalf("run 3: expected alert; got %s", rpt)
+	}
+	if g := rpt.GaveUp[0]; g.EnvelopeID != "ENV1" || g.Attempts != 2 || g.Error != "503 from listener" {
+		t.Errorf("run 3: unexpected failure %#v", g)
+	}
+
+	// fourth run: ENV1 was already reported
+	testTransport.Add(&testutils.RequestTester{
+		Path:     failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[{"envelopeId":"ENV1","failureId":"F3","error":"503 from listener"}]}`), nil),
+	})
+	if rpt = r.Reconcile(ctx); rpt.Alert() || len(rpt.Retried) != 0 || len(rpt.GaveUp) != 0 {
+		t.Fatalf("run 4: expected no alert; got %s", rpt)
+	}
+
+	// list error
+	testTransport.Add(&testutils.RequestTester{
+		Path:     failuresPath,
+		Response: testutils.MakeResponse(500, []byte(`{"errorCode":"UNKNOWN"}`), nil),
+	})
+	if rpt = r.Reconcile(ctx); !rpt.Alert() || len(rpt.Errors) != 1 || len(reports) != 6 {
+		t.Errorf("expected error report; got %s", rpt)
+	}
+	if len(testTransport.Queue) != 0 {
+		t.Errorf("expected all requests sent; %d remain", len(testTransport.Queue))
+	}
+}
+
+func TestReconciler_async(t *testing.T) {
+	ctx := context.Background()
+	testTransport := &testutils.Transport{}
+	cred := esign.TokenCredential("ABCDEF", true).
+		SetClientFunc(func(ctx context.Context) (*http.Client, error) {
+			return &http.Client{Transport: testTransport}, nil
+		})
+	r := &reconcile.Reconciler{Credential: cred, Lookback: time.Hour}
+	if err := r.Run(ctx, 0); err == nil {
+		t.Errorf("expected interval error; got success")
+	}
+	created := func(d time.Duration) string {
+		return time.Now().Add(-d).UTC().Format(time.RFC3339)
+	}
+
+	// first run: ENV1, ENV2 and ENV3 are queued for redelivery
+	testTransport.Add(&testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfo), nil),
+	}, &testutils.RequestTester{
+		Path: failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[
+			{"envelopeId":"ENV1","failureId":"F1","created":"`+created(2*time.Hour)+`"},
+			{"envelopeId":"ENV2","failureId":"F2","created":"`+created(time.Minute)+`"},
+			{"envelopeId":"ENV3","failureId":"F3","created":"`+created(time.Minute)+`","lastTry":"2019-06-10T19:55:01.713Z"}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:     retryPath,
+		Method:   "PUT",
+		Payload:  []byte(`{"envelopeIds":["ENV1","ENV2","ENV3"]}` + "\n"),
+		Response: testutils.MakeResponse(200, []byte(`{"retryQueue":[{"envelopeId":"ENV1","status":"Queued"},{"envelopeId":"ENV2","status":"Queued"},{"envelopeId":"ENV3","status":"Queued"}]}`), nil),
+	})
+	rpt := r.Reconcile(ctx)
+	if len(rpt.Retried) != 3 || len(rpt.Resolved) != 0 || rpt.Alert() {
+		t.Fatalf("run 1: unexpected report %s %v", rpt, rpt.Errors)
+	}
+
+	// second run: ENV1 aged out of the lookback window and ENV2 was
+	// delivered.  ENV3's retry has not run, so it is retried again.
+	testTransport.Add(&testutils.RequestTester{
+		Path: failuresPath,
+		Response: testutils.MakeResponse(200, []byte(`{"failures":[
+			{"envelopeId":"ENV3","failureId":"F3","created":"`+created(time.Minute)+`","lastTry":"2019-06-10T19:55:01.713Z"}]}`), nil),
+	}, &testutils.RequestTester{
+		Path:     retryPath,
+		Method:   "PUT",
+		Payload:  []byte(`{"envelopeIds":["ENV3"]}` + "\n"),
+		Response: testutils.MakeResponse(200, []byte(`{"retryQueue":[{"envelopeId":"ENV3","status":"Queued"}]}`), nil),
+	})
+	rpt = r.Reconcile(ctx)
+	if !reflect.DeepEqual(rpt.Resolved, []string{"ENV2"}) || len(rpt.Retried) != 1 || rpt.Retried[0].Attempt != 2 || rpt.Alert() {
+		t.Fatalf("run 2: unexpected report %s %#v", rpt, rpt)
+	}
+	if len(testTransport.Queue) != 0 {
+		t.Errorf("expected all requests sent; %d remain", len(testTransport.Queue))
+	}
+}
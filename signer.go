@@ -0,0 +1,215 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+// signer.go contains the JWTSigner interface and adapters
+// for signing JWT assertions with keys held in PEM files,
+// environment variables or an external signing process.
+
+import (
+	"bufio"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	"github.com/jfcote87/oauth2/jws"
+)
+
+// JWTSigner creates RS256 signatures for JWT assertions.  Implement
+// JWTSigner to keep the integrator key's RSA private key in an HSM or
+// external key service rather than in application memory.
+type JWTSigner interface {
+	// Sign returns the RSASSA-PKCS1-v1_5 SHA-256 signature of content.
+	Sign(ctx context.Context, content []byte) ([]byte, error)
+	// KeyID returns the key pair id of the RSA key.
+	KeyID() string
+}
+
+// CryptoSigner returns a JWTSigner using s, which must hold an RSA
+// private key.  Many HSM and PKCS#11 libraries provide a crypto.Signer.
+func CryptoSigner(s crypto.Signer, keyID string) JWTSigner {
+	return &cryptoSigner{signer: s, keyID: keyID}
+}
+
+type cryptoSigner struct {
+	signer crypto.Signer
+	keyID  string
+}
+
+// Sign hashes content and signs the digest
+func (cs *cryptoSigner) Sign(ctx context.Context, content []byte) ([]byte, error) {
+	digest := sha256.Sum256(content)
+	return cs.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+// KeyID returns the key pair id
+func (cs *cryptoSigner) KeyID() string {
+	return cs.keyID
+}
+
+// PEMSigner returns a JWTSigner for a PKCS#1 or PKCS#8 PEM encoded
+// RSA private key.
+func PEMSigner(pemBytes []byte, keyID string) (JWTSigner, error) {
+	key, err := jws.ParseRSAKey(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	return CryptoSigner(key, keyID), nil
+}
+
+// PEMFileSigner returns a JWTSigner for the PKCS#1 or PKCS#8 PEM
+// encoded RSA private key in filename.
+func PEMFileSigner(filename string, keyID string) (JWTSigner, error) {
+	pemBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return PEMSigner(pemBytes, keyID)
+}
+
+// EnvPEMSigner returns a JWTSigner for the PKCS#1 or PKCS#8 PEM encoded
+// RSA private key contained in the environment variable name.
+func EnvPEMSigner(name string, keyID string) (JWTSigner, error) {
+	pemValue, ok := os.LookupEnv(name)
+	if !ok {
+		return nil, fmt.Errorf("environment variable %s not set", name)
+	}
+	return PEMSigner([]byte(pemValue), keyID)
+}
+
+// SocketSigner is a JWTSigner that sends a SHA-256 digest to a
+// signing process listening on a unix socket.  The request and
+// response are single lines of json (see SocketSignRequest and
+// SocketSignResponse). ServeSigner implements the listening process
+// and may be used as a stand-in for an HSM.
+type SocketSigner struct {
+	// Path of the unix socket
+	Path string
+	// KeyPairID identifies the key to the signing process and
+	// is returned from KeyID()
+	KeyPairID string
+	// Timeout limits the time for a signing request.  If 0,
+	// 10 seconds is assumed.
+	Timeout time.Duration
+}
+
+// SocketSignRequest is sent to the signing process by a SocketSigner.
+type SocketSignRequest struct {
+	KeyID  string `json:"key_id"`
+	Digest []byte `json:"digest"` // SHA-256 hash of content
+}
+
+// SocketSignResponse is returned from the signing process.
+type SocketSignResponse struct {
+	Signature []byte `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// KeyID returns the key pair id
+func (s *SocketSigner) KeyID() string {
+	return s.KeyPairID
+}
+
+// Sign sends the SHA-256 digest of content to the signing process and
+// returns the signature.
+func (s *SocketSigner) Sign(ctx context.Context, content []byte) ([]byte, error) {
+	timeout := s.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", s.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	digest := sha256.Sum256(content)
+	if err = json.NewEncoder(conn).Encode(&SocketSignRequest{KeyID: s.KeyPairID, Digest: digest[:]}); err != nil {
+		return nil, err
+	}
+	var res SocketSignResponse
+	if err = json.NewDecoder(bufio.NewReader(conn)).Decode(&res); err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, fmt.Errorf("socket signer: %s", res.Error)
+	}
+	return res.Signature, nil
+}
+
+// ServeSigner accepts connections on l and answers SocketSigner requests
+// using keys, a map of key pair ids to crypto.Signers.  ServeSigner returns
+// when l.Accept returns an error.
+func ServeSigner(l net.Listener, keys map[string]crypto.Signer) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go serveSignerConn(conn, keys)
+	}
+}
+
+func serveSignerConn(conn net.Conn, keys map[string]crypto.Signer) {
+	defer conn.Close()
+	var req SocketSignRequest
+	var res SocketSignResponse
+	err := json.NewDecoder(bufio.NewReader(conn)).Decode(&req)
+	if err == nil {
+		key, ok := keys[req.KeyID]
+		switch {
+		case !ok:
+			err = fmt.Errorf("unknown key %s", req.KeyID)
+		case len(req.Digest) != sha256.Size:
+			err = errors.New("invalid digest")
+		default:
+			res.Signature, err = key.Sign(rand.Reader, req.Digest, crypto.SHA256)
+		}
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	json.NewEncoder(conn).Encode(&res)
+}
+
+// jwsSigner adapts a JWTSigner to a jws.Signer for a single
+// assertion.
+type jwsSigner struct {
+	ctx    context.Context
+	signer JWTSigner
+}
+
+// Sign fulfills jws.Signer interface
+func (s *jwsSigner) Sign(content []byte) ([]byte, error) {
+	return s.signer.Sign(s.ctx, content)
+}
+
+// Header returns the base64 encoded RS256 JWT header
+func (s *jwsSigner) Header() []byte {
+	hdr, _ := json.Marshal(struct {
+		Alg string `json:"alg"`
+		Typ string `json:"typ"`
+		Kid string `json:"kid,omitempty"`
+	}{"RS256", "JWT", s.signer.KeyID()})
+	encodedHdr := make([]byte, base64.RawURLEncoding.EncodedLen(len(hdr)))
+	base64.RawURLEncoding.Encode(encodedHdr, hdr)
+	return encodedHdr
+}
@@ -0,0 +1,151 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/oauth2/jws"
+	"github.com/jfcote87/testutils"
+)
+
+func checkSigner(ctx context.Context, signer esign.JWTSigner, key *rsa.PrivateKey, keyID string) error {
+	content := []byte("header.payload")
+	sig, err := signer.Sign(ctx, content)
+	if err != nil {
+		return fmt.Errorf("sign: %v", err)
+	}
+	if err = jws.RS256Verifier(&key.PublicKey)(sig, content); err != nil {
+		return fmt.Errorf("verify: %v", err)
+	}
+	if signer.KeyID() != keyID {
+		return fmt.Errorf("expected key id %s; got %s", keyID, signer.KeyID())
+	}
+	return nil
+}
+
+func TestPEMSigners(t *testing.T) {
+	ctx := context.Background()
+	key, err := jws.ParseRSAKey([]byte(testPK))
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	if _, err := esign.PEMSigner([]byte("not a key"), "KEYID"); err == nil {
+		t.Errorf("expected invalid pem error; got success")
+	}
+	signer, err := esign.PEMSigner([]byte(testPK), "KEYID")
+	if err != nil {
+		t.Fatalf("PEMSigner: %v", err)
+	}
+	if err = checkSigner(ctx, signer, key, "KEYID"); err != nil {
+		t.Errorf("PEMSigner %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "esign")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "key.pem")
+	if err = ioutil.WriteFile(fn, []byte(testPK), 0600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	if signer, err = esign.PEMFileSigner(fn, "FILEKEY"); err != nil {
+		t.Fatalf("PEMFileSigner: %v", err)
+	}
+	if err = checkSigner(ctx, signer, key, "FILEKEY"); err != nil {
+		t.Errorf("PEMFileSigner %v", err)
+	}
+	if _, err = esign.PEMFileSigner(filepath.Join(dir, "missing.pem"), "FILEKEY"); err == nil {
+		t.Errorf("expected missing file error; got success")
+	}
+
+	if _, err = esign.EnvPEMSigner("ESIGN_TEST_UNSET_KEY", "ENVKEY"); err == nil {
+		t.Errorf("expected unset environment variable error; got success")
+	}
+	os.Setenv("ESIGN_TEST_KEY", testPK)
+	defer os.Unsetenv("ESIGN_TEST_KEY")
+	if signer, err = esign.EnvPEMSigner("ESIGN_TEST_KEY", "ENVKEY"); err != nil {
+		t.Fatalf("EnvPEMSigner: %v", err)
+	}
+	if err = checkSigner(ctx, signer, key, "ENVKEY"); err != nil {
+		t.Errorf("EnvPEMSigner %v", err)
+	}
+}
+
+func TestSocketSigner(t *testing.T) {
+	ctx := context.Background()
+	key, err := jws.ParseRSAKey([]byte(testPK))
+	if err != nil {
+		t.Fatalf("parse key: %v", err)
+	}
+	dir, err := ioutil.TempDir("", "esign")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "signer.sock")
+	l, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer l.Close()
+	go esign.ServeSigner(l, map[string]crypto.Signer{"HSMKEY": key})
+
+	signer := &esign.SocketSigner{Path: socketPath, KeyPairID: "HSMKEY"}
+	if err = checkSigner(ctx, signer, key, "HSMKEY"); err != nil {
+		t.Errorf("SocketSigner %v", err)
+	}
+	badSigner := &esign.SocketSigner{Path: socketPath, KeyPairID: "UNKNOWN"}
+	if _, err = badSigner.Sign(ctx, []byte("content")); err == nil || err.Error() != "socket signer: unknown key UNKNOWN" {
+		t.Errorf("expected socket signer: unknown key UNKNOWN; got %v", err)
+	}
+
+	// use socket signer for JWT credential
+	testTransport := &testutils.Transport{}
+	clx := &http.Client{Transport: testTransport}
+	cfg := &esign.JWTConfig{
+		IntegratorKey: "KEY",
+		Signer:        signer,
+		IsDemo:        true,
+		HTTPClientFunc: func(ctx context.Context) (*http.Client, error) {
+			return clx, nil
+		},
+	}
+	testTransport.Add(&testutils.RequestTester{
+		Path: "/oauth/token",
+		ResponseFunc: func(r *http.Request) (*http.Response, error) {
+			assertion := r.FormValue("assertion")
+			var hdr map[string]interface{}
+			if err := jws.DecodeHeader(assertion, &hdr); err != nil || hdr["kid"] != "HSMKEY" || hdr["alg"] != "RS256" {
+				return testutils.MakeResponse(400, []byte(fmt.Sprintf("invalid header %v %v", hdr, err)), nil), nil
+			}
+			if err := jws.Verify(assertion, jws.RS256Verifier(&key.PublicKey)); err != nil {
+				return testutils.MakeResponse(400, []byte(`{"error":"invalid_grant","error_description":"no_valid_keys_or_signatures"}`), nil), nil
+			}
+			return testutils.MakeResponse(200, []byte(tokenSuccessResponse), nil), nil
+		},
+	})
+	u := &esign.UserInfo{
+		Accounts: []esign.UserInfoAccount{{AccountID: "ACCOUNT", IsDefault: true, BaseURI: "https://gotest.docusign.net"}},
+	}
+	cred, err := cfg.Credential("USERID", nil, u)
+	if err != nil {
+		t.Fatalf("expected credential; got %v", err)
+	}
+	if _, err = cred.Token(ctx); err != nil {
+		t.Errorf("expected token signed by socket signer; got %v", err)
+	}
+}
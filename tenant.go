@@ -0,0 +1,303 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package esign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/jfcote87/oauth2"
+)
+
+// Tenant describes how a customer's DocuSign connection is authorized.
+// Exactly one of OAuth2 (code grant) or JWT must be set.
+type Tenant struct {
+	ID string `json:"id"`
+	// OAuth2 tenants require a State containing the token from the
+	// code grant exchange.
+	OAuth2 *OAuth2Config `json:"oauth2,omitempty"`
+	// JWT tenants impersonate APIUserID.
+	JWT       *JWTConfig `json:"jwt,omitempty"`
+	APIUserID string     `json:"api_user_id,omitempty"`
+	// State is the most recently saved credential state.
+	State *CredentialState `json:"state,omitempty"`
+	// RequestsPerSecond limits the tenant's api calls.  0 indicates
+	// no limit.
+	RequestsPerSecond float64 `json:"requests_per_second,omitempty"`
+	// Burst is the number of calls allowed above RequestsPerSecond.  If 0,
+	// 1 is assumed.
+	Burst int `json:"burst,omitempty"`
+}
+
+// TenantStore loads tenant definitions and persists credential state.
+type TenantStore interface {
+	// LoadTenant returns the tenant's definition.
+	LoadTenant(ctx context.Context, tenantID string) (*Tenant, error)
+	// SaveState is called whenever a tenant's credential obtains a new token.
+	SaveState(ctx context.Context, tenantID string, st *CredentialState) error
+}
+
+// TenantEvent types
+const (
+	TenantLoaded      = "loaded"
+	TenantRevoked     = "revoked"
+	TenantReconnected = "reconnected"
+	TenantSaveFailed  = "save_failed"
+)
+
+// TenantEvent reports changes to a tenant's connection.
+type TenantEvent struct {
+	TenantID string
+	Type     string
+	Err      error
+}
+
+// TenantManager provides credentials for many tenants.  Tenants are loaded
+// from the Store on first use, each with its own http client and rate
+// limit.  A credential is dropped from the manager when DocuSign reports
+// that consent or the grant has been revoked.
+//
+//	ctx = esign.WithTenant(ctx, customerID)
+//	res, err := envelopes.New(mgr.For(ctx)).ListStatusChanges().Do(ctx)
+type TenantManager struct {
+	Store TenantStore
+	// NewHTTPClient, if not nil, creates the http client for a tenant.
+	// Otherwise each tenant receives a client with a dedicated transport.
+	NewHTTPClient func(tenantID string) *http.Client
+	// OnEvent, if not nil, is called for each TenantEvent.
+	OnEvent func(context.Context, TenantEvent)
+
+	mu      sync.Mutex
+	entries map[string]*tenantEntry
+}
+
+type tenantEntry struct {
+	mu      sync.Mutex // serializes loading
+	cred    *OAuth2Credential
+	limiter *RateLimiter
+}
+
+type tenantCtxKey struct{}
+
+// WithTenant returns a context identifying tenantID for TenantManager.For.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantCtxKey{}, tenantID)
+}
+
+// TenantID returns the tenant id set by WithTenant.
+func TenantID(ctx context.Context) string {
+	id, _ := ctx.Value(tenantCtxKey{}).(string)
+	return id
+}
+
+// For returns a Credential for the tenant identified in ctx.  The tenant's
+// credential is loaded when the first op is sent.
+func (m *TenantManager) For(ctx context.Context) Credential {
+	return &tenantCredential{m: m, tenantID: TenantID(ctx)}
+}
+
+type tenantCredential struct {
+	m        *TenantManager
+	tenantID string
+}
+
+// AuthDo waits for the tenant's rate limit and sends the request using
+// the tenant's credential.
+func (tc *tenantCredential) AuthDo(ctx context.Context, req *http.Request, v *APIVersion) (*http.Response, error) {
+	cred, limiter, err := tc.m.entry(ctx, tc.tenantID)
+	if err == nil {
+		err = limiter.Wait(ctx)
+	}
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	res, err := cred.AuthDo(ctx, req, v)
+	if isRevocation(err) {
+		tc.m.Revoke(ctx, tc.tenantID, err)
+	}
+	return res, err
+}
+
+// Credential returns the tenant's credential, loading it from the
+// Store if necessary.
+func (m *TenantManager) Credential(ctx context.Context, tenantID string) (*OAuth2Credential, error) {
+	cred, _, err := m.entry(ctx, tenantID)
+	return cred, err
+}
+
+func (m *TenantManager) entry(ctx context.Context, tenantID string) (*OAuth2Credential, *RateLimiter, error) {
+	if m == nil || m.Store == nil {
+		return nil, nil, errors.New("tenant manager has no store")
+	}
+	if tenantID == "" {
+		return nil, nil, errors.New("no tenant in context")
+	}
+	m.mu.Lock()
+	if m.entries == nil {
+		m.entries = make(map[string]*tenantEntry)
+	}
+	e, ok := m.entries[tenantID]
+	if !ok {
+		e = &tenantEntry{}
+		m.entries[tenantID] = e
+	}
+	m.mu.Unlock()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cred == nil {
+		t, err := m.Store.LoadTenant(ctx, tenantID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if e.cred, err = m.credential(t); err != nil {
+			return nil, nil, err
+		}
+		e.limiter = NewRateLimiter(t.RequestsPerSecond, t.Burst)
+		m.event(ctx, TenantEvent{TenantID: tenantID, Type: TenantLoaded})
+	}
+	return e.cred, e.limiter, nil
+}
+
+// credential creates the tenant's credential using copies of the
+// tenant's configs with the tenant's http client and caching.
+func (m *TenantManager) credential(t *Tenant) (*OAuth2Credential, error) {
+	if t == nil {
+		return nil, errors.New("nil tenant")
+	}
+	clx := m.httpClient(t.ID)
+	f := func(ctx context.Context) (*http.Client, error) {
+		return clx, nil
+	}
+	switch {
+	case t.JWT != nil && t.OAuth2 == nil:
+		cfg := *t.JWT
+		cfg.HTTPClientFunc = f
+		cfg.CacheFunc = m.cacheFunc(t.ID, cfg.environment(), t.accountID(cfg.AccountID), cfg.CacheFunc)
+		if t.State != nil {
+			return cfg.RestoreCredential(t.APIUserID, t.State)
+		}
+		return cfg.Credential(t.APIUserID, nil, nil)
+	case t.OAuth2 != nil && t.JWT == nil:
+		cfg := *t.OAuth2
+		cfg.HTTPClientFunc = f
+		cfg.CacheFunc = m.cacheFunc(t.ID, cfg.environment(), t.accountID(cfg.AccountID), cfg.CacheFunc)
+		return cfg.RestoreCredential(t.State)
+	}
+	return nil, fmt.Errorf("tenant %s must define exactly one of OAuth2 or JWT", t.ID)
+}
+
+func (m *TenantManager) httpClient(tenantID string) *http.Client {
+	if m.NewHTTPClient != nil {
+		return m.NewHTTPClient(tenantID)
+	}
+	if tx, ok := http.DefaultTransport.(*http.Transport); ok {
+		return &http.Client{Transport: tx.Clone()}
+	}
+	return &http.Client{}
+}
+
+// accountID returns the account of the tenant's saved state or, if none,
+// the config's account.
+func (t *Tenant) accountID(cfgAccountID string) string {
+	if t.State != nil && t.State.AccountID > "" {
+		return t.State.AccountID
+	}
+	return cfgAccountID
+}
+
+// cacheFunc saves the tenant's new token and userinfo to the store
+// before calling the config's CacheFunc.  The saved account and base uri
+// are resolved from the userinfo as the credential resolves them, so a
+// restored credential keeps the same account.
+func (m *TenantManager) cacheFunc(tenantID string, env *Environment, accountID string, next func(context.Context, oauth2.Token, UserInfo)) func(context.Context, oauth2.Token, UserInfo) {
+	return func(ctx context.Context, tk oauth2.Token, u UserInfo) {
+		st := &CredentialState{
+			Token:       &tk,
+			UserInfo:    &u,
+			AccountID:   accountID,
+			Environment: env,
+		}
+		if id, baseURI, err := env.accountBaseURI(&u, accountID); err == nil {
+			st.AccountID, st.BaseURI = id, baseURI.String()
+		}
+		if err := m.Store.SaveState(ctx, tenantID, st); err != nil {
+			m.event(ctx, TenantEvent{TenantID: tenantID, Type: TenantSaveFailed, Err: err})
+		}
+		if next != nil {
+			next(ctx, tk, u)
+		}
+	}
+}
+
+// Revoke drops the tenant's credential.  The next call for the tenant
+// reloads the tenant from the Store.  err is reported in the TenantRevoked
+// event.
+func (m *TenantManager) Revoke(ctx context.Context, tenantID string, err error) {
+	if m.drop(tenantID) {
+		m.event(ctx, TenantEvent{TenantID: tenantID, Type: TenantRevoked, Err: err})
+	}
+}
+
+// Reconnect saves a new credential state (e.g. after a code grant exchange)
+// and drops the tenant's current credential.  For JWT tenants, st may be nil
+// after the user grants consent.
+func (m *TenantManager) Reconnect(ctx context.Context, tenantID string, st *CredentialState) error {
+	if m == nil || m.Store == nil {
+		return errors.New("tenant manager has no store")
+	}
+	if st != nil {
+		if err := m.Store.SaveState(ctx, tenantID, st); err != nil {
+			return err
+		}
+	}
+	m.drop(tenantID)
+	m.event(ctx, TenantEvent{TenantID: tenantID, Type: TenantReconnected})
+	return nil
+}
+
+// drop removes the tenant's entry returning true if a credential existed.
+func (m *TenantManager) drop(tenantID string) bool {
+	m.mu.Lock()
+	e, ok := m.entries[tenantID]
+	delete(m.entries, tenantID)
+	m.mu.Unlock()
+	if !ok {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.cred != nil
+}
+
+func (m *TenantManager) event(ctx context.Context, ev TenantEvent) {
+	if m.OnEvent != nil {
+		m.OnEvent(ctx, ev)
+	}
+}
+
+// isRevocation returns true if err indicates that the tenant's
+// authorization is no longer valid.
+func isRevocation(err error) bool {
+	var consentErr *ConsentRequiredError
+	var grantErr *InvalidGrantError
+	var respErr *ResponseError
+	switch {
+	case err == nil:
+		return false
+	case errors.As(err, &consentErr), errors.As(err, &grantErr):
+		return true
+	case errors.As(err, &respErr):
+		return respErr.Status == http.StatusUnauthorized
+	}
+	return false
+}
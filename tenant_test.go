@@ -0,0 +1,187 @@
+// Copyright 2019 James Cote
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+package esign_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfcote87/esign"
+	"github.com/jfcote87/oauth2"
+	"github.com/jfcote87/testutils"
+)
+
+type testTenantStore struct {
+	mu      sync.Mutex
+	tenants map[string]*esign.Tenant
+	loads   map[string]int
+	saved   map[string]*esign.CredentialState
+}
+
+func (s *testTenantStore) LoadTenant(ctx context.Context, tenantID string) (*esign.Tenant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loads[tenantID]++
+	t, ok := s.tenants[tenantID]
+	if !ok {
+		return nil, errors.New("unknown tenant " + tenantID)
+	}
+	return t, nil
+}
+
+func (s *testTenantStore) SaveState(ctx context.Context, tenantID string, st *esign.CredentialState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved[tenantID] = st
+	return nil
+}
+
+func TestTenantManager(t *testing.T) {
+	var u *esign.UserInfo
+	if err := json.Unmarshal([]byte(userInfoSuccessResponse), &u); err != nil {
+		t.Fatalf("unmarshal userinfo: %v", err)
+	}
+	store := &testTenantStore{
+		tenants: map[string]*esign.Tenant{
+			"JWTTENANT": {
+				ID:        "JWTTENANT",
+				JWT:       &esign.JWTConfig{IntegratorKey: "KEY1", PrivateKey: testPK, KeyPairID: "KP", IsDemo: true},
+				APIUserID: "USER1",
+			},
+			"CODETENANT": {
+				ID:     "CODETENANT",
+				OAuth2: &esign.OAuth2Config{IntegratorKey: "KEY2", Secret: "SECRET", IsDemo: true},
+				State: &esign.CredentialState{
+					Token:    &oauth2.Token{AccessToken: "CODETOKEN", RefreshToken: "REFRESH", Expiry: time.Now().Add(time.Hour)},
+					UserInfo: u,
+				},
+				RequestsPerSecond: 20,
+			},
+		},
+		loads: make(map[string]int),
+		saved: make(map[string]*esign.CredentialState),
+	}
+	transports := map[string]*testutils.Transport{
+		"JWTTENANT":  {},
+		"CODETENANT": {},
+	}
+	var events []esign.TenantEvent
+	mgr := &esign.TenantManager{
+		Store: store,
+		NewHTTPClient: func(tenantID string) *http.Client {
+			return &http.Client{Transport: transports[tenantID]}
+		},
+		OnEvent: func(ctx context.Context, ev esign.TenantEvent) {
+			events = append(events, ev)
+		},
+	}
+	op := func(ctx context.Context) error {
+		return (&esign.Op{
+			Credential: mgr.For(ctx),
+			Method:     "GET",
+			Path:       "envelopes",
+			Version:    esign.VersionV21,
+		}).Do(ctx, nil)
+	}
+	envelopesPath := "/restapi/v2.1/accounts/fe0b61a3-3b9b-cafe-b7be-4592af32aa9b/envelopes"
+
+	if err := op(context.Background()); err == nil || err.Error() != "no tenant in context" {
+		t.Errorf("expected no tenant in context; got %v", err)
+	}
+	if err := op(esign.WithTenant(context.Background(), "MISSING")); err == nil {
+		t.Errorf("expected unknown tenant error; got success")
+	}
+
+	// jwt tenant obtains a token and saves state
+	ctx := esign.WithTenant(context.Background(), "JWTTENANT")
+	transports["JWTTENANT"].Add(&testutils.RequestTester{
+		Path:     "/oauth/token",
+		Response: testutils.MakeResponse(200, []byte(tokenSuccessResponse), nil),
+	}, &testutils.RequestTester{
+		Path:     "/oauth/userinfo",
+		Response: testutils.MakeResponse(200, []byte(userInfoSuccessResponse), nil),
+	}, &testutils.RequestTester{
+		Path:     envelopesPath,
+		Response: testutils.MakeResponse(200, []byte("{}"), nil),
+	})
+	if err := op(ctx); err != nil {
+		t.Fatalf("expected jwt tenant success; got %v", err)
+	}
+	if st := store.saved["JWTTENANT"]; st == nil || st.Token == nil || st.UserInfo == nil {
+		t.Errorf("expected saved jwt tenant state; got %#v", st)
+	} else if st.AccountID != "fe0b61a3-3b9b-cafe-b7be-4592af32aa9b" || st.BaseURI != "https://gotest.docusign.net" {
+		t.Errorf("expected saved state with resolved account and base uri; got %s %s", st.AccountID, st.BaseURI)
+	}
+
+	// code grant tenant uses stored token and is rate limited
+	ctx2 := esign.WithTenant(context.Background(), "CODETENANT")
+	for i := 0; i < 3; i++ {
+		transports["CODETENANT"].Add(&testutils.RequestTester{
+			Path:     envelopesPath,
+			Auth:     "Bearer CODETOKEN",
+			Response: testutils.MakeResponse(200, []byte("{}"), nil),
+		})
+	}
+	tm := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := op(ctx2); err != nil {
+			t.Fatalf("expected code grant tenant success; got %v", err)
+		}
+	}
+	if elapsed := time.Since(tm); elapsed < 90*time.Millisecond {
+		t.Errorf("expected rate limit of 20/sec; 3 calls took %v", elapsed)
+	}
+
+	// revocation drops the credential and the next call reloads the tenant
+	transports["CODETENANT"].Add(&testutils.RequestTester{
+		Path:     envelopesPath,
+		Response: testutils.MakeResponse(401, []byte(`{"errorCode":"USER_AUTHENTICATION_FAILED"}`), nil),
+	}, &testutils.RequestTester{
+		Path:     envelopesPath,
+		Response: testutils.MakeResponse(200, []byte("{}"), nil),
+	})
+	if err := op(ctx2); err == nil {
+		t.Errorf("expected 401 error; got success")
+	}
+	if err := op(ctx2); err != nil {
+		t.Errorf("expected success after reload; got %v", err)
+	}
+	if store.loads["CODETENANT"] != 2 || store.loads["JWTTENANT"] != 1 {
+		t.Errorf("expected 2 code tenant and 1 jwt tenant loads; got %v", store.loads)
+	}
+
+	if err := mgr.Reconnect(ctx, "JWTTENANT", nil); err != nil {
+		t.Errorf("reconnect: %v", err)
+	}
+
+	// reloaded jwt tenant restores the saved account without userinfo
+	store.tenants["JWTTENANT"].State = store.saved["JWTTENANT"]
+	transports["JWTTENANT"].Add(&testutils.RequestTester{
+		Path:     envelopesPath,
+		Response: testutils.MakeResponse(200, []byte("{}"), nil),
+	})
+	if err := op(ctx); err != nil {
+		t.Errorf("expected restored jwt tenant success; got %v", err)
+	}
+	var evTypes []string
+	for _, ev := range events {
+		evTypes = append(evTypes, ev.TenantID+":"+ev.Type)
+	}
+	expected := []string{"JWTTENANT:loaded", "CODETENANT:loaded", "CODETENANT:revoked", "CODETENANT:loaded", "JWTTENANT:reconnected", "JWTTENANT:loaded"}
+	if len(evTypes) != len(expected) {
+		t.Fatalf("expected events %v; got %v", expected, evTypes)
+	}
+	for i := range expected {
+		if evTypes[i] != expected[i] {
+			t.Errorf("expected events %v; got %v", expected, evTypes)
+			break
+		}
+	}
+}